@@ -86,8 +86,16 @@ RBAC rules required to create an intercept in a namespace; excludes any rules th
   resources: ["pods/portforward"]
   verbs: ["create"]
 - apiGroups: ["apps"]
-  resources: ["deployments", "replicasets", "statefulsets"]
+  resources: ["deployments", "replicasets", "statefulsets", "daemonsets"]
   verbs: ["get", "watch", "list"]
+- apiGroups: ["argoproj.io"]
+  resources: ["rollouts"]
+  verbs: ["get", "watch", "list"]
+{{- range .Values.customWorkloadKinds }}
+- apiGroups: [{{ .group | quote }}]
+  resources: [{{ include "telepresence.customWorkloadResource" . | quote }}]
+  verbs: ["get", "watch", "list"]
+{{- end }}
 - apiGroups: [""]
   resources: ["configmaps"]
   resourceNames: ["telepresence-agents"]
@@ -97,6 +105,37 @@ RBAC rules required to create an intercept in a namespace; excludes any rules th
 {{- end }}
 {{- end }}
 
+{{/*
+The plural resource name of a custom workload kind. It is guessed from the kind when not given.
+*/}}
+{{- define "telepresence.customWorkloadResource" -}}
+{{- .resource | default (printf "%ss" (lower .kind)) -}}
+{{- end -}}
+
+{{/*
+RBAC rules that the traffic-manager needs for Argo Rollouts and the custom workload kinds.
+*/}}
+{{- define "traffic-manager.customWorkloadRules" -}}
+- apiGroups:
+  - "argoproj.io"
+  resources:
+  - rollouts
+  verbs:
+  - get
+  - list
+  - patch
+{{- range .Values.customWorkloadKinds }}
+- apiGroups:
+  - {{ .group | quote }}
+  resources:
+  - {{ include "telepresence.customWorkloadResource" . }}
+  verbs:
+  - get
+  - list
+  - patch
+{{- end }}
+{{- end }}
+
 {{/*
 Kubernetes version
 */}}
@@ -154,6 +154,12 @@ func Main(ctx context.Context, _ ...string) error {
 	return g.Wait()
 }
 
+// containerMountPoint returns the directory that the ftp and sftp servers use when exporting the
+// volumes of the given container.
+func containerMountPoint(cn *agentconfig.Container) string {
+	return filepath.Join(agentconfig.ExportsMountPoint, filepath.Base(cn.MountPoint))
+}
+
 func sidecar(ctx context.Context, s SimpleState, info *rpc.AgentInfo) error {
 	// Manage the forwarders
 	ac := s.AgentConfig()
@@ -179,8 +185,7 @@ func sidecar(ctx context.Context, s SimpleState, info *rpc.AgentInfo) error {
 			dgroup.ParentGroup(ctx).Go(fmt.Sprintf("forward-%s", iputil.JoinHostPort(cn.Name, cp)), func(ctx context.Context) error {
 				return fwd.Serve(tunnel.WithPool(ctx, tunnel.NewPool()), nil)
 			})
-			cnMountPoint := filepath.Join(agentconfig.ExportsMountPoint, filepath.Base(cn.MountPoint))
-			s.AddInterceptState(s.NewInterceptState(fwd, NewInterceptTarget(ics), cnMountPoint, env))
+			s.AddInterceptState(s.NewInterceptState(fwd, NewInterceptTarget(ics), containerMountPoint(cn), env))
 		}
 	}
 	TalkToManagerLoop(ctx, s, info)
@@ -308,6 +313,16 @@ func StartServices(ctx context.Context, g *dgroup.Group, config Config, srv Stat
 				Product: "telepresence",
 				Version: version.Version,
 			},
+			{
+				Name:    "http",
+				Product: "telepresence",
+				Version: version.Version,
+			},
+			{
+				Name:    "grpc",
+				Product: "telepresence",
+				Version: version.Version,
+			},
 		},
 	}, nil
 }
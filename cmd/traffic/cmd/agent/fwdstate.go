@@ -4,25 +4,32 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
+	core "k8s.io/api/core/v1"
+
 	"github.com/datawire/dlib/dlog"
 	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/pkg/forwarder"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
 	"github.com/telepresenceio/telepresence/v2/pkg/restapi"
 	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
 )
 
 type fwdState struct {
 	*simpleState
-	intercept  InterceptTarget
-	forwarder  forwarder.Interceptor
-	mountPoint string
-	env        map[string]string
+	intercept      InterceptTarget
+	forwarder      forwarder.Interceptor
+	mountPoint     string
+	env            map[string]string
+	httpLock       sync.Mutex
+	httpIntercepts []*forwarder.HTTPIntercept
 }
 
-// NewInterceptState creates a InterceptState that performs intercepts by using an Interceptor which indiscriminately
-// intercepts all traffic to the port that it forwards.
+// NewInterceptState creates a InterceptState that performs intercepts by using an Interceptor which either
+// indiscriminately intercepts all traffic to the port that it forwards, or intercepts the HTTP requests that
+// match the intercepts that use the "http" or "grpc" mechanism.
 func (s *simpleState) NewInterceptState(forwarder forwarder.Interceptor, intercept InterceptTarget, mountPoint string, env map[string]string) InterceptState {
 	return &fwdState{
 		simpleState: s,
@@ -38,14 +45,13 @@ func (fs *fwdState) Target() InterceptTarget {
 }
 
 func (fs *fwdState) InterceptInfo(ctx context.Context, callerID, path string, containerPort uint16, headers http.Header) (*restapi.InterceptInfo, error) {
-	// The OSS agent is either intercepting or it isn't. There's no way to tell what it is that's being intercepted.
 	fw := fs.forwarder
 	if containerPort == 0 {
-		return fw.InterceptInfo(), nil
+		return fs.interceptInfo(path, headers), nil
 	}
 	_, port := fw.Target()
 	if containerPort == port {
-		return fw.InterceptInfo(), nil
+		return fs.interceptInfo(path, headers), nil
 	}
 	portInfo := ""
 	if containerPort != 0 {
@@ -55,6 +61,19 @@ func (fs *fwdState) InterceptInfo(ctx context.Context, callerID, path string, co
 	return &restapi.InterceptInfo{Intercepted: false}, nil
 }
 
+// interceptInfo returns the info of the intercept that would receive a request with the given path and headers.
+func (fs *fwdState) interceptInfo(path string, headers http.Header) *restapi.InterceptInfo {
+	fs.httpLock.Lock()
+	hics := fs.httpIntercepts
+	fs.httpLock.Unlock()
+	for _, hi := range hics {
+		if hi.Matches(path, headers) {
+			return &restapi.InterceptInfo{Intercepted: true, Metadata: hi.Metadata}
+		}
+	}
+	return fs.forwarder.InterceptInfo()
+}
+
 type ProviderMux struct {
 	AgentProvider   tunnel.ClientStreamProvider
 	ManagerProvider tunnel.StreamProvider
@@ -75,10 +94,11 @@ func (pm *ProviderMux) CreateClientStream(ctx context.Context, sessionID string,
 func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.InterceptInfo) []*manager.ReviewInterceptRequest {
 	var myChoice, activeIntercept *manager.InterceptInfo
 
-	// Find the chosen intercept if it still exists
+	// Find the chosen intercept if it still exists. It must be found using its ID, because an intercept
+	// with several ports is a different copy for each port.
 	if fs.chosenIntercept != nil {
 		for _, cept := range cepts {
-			if cept == fs.chosenIntercept {
+			if cept.Id == fs.chosenIntercept.Id {
 				myChoice = cept
 				break
 			}
@@ -91,7 +111,7 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 	} else {
 		// Attach to already ACTIVE intercept if there is one.
 		for _, cept := range cepts {
-			if cept.Disposition == manager.InterceptDispositionType_ACTIVE {
+			if cept.Disposition == manager.InterceptDispositionType_ACTIVE && !isHTTP(cept) && !isSourced(cept) {
 				myChoice = cept
 				fs.chosenIntercept = cept
 				activeIntercept = cept
@@ -110,12 +130,67 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 	}
 	fs.forwarder.SetIntercepting(activeIntercept)
 
+	// Collect the active HTTP intercepts. They can coexist with each other, but not with an intercept that
+	// uses the "tcp" mechanism.
+	var httpIntercepts []*forwarder.HTTPIntercept
+	for _, cept := range cepts {
+		if cept.Disposition == manager.InterceptDispositionType_ACTIVE && isHTTP(cept) {
+			if m, err := matcher.NewRequestFromMechanismArgs(cept.Spec.Mechanism, cept.Spec.MechanismArgs); err == nil {
+				httpIntercepts = append(httpIntercepts, &forwarder.HTTPIntercept{InterceptInfo: cept, Matcher: m})
+			}
+		}
+	}
+	fs.httpLock.Lock()
+	fs.httpIntercepts = httpIntercepts
+	fs.httpLock.Unlock()
+	fs.forwarder.SetHTTPIntercepts(httpIntercepts)
+	httpChoice := ""
+	if len(httpIntercepts) > 0 {
+		httpChoice = httpIntercepts[0].Id
+	}
+
+	// Collect the active intercepts that only receive the connections from selected pods. They too can
+	// coexist with each other, but not with an intercept that takes all connections.
+	sis := sourceIntercepts(cepts)
+	fs.forwarder.SetSourceIntercepts(sis, fs.lookupSource)
+	sourceChoice := ""
+	if len(sis) > 0 {
+		sourceChoice = sis[0].Id
+	}
+
 	// Review waiting intercepts
 	reviews := make([]*manager.ReviewInterceptRequest, 0, len(cepts))
 	for _, cept := range cepts {
 		if cept.Disposition == manager.InterceptDispositionType_WAITING {
 			// This intercept is ready to be active
 			switch {
+			case isSourced(cept):
+				review := fs.reviewSourceIntercept(ctx, cept, httpChoice)
+				if review.Disposition == manager.InterceptDispositionType_ACTIVE && sourceChoice == "" {
+					sourceChoice = cept.Id
+				}
+				reviews = append(reviews, review)
+			case isHTTP(cept) && sourceChoice != "":
+				dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; as it conflicts with the source intercept %q", cept.Id, sourceChoice)
+				reviews = append(reviews, &manager.ReviewInterceptRequest{
+					Id:          cept.Id,
+					Disposition: manager.InterceptDispositionType_AGENT_ERROR,
+					Message:     fmt.Sprintf("Conflicts with the source intercept %q", sourceChoice),
+				})
+			case isHTTP(cept):
+				review := fs.reviewHTTPIntercept(ctx, cept)
+				if review.Disposition == manager.InterceptDispositionType_ACTIVE && httpChoice == "" {
+					httpChoice = cept.Id
+				}
+				reviews = append(reviews, review)
+			case isWeighted(cept) && fs.intercept.Protocol() != core.ProtocolTCP:
+				dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; a weight requires a TCP port", cept.Id)
+				reviews = append(reviews, &manager.ReviewInterceptRequest{
+					Id:                cept.Id,
+					Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
+					Message:           fmt.Sprintf("a weight cannot be used with protocol %s", fs.intercept.Protocol()),
+					MechanismArgsDesc: globalArgsDesc(cept),
+				})
 			case cept == myChoice:
 				// We've already chosen this one, but it's not active yet in this
 				// snapshot. Let's go ahead and tell the manager to mark it ACTIVE.
@@ -127,10 +202,10 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 					FtpPort:           int32(fs.FtpPort()),
 					SftpPort:          int32(fs.SftpPort()),
 					MountPoint:        fs.mountPoint,
-					MechanismArgsDesc: "all TCP connections",
+					MechanismArgsDesc: globalArgsDesc(cept),
 					Environment:       fs.env,
 				})
-			case fs.chosenIntercept == nil:
+			case fs.chosenIntercept == nil && httpChoice == "" && sourceChoice == "":
 				// We don't have an intercept in play, so choose this one. All
 				// agents will get intercepts in the same order every time, so
 				// this will yield a consistent result. Note that the intercept
@@ -146,27 +221,125 @@ func (fs *fwdState) HandleIntercepts(ctx context.Context, cepts []*manager.Inter
 					FtpPort:           int32(fs.FtpPort()),
 					SftpPort:          int32(fs.SftpPort()),
 					MountPoint:        fs.mountPoint,
-					MechanismArgsDesc: "all TCP connections",
+					MechanismArgsDesc: globalArgsDesc(cept),
 					Environment:       fs.env,
 				})
-			default:
-				// We already have an intercept in play, so reject this one.
-				chosenID := fs.chosenIntercept.Id
-				dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; as it conflicts with %q as the current chosen-to-be-ACTIVE intercept", cept.Id, chosenID)
+			case fs.chosenIntercept == nil:
+				// HTTP or source intercepts are in play, and they can't coexist with an intercept that takes all connections.
 				var msg string
-				if fs.chosenIntercept.Disposition == manager.InterceptDispositionType_ACTIVE {
-					msg = fmt.Sprintf("Conflicts with the currently-served intercept %q", chosenID)
+				if httpChoice != "" {
+					msg = fmt.Sprintf("Conflicts with the HTTP intercept %q", httpChoice)
 				} else {
-					msg = fmt.Sprintf("Conflicts with the currently-waiting-to-be-served intercept %q", chosenID)
+					msg = fmt.Sprintf("Conflicts with the source intercept %q", sourceChoice)
 				}
+				dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; %s", cept.Id, msg)
 				reviews = append(reviews, &manager.ReviewInterceptRequest{
 					Id:                cept.Id,
 					Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
 					Message:           msg,
-					MechanismArgsDesc: "all TCP connections",
+					MechanismArgsDesc: globalArgsDesc(cept),
 				})
+			default:
+				// We already have an intercept in play, so reject this one.
+				reviews = append(reviews, fs.conflictReview(ctx, cept, globalArgsDesc(cept)))
 			}
 		}
 	}
 	return reviews
 }
+
+// conflictReview returns a review that rejects the given intercept because it conflicts with the chosen intercept.
+func (fs *fwdState) conflictReview(ctx context.Context, cept *manager.InterceptInfo, argsDesc string) *manager.ReviewInterceptRequest {
+	chosenID := fs.chosenIntercept.Id
+	dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; as it conflicts with %q as the current chosen-to-be-ACTIVE intercept", cept.Id, chosenID)
+	var msg string
+	if fs.chosenIntercept.Disposition == manager.InterceptDispositionType_ACTIVE {
+		msg = fmt.Sprintf("Conflicts with the currently-served intercept %q", chosenID)
+	} else {
+		msg = fmt.Sprintf("Conflicts with the currently-waiting-to-be-served intercept %q", chosenID)
+	}
+	return &manager.ReviewInterceptRequest{
+		Id:                cept.Id,
+		Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
+		Message:           msg,
+		MechanismArgsDesc: argsDesc,
+	}
+}
+
+// reviewHTTPIntercept reviews an intercept that uses the "http" or "grpc" mechanism. Such intercepts are accepted
+// unless an intercept that takes all connections is in play, the intercepted port isn't a TCP port, or the
+// mechanism args are invalid.
+func (fs *fwdState) reviewHTTPIntercept(ctx context.Context, cept *manager.InterceptInfo) *manager.ReviewInterceptRequest {
+	m, err := matcher.NewRequestFromMechanismArgs(cept.Spec.Mechanism, cept.Spec.MechanismArgs)
+	if err != nil {
+		dlog.Infof(ctx, "Setting intercept %q as BAD_ARGS: %v", cept.Id, err)
+		return &manager.ReviewInterceptRequest{
+			Id:          cept.Id,
+			Disposition: manager.InterceptDispositionType_BAD_ARGS,
+			Message:     err.Error(),
+		}
+	}
+	argsDesc := weightedDesc(cept, m.String())
+	if fs.chosenIntercept != nil {
+		return fs.conflictReview(ctx, cept, argsDesc)
+	}
+	if fs.intercept.Protocol() != core.ProtocolTCP {
+		dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; the %q mechanism requires a TCP port", cept.Id, cept.Spec.Mechanism)
+		return &manager.ReviewInterceptRequest{
+			Id:                cept.Id,
+			Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
+			Message:           fmt.Sprintf("mechanism %q cannot be used with protocol %s", cept.Spec.Mechanism, fs.intercept.Protocol()),
+			MechanismArgsDesc: argsDesc,
+		}
+	}
+	dlog.Infof(ctx, "Setting intercept %q as ACTIVE", cept.Id)
+	return &manager.ReviewInterceptRequest{
+		Id:                cept.Id,
+		Disposition:       manager.InterceptDispositionType_ACTIVE,
+		PodIp:             fs.PodIP(),
+		FtpPort:           int32(fs.FtpPort()),
+		SftpPort:          int32(fs.SftpPort()),
+		MountPoint:        fs.mountPoint,
+		MechanismArgsDesc: argsDesc,
+		Headers:           m.Map(),
+		Environment:       fs.env,
+	}
+}
+
+// isHTTP returns true if the intercept uses a mechanism that matches individual requests, i.e. "http" or "grpc".
+func isHTTP(cept *manager.InterceptInfo) bool {
+	switch cept.Spec.Mechanism {
+	case "http", "grpc":
+		return true
+	default:
+		return false
+	}
+}
+
+// isWeighted returns true if only a share of the connections or requests are sent to the client of the intercept.
+func isWeighted(cept *manager.InterceptInfo) bool {
+	w := cept.Spec.Weight
+	return w > 0 && w < 100
+}
+
+// weightedDesc prefixes the given description with the weight of the intercept, if it has one.
+func weightedDesc(cept *manager.InterceptInfo, desc string) string {
+	if isWeighted(cept) {
+		return fmt.Sprintf("%d%% of %s", cept.Spec.Weight, desc)
+	}
+	return desc
+}
+
+// globalArgsDesc returns the description of an intercept that takes all connections, or all connections from
+// the pods that its source selector selects.
+func globalArgsDesc(cept *manager.InterceptInfo) string {
+	desc := "all TCP connections"
+	if isSourced(cept) {
+		desc = "TCP connections from " + sourceDesc(cept.Spec.Source)
+	}
+	desc = weightedDesc(cept, desc)
+	if cept.Spec.Mirror {
+		desc = "a copy of " + desc
+	}
+	return desc
+}
@@ -46,6 +46,17 @@ func (cp InterceptTarget) MatchForSpec(spec *manager.InterceptSpec) bool {
 	return false
 }
 
+// SpecFor returns the spec that targets this InterceptTarget, or nil if no port of the given spec matches. The
+// returned spec is a copy of the given spec with its primary port replaced when one of its additional ports matches.
+func (cp InterceptTarget) SpecFor(spec *manager.InterceptSpec) *manager.InterceptSpec {
+	for _, sv := range cp {
+		if ps := agentconfig.InterceptSpecFor(spec, sv); ps != nil {
+			return ps
+		}
+	}
+	return nil
+}
+
 func (cp InterceptTarget) AgentPort() uint16 {
 	return cp[0].AgentPort
 }
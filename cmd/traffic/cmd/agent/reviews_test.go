@@ -0,0 +1,58 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+func TestMergeReviews(t *testing.T) {
+	review := func(id string, d rpc.InterceptDispositionType) *rpc.ReviewInterceptRequest {
+		return &rpc.ReviewInterceptRequest{Id: id, Disposition: d}
+	}
+	active := rpc.InterceptDispositionType_ACTIVE
+	waiting := rpc.InterceptDispositionType_WAITING
+	agentError := rpc.InterceptDispositionType_AGENT_ERROR
+
+	tests := []struct {
+		name     string
+		reviews  []*rpc.ReviewInterceptRequest
+		expected []*rpc.ReviewInterceptRequest
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:     "single",
+			reviews:  []*rpc.ReviewInterceptRequest{review("a", waiting)},
+			expected: []*rpc.ReviewInterceptRequest{review("a", waiting)},
+		},
+		{
+			name:     "different intercepts",
+			reviews:  []*rpc.ReviewInterceptRequest{review("a", active), review("b", waiting)},
+			expected: []*rpc.ReviewInterceptRequest{review("a", active), review("b", waiting)},
+		},
+		{
+			name:     "all ports active",
+			reviews:  []*rpc.ReviewInterceptRequest{review("a", active), review("a", active)},
+			expected: []*rpc.ReviewInterceptRequest{review("a", active)},
+		},
+		{
+			name:     "second port not active",
+			reviews:  []*rpc.ReviewInterceptRequest{review("a", active), review("b", active), review("a", agentError)},
+			expected: []*rpc.ReviewInterceptRequest{review("a", agentError), review("b", active)},
+		},
+		{
+			name:     "first port not active",
+			reviews:  []*rpc.ReviewInterceptRequest{review("a", waiting), review("a", active), review("a", agentError)},
+			expected: []*rpc.ReviewInterceptRequest{review("a", waiting)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mergeReviews(tt.reviews))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	core "k8s.io/api/core/v1"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/forwarder"
+)
+
+// sourceCacheTTL is the time that the result of a source lookup is retained. Pod IPs are reused, but
+// not within such a short time.
+const sourceCacheTTL = 30 * time.Second
+
+type cachedSource struct {
+	info    *manager.SourceInfo
+	expires time.Time
+}
+
+// sourceCache caches the pods that the traffic-manager finds when looking up the source IPs of
+// intercepted connections.
+type sourceCache struct {
+	mu      sync.Mutex
+	sources map[string]cachedSource
+}
+
+func (c *sourceCache) get(ip net.IP) *manager.SourceInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cs, ok := c.sources[ip.String()]; ok && time.Now().Before(cs.expires) {
+		return cs.info
+	}
+	return nil
+}
+
+func (c *sourceCache) put(ip net.IP, info *manager.SourceInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.sources == nil {
+		c.sources = make(map[string]cachedSource)
+	} else {
+		for k, cs := range c.sources {
+			if now.After(cs.expires) {
+				delete(c.sources, k)
+			}
+		}
+	}
+	c.sources[ip.String()] = cachedSource{info: info, expires: now.Add(sourceCacheTTL)}
+}
+
+// lookupSource is a forwarder.SourceLookup that asks the traffic-manager for the pod that has the given IP.
+func (fs *fwdState) lookupSource(ctx context.Context, ip net.IP) (*manager.SourceInfo, error) {
+	if info := fs.sources.get(ip); info != nil {
+		return info, nil
+	}
+	mc := fs.ManagerClient()
+	if mc == nil {
+		return nil, fmt.Errorf("no connection to the traffic-manager")
+	}
+	info, err := mc.LookupSource(ctx, &manager.LookupSourceRequest{Session: fs.SessionInfo(), Ip: ip})
+	if err != nil {
+		return nil, err
+	}
+	fs.sources.put(ip, info)
+	return info, nil
+}
+
+// isSourced returns true if the intercept only receives connections from the pods that its source selector selects.
+func isSourced(cept *manager.InterceptInfo) bool {
+	sel := cept.Spec.Source
+	return sel != nil && (len(sel.Labels) > 0 || sel.Namespace != "" || sel.ServiceAccount != "")
+}
+
+// sourceDesc returns a description of the pods that are selected by the given selector.
+func sourceDesc(sel *manager.SourceSelector) string {
+	var parts []string
+	if len(sel.Labels) > 0 {
+		ls := make([]string, 0, len(sel.Labels))
+		for k, v := range sel.Labels {
+			ls = append(ls, k+"="+v)
+		}
+		sort.Strings(ls)
+		parts = append(parts, "labels "+strings.Join(ls, ","))
+	}
+	if sel.Namespace != "" {
+		parts = append(parts, "namespace "+sel.Namespace)
+	}
+	if sel.ServiceAccount != "" {
+		parts = append(parts, "service account "+sel.ServiceAccount)
+	}
+	return "pods with " + strings.Join(parts, " and ")
+}
+
+// sourceIntercepts returns the active intercepts that only receive connections from selected pods.
+func sourceIntercepts(cepts []*manager.InterceptInfo) []*forwarder.SourceIntercept {
+	var sis []*forwarder.SourceIntercept
+	for _, cept := range cepts {
+		if cept.Disposition == manager.InterceptDispositionType_ACTIVE && isSourced(cept) && !isHTTP(cept) {
+			sis = append(sis, &forwarder.SourceIntercept{InterceptInfo: cept})
+		}
+	}
+	return sis
+}
+
+// reviewSourceIntercept reviews an intercept that only receives the connections from the pods that its source
+// selector selects. Such intercepts can coexist with each other, but not with HTTP intercepts or with an intercept
+// that takes all connections.
+func (fs *fwdState) reviewSourceIntercept(ctx context.Context, cept *manager.InterceptInfo, httpChoice string) *manager.ReviewInterceptRequest {
+	if isHTTP(cept) {
+		dlog.Infof(ctx, "Setting intercept %q as BAD_ARGS; a source selector cannot be used with the %q mechanism", cept.Id, cept.Spec.Mechanism)
+		return &manager.ReviewInterceptRequest{
+			Id:          cept.Id,
+			Disposition: manager.InterceptDispositionType_BAD_ARGS,
+			Message:     fmt.Sprintf("a source selector cannot be used with the %q mechanism", cept.Spec.Mechanism),
+		}
+	}
+	argsDesc := globalArgsDesc(cept)
+	if fs.chosenIntercept != nil {
+		return fs.conflictReview(ctx, cept, argsDesc)
+	}
+	var msg string
+	switch {
+	case httpChoice != "":
+		msg = fmt.Sprintf("Conflicts with the HTTP intercept %q", httpChoice)
+	case fs.intercept.Protocol() != core.ProtocolTCP:
+		msg = fmt.Sprintf("a source selector cannot be used with protocol %s", fs.intercept.Protocol())
+	default:
+		dlog.Infof(ctx, "Setting intercept %q as ACTIVE", cept.Id)
+		return &manager.ReviewInterceptRequest{
+			Id:                cept.Id,
+			Disposition:       manager.InterceptDispositionType_ACTIVE,
+			PodIp:             fs.PodIP(),
+			FtpPort:           int32(fs.FtpPort()),
+			SftpPort:          int32(fs.SftpPort()),
+			MountPoint:        fs.mountPoint,
+			MechanismArgsDesc: argsDesc,
+			Environment:       fs.env,
+		}
+	}
+	dlog.Infof(ctx, "Setting intercept %q as AGENT_ERROR; %s", cept.Id, msg)
+	return &manager.ReviewInterceptRequest{
+		Id:                cept.Id,
+		Disposition:       manager.InterceptDispositionType_AGENT_ERROR,
+		Message:           msg,
+		MechanismArgsDesc: argsDesc,
+	}
+}
@@ -2,10 +2,12 @@ package agent
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/blang/semver"
 	"github.com/puzpuzpuz/xsync/v3"
+	"google.golang.org/protobuf/proto"
 	core "k8s.io/api/core/v1"
 
 	"github.com/datawire/dlib/dlog"
@@ -60,6 +62,9 @@ type state struct {
 	manager     manager.ManagerClient
 	mgrVer      semver.Version
 
+	// Sources of intercepted connections, as looked up by the traffic-manager.
+	sources sourceCache
+
 	interceptStates []InterceptState
 	agent.UnimplementedAgentServer
 }
@@ -116,11 +121,21 @@ func (s *state) InterceptStates() []InterceptState {
 
 func (s *state) HandleIntercepts(ctx context.Context, iis []*manager.InterceptInfo) []*manager.ReviewInterceptRequest {
 	var rs []*manager.ReviewInterceptRequest
+	for _, ii := range iis {
+		if ii.Spec.Ingest && ii.Disposition == manager.InterceptDispositionType_WAITING {
+			rs = append(rs, s.reviewIngest(ctx, ii))
+		}
+	}
 	for _, ist := range s.interceptStates {
 		ms := make([]*manager.InterceptInfo, 0, len(iis))
 		for _, ii := range iis {
 			ic := ist.Target()
-			if ic.MatchForSpec(ii.Spec) {
+			if spec := ic.SpecFor(ii.Spec); spec != nil {
+				if spec != ii.Spec {
+					// One of the additional ports of the intercept matched.
+					ii = proto.Clone(ii).(*manager.InterceptInfo)
+					ii.Spec = spec
+				}
 				dlog.Debugf(ctx, "intercept id %s svc=%q, svcPortId=%q matches target protocol=%s, agentPort=%d, containerPort=%d",
 					ii.Id, ii.Spec.ServiceName, ii.Spec.ServicePortIdentifier, ic.Protocol(), ic.AgentPort(), ic.ContainerPort())
 				ms = append(ms, ii)
@@ -128,7 +143,66 @@ func (s *state) HandleIntercepts(ctx context.Context, iis []*manager.InterceptIn
 		}
 		rs = append(rs, ist.HandleIntercepts(ctx, ms)...)
 	}
-	return rs
+	return mergeReviews(rs)
+}
+
+// mergeReviews ensures that there's only one review for each intercept. An intercept with several ports is
+// reviewed once for each port, and it can only become active when all its ports can become active.
+func mergeReviews(rs []*manager.ReviewInterceptRequest) []*manager.ReviewInterceptRequest {
+	if len(rs) < 2 {
+		return rs
+	}
+	idx := make(map[string]int, len(rs))
+	merged := rs[:0]
+	for _, r := range rs {
+		i, ok := idx[r.Id]
+		if !ok {
+			idx[r.Id] = len(merged)
+			merged = append(merged, r)
+			continue
+		}
+		if merged[i].Disposition == manager.InterceptDispositionType_ACTIVE && r.Disposition != manager.InterceptDispositionType_ACTIVE {
+			merged[i] = r
+		}
+	}
+	return merged
+}
+
+// reviewIngest reviews an ingest. An ingest never conflicts with other ingests or intercepts, because no traffic is
+// redirected. It is activated as soon as the container that it ingests is found.
+func (s *state) reviewIngest(ctx context.Context, ii *manager.InterceptInfo) *manager.ReviewInterceptRequest {
+	cnName := ii.Spec.ContainerName
+	for _, cn := range s.AgentConfig().Containers {
+		if cnName != "" && cn.Name != cnName {
+			continue
+		}
+		env, err := AppEnvironment(ctx, cn)
+		if err != nil {
+			dlog.Errorf(ctx, "Setting ingest %q as AGENT_ERROR; %v", ii.Id, err)
+			return &manager.ReviewInterceptRequest{
+				Id:          ii.Id,
+				Disposition: manager.InterceptDispositionType_AGENT_ERROR,
+				Message:     err.Error(),
+			}
+		}
+		dlog.Infof(ctx, "Setting ingest %q as ACTIVE", ii.Id)
+		return &manager.ReviewInterceptRequest{
+			Id:                ii.Id,
+			Disposition:       manager.InterceptDispositionType_ACTIVE,
+			PodIp:             s.PodIP(),
+			FtpPort:           int32(s.FtpPort()),
+			SftpPort:          int32(s.SftpPort()),
+			MountPoint:        containerMountPoint(cn),
+			MechanismArgsDesc: "as ingest",
+			Environment:       env,
+		}
+	}
+	dlog.Infof(ctx, "Setting ingest %q as AGENT_ERROR; container %q not found", ii.Id, cnName)
+	return &manager.ReviewInterceptRequest{
+		Id:          ii.Id,
+		Disposition: manager.InterceptDispositionType_AGENT_ERROR,
+		Message:     fmt.Sprintf("unable to ingest container %q", cnName),
+	}
 }
 
 func (s *simpleState) HandleIntercepts(ctx context.Context, iis []*manager.InterceptInfo) []*manager.ReviewInterceptRequest {
@@ -3,17 +3,20 @@ package agent_test
 import (
 	"context"
 	"net"
+	"net/http"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
 
 	"github.com/datawire/dlib/dlog"
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/agent"
 	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/dos"
 	"github.com/telepresenceio/telepresence/v2/pkg/forwarder"
 )
 
@@ -148,4 +151,329 @@ func TestState_HandleIntercepts(t *testing.T) {
 	reviews = s.HandleIntercepts(ctx, nil)
 	a.Len(reviews, 0)
 	a.Equal("", f.InterceptId())
+
+	// The description of a weighted intercept includes the weight
+
+	cepts[0].Disposition = rpc.InterceptDispositionType_WAITING
+	cepts[0].Spec.Weight = 10
+	reviews = s.HandleIntercepts(ctx, cepts[:1])
+	a.Len(reviews, 1)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[0].Disposition)
+	a.Equal("10% of all TCP connections", reviews[0].MechanismArgsDesc)
+}
+
+func TestState_HandleMultiPortIntercepts(t *testing.T) {
+	ctx := testContext(t, nil)
+	c, err := agent.LoadConfig(ctx)
+	require.NoError(t, err)
+	s := agent.NewSimpleState(c)
+
+	// One intercept state for each container port.
+	targets := []*agentconfig.Intercept{
+		{
+			ContainerPortName: "http",
+			ServiceName:       serviceName,
+			ServicePortName:   "http",
+			ServicePort:       80,
+			Protocol:          core.ProtocolTCP,
+			AgentPort:         9900,
+			ContainerPort:     8080,
+		},
+		{
+			ContainerPortName: "metrics",
+			ServiceName:       serviceName,
+			ServicePortName:   "metrics",
+			ServicePort:       9090,
+			Protocol:          core.ProtocolTCP,
+			AgentPort:         9901,
+			ContainerPort:     9090,
+		},
+	}
+	sctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fs := make([]forwarder.Interceptor, len(targets))
+	for i, ic := range targets {
+		fs[i] = forwarder.NewInterceptor(&net.TCPAddr{IP: net.IP{127, 0, 0, 1}}, appHost, ic.ContainerPort)
+		initCh := make(chan net.Addr, 1)
+		go func(f forwarder.Interceptor) {
+			_ = f.Serve(sctx, initCh)
+		}(fs[i])
+		<-initCh
+		s.AddInterceptState(s.NewInterceptState(fs[i], agent.NewInterceptTarget([]*agentconfig.Intercept{ic}), "/tel_app_exports/test-echo", map[string]string{}))
+	}
+
+	cepts := []*rpc.InterceptInfo{{
+		Spec: &rpc.InterceptSpec{
+			Name:                  "cept1Name",
+			Client:                "user@host1",
+			Agent:                 "agentName",
+			Mechanism:             "tcp",
+			Namespace:             namespace,
+			ServiceName:           serviceName,
+			ServicePortIdentifier: "http",
+			ServicePortName:       "http",
+			ServicePort:           80,
+			TargetPort:            8080,
+			AdditionalPorts: []*rpc.InterceptPort{{
+				ServicePortIdentifier: "metrics",
+				ServicePortName:       "metrics",
+				ServicePort:           9090,
+				TargetPort:            9090,
+			}},
+		},
+		Id:          "intercept-01",
+		Disposition: rpc.InterceptDispositionType_WAITING,
+	}}
+
+	// The intercept doesn't conflict with itself on its additional port.
+	reviews := s.HandleIntercepts(ctx, cepts)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "intercept-01", reviews[0].Id)
+	assert.Equal(t, rpc.InterceptDispositionType_ACTIVE, reviews[0].Disposition, reviews[0].Message)
+
+	// Once active, every port is intercepted.
+	cepts[0].Disposition = rpc.InterceptDispositionType_ACTIVE
+	reviews = s.HandleIntercepts(ctx, cepts)
+	assert.Empty(t, reviews)
+	for i, f := range fs {
+		assert.Equal(t, "intercept-01", f.InterceptId(), "port %d", targets[i].ServicePort)
+	}
+
+	// Another intercept on either port conflicts with it.
+	cepts = append(cepts, &rpc.InterceptInfo{
+		Spec: &rpc.InterceptSpec{
+			Name:                  "cept2Name",
+			Client:                "user@host2",
+			Agent:                 "agentName",
+			Mechanism:             "tcp",
+			Namespace:             namespace,
+			ServiceName:           serviceName,
+			ServicePortIdentifier: "metrics",
+			TargetPort:            9090,
+		},
+		Id:          "intercept-02",
+		Disposition: rpc.InterceptDispositionType_WAITING,
+	})
+	reviews = s.HandleIntercepts(ctx, cepts)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "intercept-02", reviews[0].Id)
+	assert.Equal(t, rpc.InterceptDispositionType_AGENT_ERROR, reviews[0].Disposition)
+	assert.Equal(t, "Conflicts with the currently-served intercept \"intercept-01\"", reviews[0].Message)
+}
+
+func TestState_HandleHTTPIntercepts(t *testing.T) {
+	ctx := testContext(t, nil)
+	a := assert.New(t)
+	f, s := makeFS(t, ctx)
+
+	cept := func(id, client, mechanism string, args ...string) *rpc.InterceptInfo {
+		return &rpc.InterceptInfo{
+			Spec: &rpc.InterceptSpec{
+				Name:                  id + "Name",
+				Client:                client,
+				Agent:                 "agentName",
+				Mechanism:             mechanism,
+				MechanismArgs:         args,
+				Namespace:             namespace,
+				ServiceName:           serviceName,
+				ServicePortIdentifier: "http",
+				TargetPort:            8080,
+			},
+			Id:          id,
+			Disposition: rpc.InterceptDispositionType_WAITING,
+		}
+	}
+
+	cepts := []*rpc.InterceptInfo{
+		cept("intercept-01", "alice@host1", "http", "--http-header=x-dev=alice"),
+		cept("intercept-02", "bob@host2", "http", "--http-header=x-dev=bob", "--http-path-prefix=/api"),
+		cept("intercept-03", "carol@host3", "http", "--http-path-prefix=/a", "--http-path-equal=/b"),
+		cept("intercept-04", "dave@host4", "tcp"),
+	}
+
+	// HTTP intercepts can coexist, but not with a TCP intercept, and bad args are rejected
+
+	reviews := s.HandleIntercepts(ctx, cepts)
+	a.Len(reviews, 4)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[0].Disposition)
+	a.Equal(map[string]string{"X-Dev": "alice"}, reviews[0].Headers)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[1].Disposition)
+	a.Equal(map[string]string{"X-Dev": "bob", ":path-prefix:": "/api"}, reviews[1].Headers)
+	a.Equal(rpc.InterceptDispositionType_BAD_ARGS, reviews[2].Disposition)
+	a.Equal(rpc.InterceptDispositionType_AGENT_ERROR, reviews[3].Disposition)
+	a.Equal("Conflicts with the HTTP intercept \"intercept-01\"", reviews[3].Message)
+	a.Equal("", f.InterceptId())
+
+	// Active HTTP intercepts are used when answering the intercept info of a request
+
+	cepts[0].Disposition = rpc.InterceptDispositionType_ACTIVE
+	cepts[1].Disposition = rpc.InterceptDispositionType_ACTIVE
+	cepts[0].Metadata = map[string]string{"who": "alice"}
+	cepts = cepts[:2]
+	reviews = s.HandleIntercepts(ctx, cepts)
+	a.Len(reviews, 0)
+
+	is := s.InterceptStates()[0]
+	header := http.Header{}
+	header.Set("x-dev", "alice")
+	ii, err := is.InterceptInfo(ctx, "", "/", 0, header)
+	require.NoError(t, err)
+	a.True(ii.Intercepted)
+	a.Equal("alice", ii.Metadata["who"])
+
+	header.Set("x-dev", "bob")
+	ii, err = is.InterceptInfo(ctx, "", "/", 0, header)
+	require.NoError(t, err)
+	a.False(ii.Intercepted)
+
+	ii, err = is.InterceptInfo(ctx, "", "/api/x", 0, header)
+	require.NoError(t, err)
+	a.True(ii.Intercepted)
+
+	// A TCP intercept in play is in conflict with new HTTP intercepts
+
+	reviews = s.HandleIntercepts(ctx, nil)
+	a.Len(reviews, 0)
+	tcpCept := cept("intercept-05", "dave@host4", "tcp")
+	tcpCept.Disposition = rpc.InterceptDispositionType_ACTIVE
+	httpCept := cept("intercept-06", "alice@host1", "http", "--http-header=x-dev=alice")
+	reviews = s.HandleIntercepts(ctx, []*rpc.InterceptInfo{tcpCept, httpCept})
+	a.Len(reviews, 1)
+	a.Equal(rpc.InterceptDispositionType_AGENT_ERROR, reviews[0].Disposition)
+	a.Equal("Conflicts with the currently-served intercept \"intercept-05\"", reviews[0].Message)
+	a.Equal("intercept-05", f.InterceptId())
+}
+
+func TestState_HandleSourceIntercepts(t *testing.T) {
+	ctx := testContext(t, nil)
+	a := assert.New(t)
+	f, s := makeFS(t, ctx)
+
+	cept := func(id, client string, source *rpc.SourceSelector) *rpc.InterceptInfo {
+		return &rpc.InterceptInfo{
+			Spec: &rpc.InterceptSpec{
+				Name:                  id + "Name",
+				Client:                client,
+				Agent:                 "agentName",
+				Mechanism:             "tcp",
+				Namespace:             namespace,
+				ServiceName:           serviceName,
+				ServicePortIdentifier: "http",
+				TargetPort:            8080,
+				Source:                source,
+			},
+			Id:          id,
+			Disposition: rpc.InterceptDispositionType_WAITING,
+		}
+	}
+
+	cepts := []*rpc.InterceptInfo{
+		cept("intercept-01", "alice@host1", &rpc.SourceSelector{Labels: map[string]string{"app": "alice-tester"}}),
+		cept("intercept-02", "bob@host2", &rpc.SourceSelector{Namespace: "bob", ServiceAccount: "tester"}),
+		cept("intercept-03", "carol@host3", nil),
+	}
+
+	// Source intercepts can coexist, but not with an intercept that takes all connections
+
+	reviews := s.HandleIntercepts(ctx, cepts)
+	a.Len(reviews, 3)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[0].Disposition)
+	a.Equal("TCP connections from pods with labels app=alice-tester", reviews[0].MechanismArgsDesc)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[1].Disposition)
+	a.Equal("TCP connections from pods with namespace bob and service account tester", reviews[1].MechanismArgsDesc)
+	a.Equal(rpc.InterceptDispositionType_AGENT_ERROR, reviews[2].Disposition)
+	a.Equal("Conflicts with the source intercept \"intercept-01\"", reviews[2].Message)
+
+	// Active source intercepts are not chosen as the intercept that takes all connections
+
+	cepts[0].Disposition = rpc.InterceptDispositionType_ACTIVE
+	cepts[1].Disposition = rpc.InterceptDispositionType_ACTIVE
+	reviews = s.HandleIntercepts(ctx, cepts[:2])
+	a.Len(reviews, 0)
+	a.Equal("", f.InterceptId())
+}
+
+func TestState_HandleGRPCIntercepts(t *testing.T) {
+	ctx := testContext(t, nil)
+	a := assert.New(t)
+	_, s := makeFS(t, ctx)
+
+	cept := func(id, client, mechanism string, args ...string) *rpc.InterceptInfo {
+		return &rpc.InterceptInfo{
+			Spec: &rpc.InterceptSpec{
+				Name:                  id + "Name",
+				Client:                client,
+				Agent:                 "agentName",
+				Mechanism:             mechanism,
+				MechanismArgs:         args,
+				Namespace:             namespace,
+				ServiceName:           serviceName,
+				ServicePortIdentifier: "http",
+				TargetPort:            8080,
+			},
+			Id:          id,
+			Disposition: rpc.InterceptDispositionType_WAITING,
+		}
+	}
+
+	// gRPC intercepts can coexist with each other and with HTTP intercepts, and bad args are rejected
+
+	reviews := s.HandleIntercepts(ctx, []*rpc.InterceptInfo{
+		cept("intercept-01", "alice@host1", "grpc", "--grpc-method=/orders.v1.OrderService/*", "--grpc-metadata=x-dev=alice"),
+		cept("intercept-02", "bob@host2", "http", "--http-header=x-dev=bob"),
+		cept("intercept-03", "carol@host3", "grpc", "--grpc-method=/orders.v1.OrderService"),
+	})
+	a.Len(reviews, 3)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[0].Disposition)
+	a.Equal("gRPC calls to /orders.v1.OrderService/* with metadata x-dev=alice", reviews[0].MechanismArgsDesc)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, reviews[1].Disposition)
+	a.Equal(rpc.InterceptDispositionType_BAD_ARGS, reviews[2].Disposition)
+}
+
+func TestState_HandleIngests(t *testing.T) {
+	ctx := testContext(t, dos.MapEnv{
+		agentconfig.EnvPrefixApp + "A_" + "ALPHA": "alpha",
+	})
+	a := assert.New(t)
+	f, s := makeFS(t, ctx)
+
+	ingest := func(id, client, container string) *rpc.InterceptInfo {
+		return &rpc.InterceptInfo{
+			Spec: &rpc.InterceptSpec{
+				Name:          id + "Name",
+				Client:        client,
+				Agent:         "agentName",
+				Mechanism:     "tcp",
+				Namespace:     namespace,
+				Ingest:        true,
+				ContainerName: container,
+			},
+			Id:          id,
+			Disposition: rpc.InterceptDispositionType_WAITING,
+		}
+	}
+
+	// Several clients can ingest the same container, and no traffic is redirected
+
+	reviews := s.HandleIntercepts(ctx, []*rpc.InterceptInfo{
+		ingest("ingest-01", "alice@host1", ""),
+		ingest("ingest-02", "bob@host2", "test-echo"),
+		ingest("ingest-03", "carol@host3", "no-such-container"),
+	})
+	a.Len(reviews, 3)
+	for _, r := range reviews[:2] {
+		a.Equal(rpc.InterceptDispositionType_ACTIVE, r.Disposition)
+		a.Equal(podIP, r.PodIp)
+		a.Equal(filepath.Join(agentconfig.ExportsMountPoint, "test-echo"), r.MountPoint)
+		a.Equal("alpha", r.Environment["ALPHA"])
+	}
+	a.Equal(rpc.InterceptDispositionType_AGENT_ERROR, reviews[2].Disposition)
+	a.Equal("", f.InterceptId())
+
+	// Active ingests are not reviewed again
+
+	active := ingest("ingest-01", "alice@host1", "")
+	active.Disposition = rpc.InterceptDispositionType_ACTIVE
+	reviews = s.HandleIntercepts(ctx, []*rpc.InterceptInfo{active})
+	a.Len(reviews, 0)
 }
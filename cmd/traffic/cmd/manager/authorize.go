@@ -0,0 +1,130 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authn "k8s.io/api/authentication/v1"
+	auth "k8s.io/api/authorization/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
+)
+
+// authenticate returns the user that the given bearer token belongs to, as reported by a TokenReview. The
+// identity that the LocalSubjectAccessReviews are made for must come from here, because an identity that is
+// asserted by the client can be spoofed. The given what describes the request that requires the identity.
+func authenticate(ctx context.Context, token, what string) (*authn.UserInfo, error) {
+	if token == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "a bearer token that identifies the user is required when %s", what)
+	}
+	review := &authn.TokenReview{Spec: authn.TokenReviewSpec{Token: token}}
+	tr, err := k8sapi.GetK8sInterface(ctx).AuthenticationV1().TokenReviews().Create(ctx, review, meta.CreateOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to review the token of the user: %v", err)
+	}
+	if !tr.Status.Authenticated {
+		dlog.Infof(ctx, "token review failed: %s", tr.Status.Error)
+		return nil, status.Errorf(codes.Unauthenticated, "the user could not be authenticated when %s", what)
+	}
+	return &tr.Status.User, nil
+}
+
+// authorize returns an error unless the given user is allowed to perform the action described by the given
+// resource attributes. This is verified using a LocalSubjectAccessReview, so that the traffic-manager only
+// needs permission to create such reviews in the managed namespaces. The given what describes the request
+// that requires the permission.
+func authorize(ctx context.Context, user *authn.UserInfo, ra *auth.ResourceAttributes, what string) error {
+	var extra map[string]auth.ExtraValue
+	if len(user.Extra) > 0 {
+		extra = make(map[string]auth.ExtraValue, len(user.Extra))
+		for k, v := range user.Extra {
+			extra[k] = auth.ExtraValue(v)
+		}
+	}
+	review := &auth.LocalSubjectAccessReview{
+		ObjectMeta: meta.ObjectMeta{Namespace: ra.Namespace},
+		Spec: auth.SubjectAccessReviewSpec{
+			ResourceAttributes: ra,
+			User:               user.Username,
+			UID:                user.UID,
+			Groups:             user.Groups,
+			Extra:              extra,
+		},
+	}
+	ar, err := k8sapi.GetK8sInterface(ctx).AuthorizationV1().LocalSubjectAccessReviews(ra.Namespace).Create(ctx, review, meta.CreateOptions{})
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to review the permissions of user %s: %v", user.Username, err)
+	}
+	if !ar.Status.Allowed {
+		perm := fmt.Sprintf("%s %s", ra.Verb, ra.Resource)
+		if ra.Subresource != "" {
+			perm += "/" + ra.Subresource
+		}
+		if ra.Name != "" {
+			perm += " " + ra.Name
+		}
+		dlog.Infof(ctx, "user %s is not allowed to %s in namespace %s: %s", user.Username, perm, ra.Namespace, ar.Status.Reason)
+		return status.Errorf(codes.PermissionDenied, "%s requires permission to %s in namespace %s", what, perm, ra.Namespace)
+	}
+	return nil
+}
+
+// authorizeEviction authenticates the user that the given bearer token belongs to and returns an error
+// unless that user is allowed to update the workload that the given intercept spec targets.
+func authorizeEviction(ctx context.Context, token string, spec *rpc.InterceptSpec) (*authn.UserInfo, error) {
+	const what = "evicting an intercept"
+	user, err := authenticate(ctx, token, what)
+	if err != nil {
+		return nil, err
+	}
+	kind := spec.WorkloadKind
+	if kind == "" {
+		wl, err := workload.GetWorkload(ctx, spec.Agent, spec.Namespace, "")
+		if err != nil {
+			if k8sErrors.IsNotFound(err) {
+				return nil, status.Errorf(codes.NotFound, "workload %s.%s not found", spec.Agent, spec.Namespace)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		kind = wl.GetKind()
+	}
+	gr, ok := workload.GroupResource(ctx, kind)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "unsupported workload kind %s", kind)
+	}
+	if err = authorize(ctx, user, &auth.ResourceAttributes{
+		Verb:      "update",
+		Group:     gr.Group,
+		Resource:  gr.Resource,
+		Namespace: spec.Namespace,
+		Name:      spec.Agent,
+	}, what); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// authorizeAllSessions authenticates the user that the given bearer token belongs to and returns an error
+// unless that user is allowed to read the logs of the traffic-manager's pods. Those logs reveal the
+// connections of all clients, so the same permission is required to watch them.
+func authorizeAllSessions(ctx context.Context, token string) error {
+	const what = "watching the connections of all clients"
+	user, err := authenticate(ctx, token, what)
+	if err != nil {
+		return err
+	}
+	return authorize(ctx, user, &auth.ResourceAttributes{
+		Verb:        "get",
+		Resource:    "pods",
+		Subresource: "log",
+		Namespace:   managerutil.GetEnv(ctx).ManagerNamespace,
+	}, what)
+}
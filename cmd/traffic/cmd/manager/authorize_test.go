@@ -0,0 +1,259 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apps "k8s.io/api/apps/v1"
+	authn "k8s.io/api/authentication/v1"
+	auth "k8s.io/api/authorization/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+)
+
+// tokenReviewer makes the given clientset authenticate the tokens of the given map as the mapped users.
+func tokenReviewer(cs *fake.Clientset, users map[string]authn.UserInfo) {
+	cs.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		r := action.(k8stesting.CreateAction).GetObject().(*authn.TokenReview).DeepCopy()
+		if ui, ok := users[r.Spec.Token]; ok {
+			r.Status.Authenticated = true
+			r.Status.User = ui
+		} else {
+			r.Status.Error = "invalid bearer token"
+		}
+		return true, r, nil
+	})
+}
+
+func TestAuthorizeEviction(t *testing.T) {
+	cs := fake.NewSimpleClientset(&apps.Deployment{ObjectMeta: meta.ObjectMeta{Name: "echo", Namespace: "default"}})
+	tokenReviewer(cs, map[string]authn.UserInfo{
+		"admin-token":  {Username: "admin", UID: "1"},
+		"bob-token":    {Username: "bob", UID: "2", Groups: []string{"viewers"}},
+		"editor-token": {Username: "bob", UID: "2", Groups: []string{"viewers", "editors"}},
+
+		// A token that claims to be the admin's, but that the API server authenticates as bob.
+		`{"sub":"admin"}`: {Username: "bob", UID: "2", Groups: []string{"viewers"}},
+	})
+
+	// The admin may update deployments, and members of the "editors" group may update rollouts.
+	var reviews []*auth.LocalSubjectAccessReview
+	cs.PrependReactor("create", "localsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		r := action.(k8stesting.CreateAction).GetObject().(*auth.LocalSubjectAccessReview)
+		reviews = append(reviews, r)
+		ra := r.Spec.ResourceAttributes
+		r = r.DeepCopy()
+		switch {
+		case r.Spec.User == "admin" && ra.Group == "apps" && ra.Resource == "deployments":
+			r.Status.Allowed = true
+		case ra.Group == "argoproj.io" && ra.Resource == "rollouts":
+			for _, g := range r.Spec.Groups {
+				if g == "editors" {
+					r.Status.Allowed = true
+				}
+			}
+		}
+		return true, r, nil
+	})
+
+	tests := []struct {
+		name     string
+		token    string
+		spec     *rpc.InterceptSpec
+		wantUser string
+		wantCode codes.Code
+	}{
+		{
+			name:     "allowed",
+			token:    "admin-token",
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Deployment"},
+			wantUser: "admin",
+		},
+		{
+			name:     "allowed, kind from workload",
+			token:    "admin-token",
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default"},
+			wantUser: "admin",
+		},
+		{
+			name:     "allowed by group",
+			token:    "editor-token",
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Rollout"},
+			wantUser: "bob",
+		},
+		{
+			name:     "denied",
+			token:    "bob-token",
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Deployment"},
+			wantUser: "bob",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "claimed identity differs from the authenticated one",
+			token:    `{"sub":"admin"}`,
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Deployment"},
+			wantUser: "bob",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "invalid token",
+			token:    "forged-token",
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Deployment"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "no token",
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Deployment"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "workload not found",
+			token:    "admin-token",
+			spec:     &rpc.InterceptSpec{Agent: "gone", Namespace: "default"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "unsupported kind",
+			token:    "admin-token",
+			spec:     &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Job"},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviews = nil
+			ctx := k8sapi.WithK8sInterface(dlog.NewTestContext(t, false), cs)
+			user, err := authorizeEviction(ctx, tt.token, tt.spec)
+			if tt.wantCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantUser, user.Username)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+			if tt.wantUser != "" {
+				// The review concerns the authenticated user, never the one that the client claims to be.
+				require.Len(t, reviews, 1)
+				assert.Equal(t, tt.wantUser, reviews[0].Spec.User)
+			}
+		})
+	}
+}
+
+func TestAuthorizeEviction_ReviewAttributes(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	tokenReviewer(cs, map[string]authn.UserInfo{
+		"admin-token": {Username: "admin", UID: "1", Groups: []string{"admins"}, Extra: map[string]authn.ExtraValue{"scopes": {"all"}}},
+	})
+	var review *auth.LocalSubjectAccessReview
+	cs.PrependReactor("create", "localsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review = action.(k8stesting.CreateAction).GetObject().(*auth.LocalSubjectAccessReview).DeepCopy()
+		r := review.DeepCopy()
+		r.Status.Allowed = true
+		return true, r, nil
+	})
+	ctx := k8sapi.WithK8sInterface(dlog.NewTestContext(t, false), cs)
+	_, err := authorizeEviction(ctx, "admin-token", &rpc.InterceptSpec{Agent: "echo", Namespace: "default", WorkloadKind: "Deployment"})
+	require.NoError(t, err)
+
+	// The review concerns the authenticated user and the workload in the namespace of the intercept.
+	require.NotNil(t, review)
+	assert.Equal(t, "default", review.Namespace)
+	assert.Equal(t, auth.SubjectAccessReviewSpec{
+		ResourceAttributes: &auth.ResourceAttributes{
+			Verb:      "update",
+			Group:     "apps",
+			Resource:  "deployments",
+			Namespace: "default",
+			Name:      "echo",
+		},
+		User:   "admin",
+		UID:    "1",
+		Groups: []string{"admins"},
+		Extra:  map[string]auth.ExtraValue{"scopes": {"all"}},
+	}, review.Spec)
+}
+
+func TestAuthorizeAllSessions(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	tokenReviewer(cs, map[string]authn.UserInfo{
+		"admin-token": {Username: "admin"},
+		"bob-token":   {Username: "bob"},
+
+		// A token that claims to be the admin's, but that the API server authenticates as bob.
+		`{"sub":"admin"}`: {Username: "bob"},
+	})
+	var reviewed []string
+	cs.PrependReactor("create", "localsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		r := action.(k8stesting.CreateAction).GetObject().(*auth.LocalSubjectAccessReview).DeepCopy()
+		reviewed = append(reviewed, r.Spec.User)
+		ra := r.Spec.ResourceAttributes
+		r.Status.Allowed = r.Spec.User == "admin" && r.Namespace == "ambassador" &&
+			ra.Namespace == "ambassador" && ra.Verb == "get" && ra.Resource == "pods" && ra.Subresource == "log"
+		return true, r, nil
+	})
+
+	tests := []struct {
+		name     string
+		token    string
+		wantUser string
+		wantCode codes.Code
+	}{
+		{
+			name:     "allowed",
+			token:    "admin-token",
+			wantUser: "admin",
+		},
+		{
+			name:     "denied",
+			token:    "bob-token",
+			wantUser: "bob",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "claimed identity differs from the authenticated one",
+			token:    `{"sub":"admin"}`,
+			wantUser: "bob",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "invalid token",
+			token:    "forged-token",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "no token",
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewed = nil
+			ctx := dlog.NewTestContext(t, false)
+			ctx = managerutil.WithEnv(ctx, &managerutil.Env{ManagerNamespace: "ambassador"})
+			ctx = k8sapi.WithK8sInterface(ctx, cs)
+			err := authorizeAllSessions(ctx, tt.token)
+			if tt.wantCode == codes.OK {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+			}
+			if tt.wantUser == "" {
+				assert.Empty(t, reviewed)
+			} else {
+				assert.Equal(t, []string{tt.wantUser}, reviewed)
+			}
+		})
+	}
+}
@@ -118,63 +118,25 @@ func NewInfo(ctx context.Context) Info {
 			oi.clusterID, err)
 	}
 
-	dummyIP := "1.1.1.1"
-	oi.InjectorSvcIp, oi.InjectorSvcPort, err = getInjectorSvcIP(ctx, env, client)
+	injectorIPs, port, err := getInjectorSvcIPs(ctx, env, client)
 	if err != nil {
 		dlog.Warn(ctx, err)
-	} else if len(oi.InjectorSvcIp) == 16 {
-		// Must use an IPv6 IP to get the correct error message.
-		dummyIP = "1:1::1"
+	} else {
+		oi.InjectorSvcIp, oi.InjectorSvcPort = injectorIPs[0], port
 	}
 
-	// make an attempt to create a service with ClusterIP that is out of range and then
-	// check the error message for the correct range as suggested tin the second answer here:
-	//   https://stackoverflow.com/questions/44190607/how-do-you-find-the-cluster-service-cidr-of-a-kubernetes-cluster
-	// This requires an additional permission to create a service, which the traffic-manager
-	// should have.
-	svc := corev1.Service{
-		TypeMeta: metav1.TypeMeta{
-			Kind: "Service",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: env.ManagerNamespace,
-			Name:      "t2-tst-dummy",
-		},
-		Spec: corev1.ServiceSpec{
-			Ports:     []corev1.ServicePort{{Port: 443}},
-			ClusterIP: dummyIP,
-		},
+	// A dual-stack cluster has one service subnet for each IP family. The agent-injector service tells us which
+	// families that are in use, and in what order. Assume IPv4 only when that service isn't available.
+	if len(injectorIPs) == 0 {
+		injectorIPs = []net.IP{nil}
 	}
-
-	if _, err = client.Services(env.ManagerNamespace).Create(ctx, &svc, metav1.CreateOptions{}); err != nil {
-		svcCIDRrx := regexp.MustCompile(`range of valid IPs is (.*)$`)
-		if match := svcCIDRrx.FindStringSubmatch(err.Error()); match != nil {
-			var cidr *net.IPNet
-			if _, cidr, err = net.ParseCIDR(match[1]); err != nil {
-				dlog.Errorf(ctx, "unable to parse service CIDR %q", match[1])
-			} else {
-				dlog.Infof(ctx, "Extracting service subnet %v from create service error message", cidr)
-				oi.ServiceSubnet = iputil.IPNetToRPC(cidr)
-			}
-		} else {
-			dlog.Errorf(ctx, "unable to extract service subnet from error message %q", err.Error())
+	for _, ip := range injectorIPs {
+		if cidr := getServiceSubnet(ctx, env, client, ip); cidr != nil {
+			oi.ServiceSubnets = append(oi.ServiceSubnets, cidr)
 		}
 	}
-
-	if err != nil {
-		dlog.Warn(ctx, err)
-	}
-	if oi.ServiceSubnet == nil && len(oi.InjectorSvcIp) > 0 {
-		// Using a "kubectl cluster-info dump" or scanning all services generates a lot of unwanted traffic
-		// and would quite possibly also require elevated permissions, so instead, we derive the service subnet
-		// from the agent-injector service IP (the traffic-manager has clusterIP=None). This is cheating but
-		// a cluster may only have one service subnet and the mask is unlikely to cover less than half the bits.
-		ip := net.IP(oi.InjectorSvcIp)
-		dlog.Infof(ctx, "Deriving serviceSubnet from %s (the IP of agent-injector.%s)", ip, env.ManagerNamespace)
-		bits := len(ip) * 8
-		ones := bits / 2
-		mask := net.CIDRMask(ones, bits) // will yield a 16 bit mask on IPv4 and 64 bit mask on IPv6.
-		oi.ServiceSubnet = &rpc.IPNet{Ip: ip.Mask(mask), Mask: int32(ones)}
+	if len(oi.ServiceSubnets) > 0 {
+		oi.ServiceSubnet = oi.ServiceSubnets[0]
 	}
 
 	podCIDRStrategy := env.PodCIDRStrategy
@@ -297,7 +259,9 @@ func (oi *info) watchNodeSubnets(ctx context.Context, mustSucceed bool) bool {
 	return true
 }
 
-func getInjectorSvcIP(ctx context.Context, env *managerutil.Env, client v1.CoreV1Interface) ([]byte, int32, error) {
+// getInjectorSvcIPs returns the cluster IPs of the agent-injector service, one for each IP family that the
+// service uses with the primary family first, and the port of its "https" endpoint.
+func getInjectorSvcIPs(ctx context.Context, env *managerutil.Env, client v1.CoreV1Interface) ([]net.IP, int32, error) {
 	sc, err := client.Services(env.ManagerNamespace).Get(ctx, env.AgentInjectorName, metav1.GetOptions{})
 	if err != nil {
 		return nil, 0, err
@@ -309,7 +273,89 @@ func getInjectorSvcIP(ctx context.Context, env *managerutil.Env, client v1.CoreV
 			break
 		}
 	}
-	return iputil.Parse(sc.Spec.ClusterIP), p, nil
+	clusterIPs := sc.Spec.ClusterIPs
+	if len(clusterIPs) == 0 {
+		clusterIPs = []string{sc.Spec.ClusterIP}
+	}
+	ips := make([]net.IP, 0, len(clusterIPs))
+	for _, cip := range clusterIPs {
+		if ip := iputil.Parse(cip); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, 0, fmt.Errorf("service %s.%s has no cluster IP", env.AgentInjectorName, env.ManagerNamespace)
+	}
+	return ips, p, nil
+}
+
+// getServiceSubnet returns the service subnet of the IP family of the given IP, which is either the IP
+// of a service or nil, in which case IPv4 is assumed.
+func getServiceSubnet(ctx context.Context, env *managerutil.Env, client v1.CoreV1Interface, ip net.IP) *rpc.IPNet {
+	dummyIP := "1.1.1.1"
+	family := corev1.IPv4Protocol
+	if len(ip) == 16 {
+		// Must use an IPv6 IP to get the correct error message.
+		dummyIP = "1:1::1"
+		family = corev1.IPv6Protocol
+	}
+
+	// make an attempt to create a service with ClusterIP that is out of range and then
+	// check the error message for the correct range as suggested tin the second answer here:
+	//   https://stackoverflow.com/questions/44190607/how-do-you-find-the-cluster-service-cidr-of-a-kubernetes-cluster
+	// This requires an additional permission to create a service, which the traffic-manager
+	// should have. The IP family is explicit, so that a dual-stack cluster reports the range
+	// of the family that we ask for.
+	singleStack := corev1.IPFamilyPolicySingleStack
+	svc := corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: env.ManagerNamespace,
+			Name:      "t2-tst-dummy",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports:          []corev1.ServicePort{{Port: 443}},
+			ClusterIP:      dummyIP,
+			IPFamilies:     []corev1.IPFamily{family},
+			IPFamilyPolicy: &singleStack,
+		},
+	}
+
+	_, err := client.Services(env.ManagerNamespace).Create(ctx, &svc, metav1.CreateOptions{})
+	if err != nil {
+		svcCIDRrx := regexp.MustCompile(`range of valid IPs is (.*)$`)
+		if match := svcCIDRrx.FindStringSubmatch(err.Error()); match != nil {
+			var cidr *net.IPNet
+			if _, cidr, err = net.ParseCIDR(match[1]); err != nil {
+				dlog.Errorf(ctx, "unable to parse service CIDR %q", match[1])
+			} else {
+				dlog.Infof(ctx, "Extracting service subnet %v from create service error message", cidr)
+				return iputil.IPNetToRPC(cidr)
+			}
+		} else {
+			dlog.Errorf(ctx, "unable to extract service subnet from error message %q", err.Error())
+		}
+	}
+
+	if err != nil {
+		dlog.Warn(ctx, err)
+	}
+	if len(ip) == 0 {
+		return nil
+	}
+
+	// Using a "kubectl cluster-info dump" or scanning all services generates a lot of unwanted traffic
+	// and would quite possibly also require elevated permissions, so instead, we derive the service subnet
+	// from the agent-injector service IP (the traffic-manager has clusterIP=None). This is cheating but
+	// a cluster may only have one service subnet per IP family and the mask is unlikely to cover less
+	// than half the bits.
+	dlog.Infof(ctx, "Deriving serviceSubnet from %s (the IP of agent-injector.%s)", ip, env.ManagerNamespace)
+	bits := len(ip) * 8
+	ones := bits / 2
+	mask := net.CIDRMask(ones, bits) // will yield a 16 bit mask on IPv4 and 64 bit mask on IPv6.
+	return &rpc.IPNet{Ip: ip.Mask(mask), Mask: int32(ones)}
 }
 
 func (oi *info) watchPodSubnets(ctx context.Context, namespaces []string) {
@@ -432,6 +478,7 @@ func (oi *info) clusterInfo() *rpc.ClusterInfo {
 
 	ci := &rpc.ClusterInfo{
 		ServiceSubnet:   oi.ServiceSubnet,
+		ServiceSubnets:  oi.ServiceSubnets,
 		PodSubnets:      make([]*rpc.IPNet, len(oi.PodSubnets)),
 		ManagerPodIp:    oi.ManagerPodIp,
 		ManagerPodPort:  oi.ManagerPodPort,
@@ -2,17 +2,23 @@ package cluster
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/datawire/k8sapi/pkg/k8sapi"
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
 )
 
 func TestNewInfo_GetClusterID(t *testing.T) {
@@ -72,3 +78,57 @@ func TestNewInfo_GetClusterID(t *testing.T) {
 		require.Equal(t, info.ID(), testUID)
 	})
 }
+
+func TestNewInfo_DualStackServiceSubnets(t *testing.T) {
+	env := managerutil.Env{
+		ManagerNamespace:  "test",
+		AgentInjectorName: "agent-injector",
+	}
+	injector := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "agent-injector",
+		},
+		Spec: v1.ServiceSpec{
+			ClusterIP:  "10.96.12.1",
+			ClusterIPs: []string{"10.96.12.1", "fd00:10:96::c01"},
+			Ports:      []v1.ServicePort{{Name: "https", Port: 443}},
+		},
+	}
+
+	t.Run("from create service error", func(t *testing.T) {
+		cs := fake.NewSimpleClientset(injector)
+		cs.PrependReactor("create", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			svc := action.(k8stesting.CreateAction).GetObject().(*v1.Service)
+			valid := "10.96.0.0/12"
+			if svc.Spec.IPFamilies[0] == v1.IPv6Protocol {
+				valid = "fd00:10:96::/112"
+			}
+			return true, nil, fmt.Errorf("the provided IP (%s) is not in the valid range. The range of valid IPs is %s", svc.Spec.ClusterIP, valid)
+		})
+		ctx := k8sapi.WithK8sInterface(context.Background(), cs)
+		ctx = managerutil.WithEnv(ctx, &env)
+
+		ci := NewInfo(ctx).(*info).clusterInfo()
+		require.Len(t, ci.ServiceSubnets, 2)
+		assert.Equal(t, "10.96.0.0/12", iputil.IPNetFromRPC(ci.ServiceSubnets[0]).String())
+		assert.Equal(t, "fd00:10:96::/112", iputil.IPNetFromRPC(ci.ServiceSubnets[1]).String())
+		assert.Equal(t, ci.ServiceSubnets[0], ci.ServiceSubnet)
+		assert.Equal(t, net.IP{10, 96, 12, 1}, net.IP(ci.InjectorSvcIp))
+		assert.Equal(t, int32(443), ci.InjectorSvcPort)
+	})
+
+	t.Run("derived from agent-injector IPs", func(t *testing.T) {
+		cs := fake.NewSimpleClientset(injector)
+		cs.PrependReactor("create", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("forbidden")
+		})
+		ctx := k8sapi.WithK8sInterface(context.Background(), cs)
+		ctx = managerutil.WithEnv(ctx, &env)
+
+		ci := NewInfo(ctx).(*info).clusterInfo()
+		require.Len(t, ci.ServiceSubnets, 2)
+		assert.Equal(t, "10.96.0.0/16", iputil.IPNetFromRPC(ci.ServiceSubnets[0]).String())
+		assert.Equal(t, "fd00:10:96::/64", iputil.IPNetFromRPC(ci.ServiceSubnets[1]).String())
+	})
+}
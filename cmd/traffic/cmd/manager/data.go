@@ -6,7 +6,10 @@ import (
 
 	"github.com/blang/semver"
 
+	core "k8s.io/api/core/v1"
+
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
 )
 
 func validateClient(client *rpc.ClientInfo) string {
@@ -76,7 +79,63 @@ func validateIntercept(spec *rpc.InterceptSpec) string {
 		return "namespace must not be empty"
 	case spec.Mechanism == "":
 		return "mechanism must not be empty"
+	case spec.Weight < 0 || spec.Weight > 100:
+		return fmt.Sprintf("weight %d is not between 0 and 100", spec.Weight)
+	case spec.Ingest && (spec.Replace || spec.Mirror || spec.Weight > 0 || spec.Source != nil):
+		return "an ingest redirects no traffic, so it cannot be combined with replace, mirror, weight, or source"
+	case spec.Ttl < 0:
+		return "ttl must not be negative"
+	case spec.IdleTimeout < 0:
+		return "idle timeout must not be negative"
 	}
 
 	return ""
 }
+
+func validateInterceptUpdate(spec *rpc.InterceptSpec, req *rpc.UpdateInterceptRequest) string {
+	switch {
+	case req.TargetPort < 0 || req.TargetPort > 0xffff:
+		return fmt.Sprintf("target port %d is out of range", req.TargetPort)
+	case req.MechanismArgs != nil && spec.Mechanism == "tcp" && len(req.MechanismArgs.Args) > 0:
+		return `mechanism "tcp" does not accept any arguments`
+	}
+	for _, ports := range [][]string{req.AddLocalPorts, req.RemoveLocalPorts} {
+		for _, port := range ports {
+			if _, err := agentconfig.NewPortAndProto(port); err != nil {
+				return err.Error()
+			}
+		}
+	}
+	return ""
+}
+
+// updateLocalPorts returns the result of adding and removing ports from the given local ports, together
+// with the TCP ports of that result, which older clients use as extra ports. The ports must be valid.
+func updateLocalPorts(localPorts, add, remove []string) ([]string, []int32) {
+	removed := make(map[agentconfig.PortAndProto]struct{}, len(remove))
+	for _, port := range remove {
+		pp, _ := agentconfig.NewPortAndProto(port)
+		removed[pp] = struct{}{}
+	}
+
+	var lps []string
+	var eps []int32
+	for _, ports := range [][]string{localPorts, add} {
+		for _, port := range ports {
+			pp, err := agentconfig.NewPortAndProto(port)
+			if err != nil {
+				continue
+			}
+			if _, skip := removed[pp]; skip {
+				continue
+			}
+			// Prevents duplicates
+			removed[pp] = struct{}{}
+			lps = append(lps, pp.String())
+			if pp.Proto == core.ProtocolTCP {
+				eps = append(eps, int32(pp.Port))
+			}
+		}
+	}
+	return lps, eps
+}
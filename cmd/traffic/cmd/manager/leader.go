@@ -0,0 +1,323 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/datawire/dlib/dgroup"
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/dlib/dtime"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+// leaseName is the name of the Lease that the traffic-manager replicas use for leader election.
+const leaseName = "traffic-manager"
+
+// leaderRetryDelay is the time to wait before retrying to connect to a newly elected leader.
+var leaderRetryDelay = 2 * time.Second //nolint:gochecknoglobals // changed by tests
+
+// leaderDialTimeout is the time to wait for the connection to a newly elected leader to become ready.
+var leaderDialTimeout = 5 * time.Second //nolint:gochecknoglobals // changed by tests
+
+// leader keeps track of the replica that currently leads.
+type leader struct {
+	sync.RWMutex
+	identity string // identity of this replica
+	elected  string // identity of the most recently elected leader
+	current  string // identity of the current leader, empty until a connection to the elected leader is established
+	ip       net.IP // IP of the current leader, unless it's this replica
+	conn     *grpc.ClientConn
+}
+
+// runLeaderElection takes part in the election of the leader among the traffic-manager replicas. Only the
+// leader keeps the state of sessions and intercepts. The other replicas forward all calls to the Manager
+// service to the leader. This function returns an error if this replica loses its leadership, so that the
+// traffic-manager is restarted and reenters the election with a clean state.
+func (s *service) runLeaderElection(ctx context.Context) error {
+	env := managerutil.GetEnv(ctx)
+	ki := k8sapi.GetK8sInterface(ctx)
+	if s.store == nil {
+		dlog.Warn(ctx, "Leader election is enabled without a state store. Sessions will be lost when the leader changes")
+	}
+	le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta:  meta.ObjectMeta{Name: leaseName, Namespace: env.ManagerNamespace},
+			Client:     ki.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: s.leader.identity},
+		},
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		ReleaseOnCancel: true,
+		Name:            leaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: s.lead,
+			OnStoppedLeading: func() {
+				dlog.Infof(ctx, "%s stopped leading", s.leader.identity)
+			},
+			OnNewLeader: func(identity string) {
+				s.setLeader(ctx, identity)
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	le.Run(ctx)
+	if ctx.Err() == nil {
+		return errors.New("leadership lost")
+	}
+	return nil
+}
+
+// lead restores the persisted state and then runs the session GC and the state persistence until the
+// given context is cancelled, which happens when this replica stops leading.
+func (s *service) lead(ctx context.Context) {
+	dlog.Infof(ctx, "%s started leading", s.leader.identity)
+	s.restoreState(ctx)
+	g := dgroup.NewGroup(ctx, dgroup.GroupConfig{})
+	g.Go("session-gc", s.runSessionGCLoop)
+	g.Go("state-store", s.persistState)
+	if err := g.Wait(); err != nil {
+		dlog.Error(ctx, err)
+	}
+}
+
+// setLeader is called when a new leader has been elected. Unless that leader is this replica, a
+// connection is established to it so that calls can be forwarded. The leader is cleared while the
+// connection can't be established, and the attempt is retried until it succeeds or another leader
+// is elected.
+func (s *service) setLeader(ctx context.Context, identity string) {
+	l := s.leader
+	l.Lock()
+	l.elected = identity
+	l.Unlock()
+	for {
+		ip, conn, err := s.dialLeader(ctx, identity)
+		l.Lock()
+		if l.elected != identity {
+			// Another leader has been elected while dialing.
+			l.Unlock()
+			if conn != nil {
+				_ = conn.Close()
+			}
+			return
+		}
+		oldConn := l.conn
+		if err == nil {
+			l.current = identity
+		} else {
+			l.current = ""
+		}
+		l.ip = ip
+		l.conn = conn
+		l.Unlock()
+		if oldConn != nil {
+			_ = oldConn.Close()
+		}
+		if err == nil {
+			dlog.Infof(ctx, "%s is the leader", identity)
+			return
+		}
+		dlog.Errorf(ctx, "%v, retrying in %s", err, leaderRetryDelay)
+		if dtime.SleepWithContext(ctx, leaderRetryDelay); ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// dialLeader returns the IP of, and a connection to, the leader with the given identity. Both are nil when
+// the identity is this replica's identity.
+func (s *service) dialLeader(ctx context.Context, identity string) (net.IP, *grpc.ClientConn, error) {
+	if identity == s.leader.identity {
+		return nil, nil, nil
+	}
+	env := managerutil.GetEnv(ctx)
+	pod, err := k8sapi.GetK8sInterface(ctx).CoreV1().Pods(env.ManagerNamespace).Get(ctx, identity, meta.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get leader pod %s: %w", identity, err)
+	}
+	ip := iputil.Parse(pod.Status.PodIP)
+	if ip == nil {
+		return nil, nil, fmt.Errorf("leader pod %s has no IP", identity)
+	}
+	// Block until the connection is ready, so that an unreachable leader is retried by setLeader.
+	dCtx, cancel := context.WithTimeout(ctx, leaderDialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(dCtx, iputil.JoinHostPort(ip.String(), env.ServerPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to connect to leader %s: %w", identity, err)
+	}
+	return ip, conn, nil
+}
+
+// isLeader returns true unless leader election is enabled and this replica isn't the leader.
+func (s *service) isLeader() bool {
+	l := s.leader
+	if l == nil {
+		return true
+	}
+	l.RLock()
+	defer l.RUnlock()
+	return l.current == l.identity
+}
+
+// leaderConn returns the connection to use when forwarding a call to the given method, or nil if the
+// call should be served by this replica.
+func (s *service) leaderConn(fullMethod string) (*grpc.ClientConn, error) {
+	l := s.leader
+	if l == nil || !strings.HasPrefix(fullMethod, "/"+rpc.Manager_ServiceDesc.ServiceName+"/") {
+		return nil, nil
+	}
+	l.RLock()
+	defer l.RUnlock()
+	switch l.current {
+	case l.identity:
+		return nil, nil
+	case "":
+		return nil, status.Error(codes.Unavailable, "no traffic-manager leader has been elected yet")
+	default:
+		return l.conn, nil
+	}
+}
+
+// leaderIP returns the IP of the leader, or nil if this replica is the leader or if there's no leader
+// election.
+func (s *service) leaderIP() net.IP {
+	l := s.leader
+	if l == nil {
+		return nil
+	}
+	l.RLock()
+	defer l.RUnlock()
+	return l.ip
+}
+
+// frame is a message that is forwarded without being decoded.
+type frame struct {
+	payload []byte
+}
+
+// forwardCodec is a proto codec that passes frames through as is.
+type forwardCodec struct{}
+
+func (forwardCodec) Marshal(v any) ([]byte, error) {
+	if f, ok := v.(*frame); ok {
+		return f.payload, nil
+	}
+	return proto.Marshal(v.(proto.Message))
+}
+
+func (forwardCodec) Unmarshal(data []byte, v any) error {
+	if f, ok := v.(*frame); ok {
+		f.payload = append([]byte(nil), data...)
+		return nil
+	}
+	return proto.Unmarshal(data, v.(proto.Message))
+}
+
+func (forwardCodec) Name() string {
+	return "proto"
+}
+
+// forwardUnary is a unary interceptor that forwards calls to the leader.
+func (s *service) forwardUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	conn, err := s.leaderConn(info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return handler(ctx, req)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	var header, trailer metadata.MD
+	rsp := &frame{}
+	err = conn.Invoke(metadata.NewOutgoingContext(ctx, md), info.FullMethod, req, rsp,
+		grpc.ForceCodec(forwardCodec{}), grpc.Header(&header), grpc.Trailer(&trailer))
+	_ = grpc.SetHeader(ctx, header)
+	_ = grpc.SetTrailer(ctx, trailer)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// forwardStream is a stream interceptor that forwards calls to the leader.
+func (s *service) forwardStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	conn, err := s.leaderConn(info.FullMethod)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return handler(srv, ss)
+	}
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	md, _ := metadata.FromIncomingContext(ctx)
+	desc := &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}
+	cs, err := conn.NewStream(metadata.NewOutgoingContext(ctx, md), desc, info.FullMethod, grpc.ForceCodec(forwardCodec{}))
+	if err != nil {
+		return err
+	}
+
+	// Forward messages from the caller to the leader.
+	go func() {
+		for {
+			f := &frame{}
+			if err := ss.RecvMsg(f); err != nil {
+				if errors.Is(err, io.EOF) {
+					_ = cs.CloseSend()
+				} else {
+					cancel()
+				}
+				return
+			}
+			if err := cs.SendMsg(f); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Forward messages from the leader to the caller.
+	headerSent := false
+	for {
+		f := &frame{}
+		if err := cs.RecvMsg(f); err != nil {
+			ss.SetTrailer(cs.Trailer())
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if !headerSent {
+			if header, err := cs.Header(); err == nil {
+				_ = ss.SendHeader(header)
+			}
+			headerSent = true
+		}
+		if err := ss.SendMsg(f); err != nil {
+			return err
+		}
+	}
+}
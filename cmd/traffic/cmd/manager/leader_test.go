@@ -0,0 +1,166 @@
+package manager
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+	"github.com/telepresenceio/telepresence/v2/pkg/version"
+)
+
+// serveBufconn serves the given service on a bufconn listener and returns a connection to it.
+func serveBufconn(ctx context.Context, t *testing.T, s *service, opts ...grpc.ServerOption) *grpc.ClientConn {
+	lis := bufconn.Listen(64 * 1024)
+	srv := grpc.NewServer(opts...)
+	rpc.RegisterManagerServer(srv, s)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestForwardToLeader(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	version.Version, version.Structured = version.Init("0.0.0-testing", "TELEPRESENCE_VERSION")
+
+	leaderSvc := &service{ctx: ctx, clock: wall{}, state: state.NewState(ctx)}
+	leaderConn := serveBufconn(ctx, t, leaderSvc)
+
+	follower := &service{ctx: ctx, clock: wall{}, state: state.NewState(ctx), leader: &leader{identity: "follower"}}
+	client := rpc.NewManagerClient(serveBufconn(ctx, t, follower,
+		grpc.ForceServerCodec(forwardCodec{}),
+		grpc.ChainUnaryInterceptor(follower.forwardUnary),
+		grpc.ChainStreamInterceptor(follower.forwardStream)))
+
+	// Calls fail until a leader has been elected
+	_, err := client.Version(ctx, &empty.Empty{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	follower.leader.current = "leader"
+	follower.leader.conn = leaderConn
+
+	ver, err := client.Version(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, version.Version, ver.Version)
+
+	// The session only exists in the leader
+	sessionID := leaderSvc.state.AddClient(&rpc.ClientInfo{Name: "alice", InstallId: "abc", Product: "test", Version: "2.19.0"}, time.Now())
+	session := &rpc.SessionInfo{SessionId: sessionID}
+	_, err = client.Remain(ctx, &rpc.RemainRequest{Session: session})
+	require.NoError(t, err)
+
+	leaderSvc.state.AddAgent(&rpc.AgentInfo{Name: "hello", Namespace: "default", Product: "test", Version: "2.19.0"}, time.Now())
+	wCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.WatchAgents(wCtx, session)
+	require.NoError(t, err)
+	snapshot, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, snapshot.Agents, 1)
+	assert.Equal(t, "hello", snapshot.Agents[0].Name)
+
+	// Errors from the leader are passed on
+	_, err = client.Remain(ctx, &rpc.RemainRequest{Session: &rpc.SessionInfo{SessionId: "unknown"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Empty(t, follower.state.GetAllClients())
+
+	// The leader serves its own calls
+	follower.leader.current = "follower"
+	_, err = client.Remain(ctx, &rpc.RemainRequest{Session: session})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSetLeader(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+
+	// The new leader accepts TCP connections but doesn't serve gRPC until told to.
+	lis, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	defer lis.Close()
+	ctx = managerutil.WithEnv(ctx, &managerutil.Env{ManagerNamespace: "ambassador", ServerPort: uint16(lis.Addr().(*net.TCPAddr).Port)})
+	cs := fake.NewSimpleClientset()
+	ctx = k8sapi.WithK8sInterface(ctx, cs)
+	defer func(d time.Duration) { leaderRetryDelay = d }(leaderRetryDelay)
+	leaderRetryDelay = 10 * time.Millisecond
+	defer func(d time.Duration) { leaderDialTimeout = d }(leaderDialTimeout)
+	leaderDialTimeout = 100 * time.Millisecond
+
+	oldConn, err := grpc.DialContext(ctx, "old:8081", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	s := &service{ctx: ctx, leader: &leader{identity: "self", current: "old", conn: oldConn}}
+	assert.False(t, s.isLeader())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.setLeader(ctx, "new")
+	}()
+
+	// The old leader is cleared while the new one's pod can't be found.
+	require.Eventually(t, func() bool {
+		s.leader.RLock()
+		defer s.leader.RUnlock()
+		return s.leader.current == "" && s.leader.conn == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = s.leaderConn("/" + rpc.Manager_ServiceDesc.ServiceName + "/Version")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// The leader remains cleared while the pod exists but can't be reached.
+	_, err = cs.CoreV1().Pods("ambassador").Create(ctx, &core.Pod{
+		ObjectMeta: meta.ObjectMeta{Name: "new", Namespace: "ambassador"},
+		Status:     core.PodStatus{PodIP: "127.0.0.1"},
+	}, meta.CreateOptions{})
+	require.NoError(t, err)
+	select {
+	case <-done:
+		t.Fatal("setLeader accepted a leader that can't be reached")
+	case <-time.After(3 * leaderDialTimeout):
+	}
+	_, err = s.leaderConn("/" + rpc.Manager_ServiceDesc.ServiceName + "/Version")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// The retry succeeds once the leader serves gRPC.
+	srv := grpc.NewServer()
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	defer srv.Stop()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("setLeader did not retry")
+	}
+	assert.Equal(t, "new", s.leader.current)
+	assert.Equal(t, "127.0.0.1", s.leaderIP().String())
+	_ = s.leader.conn.Close()
+
+	// Electing this replica makes it the leader.
+	s.setLeader(ctx, "self")
+	assert.True(t, s.isLeader())
+	assert.Nil(t, s.leaderIP())
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
 	"github.com/telepresenceio/telepresence/v2/pkg/version"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 var (
@@ -94,6 +96,13 @@ func MainWithEnv(ctx context.Context) (err error) {
 	}
 	ctx = k8sapi.WithK8sInterface(ctx, ki)
 
+	di, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to create the Kubernetes dynamic Interface from InClusterConfig: %w", err)
+	}
+	ctx = workload.WithDynamicInterface(ctx, di)
+	ctx = workload.WithCustomKinds(ctx, env.CustomWorkloadKinds)
+
 	// Ensure that the manager has access to shard informer factories for all relevant namespaces.
 	if len(env.ManagedNamespaces) == 0 {
 		ctx = informer.WithFactory(ctx, "")
@@ -118,14 +127,23 @@ func MainWithEnv(ctx context.Context) (err error) {
 
 	g.Go("prometheus", mgr.servePrometheus)
 
+	g.Go("preview", mgr.servePreview)
+
 	g.Go("agent-injector", func(ctx context.Context) error {
 		if managerutil.GetAgentImageRetriever(ctx) == nil {
 			return nil
 		}
-		return mutator.ServeMutator(ctx)
+		// All replicas serve the webhook, but only the leader triggers rollouts.
+		return mutator.ServeMutator(managerutil.WithLeaderCheck(ctx, mgr.isLeader))
 	})
 
-	g.Go("session-gc", mgr.runSessionGCLoop)
+	if env.LeaderElection {
+		// The session GC and the state store are started when this replica becomes the leader.
+		g.Go("leader-election", mgr.runLeaderElection)
+	} else {
+		g.Go("session-gc", mgr.runSessionGCLoop)
+		g.Go("state-store", mgr.persistState)
+	}
 
 	if tracer != nil {
 		g.Go("tracer-grpc", func(c context.Context) error {
@@ -251,6 +269,13 @@ func (s *service) serveHTTP(ctx context.Context) error {
 	if mz, ok := env.MaxReceiveSize.AsInt64(); ok {
 		opts = append(opts, grpc.MaxRecvMsgSize(int(mz)))
 	}
+	if s.leader != nil {
+		// Calls to the Manager service are forwarded to the leader unless this replica is the leader.
+		opts = append(opts,
+			grpc.ForceServerCodec(forwardCodec{}),
+			grpc.ChainUnaryInterceptor(s.forwardUnary),
+			grpc.ChainStreamInterceptor(s.forwardStream))
+	}
 
 	grpcHandler := grpc.NewServer(opts...)
 	httpHandler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
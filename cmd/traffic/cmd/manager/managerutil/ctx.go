@@ -29,3 +29,20 @@ func GetSessionID(ctx context.Context) string {
 }
 
 type sessionContextKey struct{}
+
+// WithLeaderCheck returns a context that carries a function that tells if this traffic-manager replica is
+// the leader.
+func WithLeaderCheck(ctx context.Context, isLeader func() bool) context.Context {
+	return context.WithValue(ctx, leaderCheckKey{}, isLeader)
+}
+
+// IsLeader returns true if this traffic-manager replica is the leader, which it always is unless the context
+// carries a leader check that says otherwise.
+func IsLeader(ctx context.Context) bool {
+	if isLeader, ok := ctx.Value(leaderCheckKey{}).(func() bool); ok {
+		return isLeader()
+	}
+	return true
+}
+
+type leaderCheckKey struct{}
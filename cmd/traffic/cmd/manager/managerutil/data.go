@@ -4,6 +4,13 @@ import (
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
 )
 
+// AgentServesIntercept returns whether the given agent is one of the agents that serve an intercept with
+// the given spec. Those are all the agents of the intercepted workload, unless the spec restricts the
+// intercept to the agent of a single pod.
+func AgentServesIntercept(agent *rpc.AgentInfo, spec *rpc.InterceptSpec) bool {
+	return agent.Name == spec.Agent && agent.Namespace == spec.Namespace && (spec.PodName == "" || agent.PodName == spec.PodName)
+}
+
 // AgentsAreCompatible returns whether all the specified agents have the same
 // product, version, and mechanisms. This might not be true for a number of
 // reasons, such as when a deployment is restarting its Pods for an upgrade.
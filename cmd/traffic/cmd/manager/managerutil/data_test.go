@@ -46,3 +46,14 @@ func TestAgentsAreCompatible(t *testing.T) {
 	a.False(AgentsAreCompatible([]*rpc.AgentInfo{}))
 	a.False(AgentsAreCompatible([]*rpc.AgentInfo{helloAgent, helloProAgent}))
 }
+
+func TestAgentServesIntercept(t *testing.T) {
+	a := assert.New(t)
+
+	agent := &rpc.AgentInfo{Name: "echo", Namespace: "default", PodName: "echo-7d9f-x2k4p"}
+	a.True(AgentServesIntercept(agent, &rpc.InterceptSpec{Agent: "echo", Namespace: "default"}))
+	a.True(AgentServesIntercept(agent, &rpc.InterceptSpec{Agent: "echo", Namespace: "default", PodName: "echo-7d9f-x2k4p"}))
+	a.False(AgentServesIntercept(agent, &rpc.InterceptSpec{Agent: "echo", Namespace: "default", PodName: "echo-7d9f-b5rtq"}))
+	a.False(AgentServesIntercept(agent, &rpc.InterceptSpec{Agent: "echo", Namespace: "other"}))
+	a.False(AgentServesIntercept(agent, &rpc.InterceptSpec{Agent: "hello", Namespace: "default"}))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
 	"github.com/telepresenceio/telepresence/v2/pkg/agentmap"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 // Env is the traffic-manager's environment. It does not define any defaults because all
@@ -40,13 +41,24 @@ type Env struct {
 	ManagedNamespaces   []string      `env:"MANAGED_NAMESPACES,       parser=split-trim,  default="`
 	APIPort             uint16        `env:"AGENT_REST_API_PORT,      parser=port-number, default=0"`
 	AgentArrivalTimeout time.Duration `env:"AGENT_ARRIVAL_TIMEOUT,    parser=time.ParseDuration"`
+	InterceptMaxTTL     time.Duration `env:"INTERCEPT_MAX_TTL,        parser=time.ParseDuration, default=0s"`
 
 	TracingGrpcPort uint16            `env:"TRACING_GRPC_PORT,     parser=port-number,default=0"`
 	MaxReceiveSize  resource.Quantity `env:"GRPC_MAX_RECEIVE_SIZE, parser=quantity"`
 
+	PreviewDomain string `env:"PREVIEW_DOMAIN, parser=string,      default="`
+	PreviewPort   uint16 `env:"PREVIEW_PORT,   parser=port-number, default=0"`
+	PreviewScheme string `env:"PREVIEW_SCHEME, parser=string,      default="`
+
+	StateStore              string        `env:"STATE_STORE,                parser=string,            default="`
+	StateRestoreGracePeriod time.Duration `env:"STATE_RESTORE_GRACE_PERIOD, parser=time.ParseDuration, default=0s"`
+
 	PodCIDRStrategy string       `env:"POD_CIDR_STRATEGY, parser=nonempty-string"`
 	PodCIDRs        []*net.IPNet `env:"POD_CIDRS,         parser=split-ipnet, default="`
 	PodIP           net.IP       `env:"POD_IP,            parser=ip"`
+	PodName         string       `env:"POD_NAME,          parser=string,      default="`
+
+	LeaderElection bool `env:"LEADER_ELECTION, parser=bool, default=false"`
 
 	AgentRegistry            string                      `env:"AGENT_REGISTRY,           parser=string,         default="`
 	AgentImageName           string                      `env:"AGENT_IMAGE_NAME,         parser=string,         default="`
@@ -68,6 +80,8 @@ type Env struct {
 	ClientDnsExcludeSuffixes             []string      `env:"CLIENT_DNS_EXCLUDE_SUFFIXES,        		parser=split-trim"`
 	ClientDnsIncludeSuffixes             []string      `env:"CLIENT_DNS_INCLUDE_SUFFIXES,       		parser=split-trim,  default="`
 	ClientConnectionTTL                  time.Duration `env:"CLIENT_CONNECTION_TTL,              		parser=time.ParseDuration"`
+
+	CustomWorkloadKinds []workload.CustomKind `env:"CUSTOM_WORKLOAD_KINDS, parser=json-workload-kinds, default="`
 }
 
 func (e *Env) GeneratorConfig(qualifiedAgentImage string) (agentmap.GeneratorConfig, error) {
@@ -211,6 +225,26 @@ func fieldTypeHandlers() map[reflect.Type]envconfig.FieldTypeHandler {
 		},
 		Setter: func(dst reflect.Value, src interface{}) { dst.Set(reflect.ValueOf(src.(*core.ResourceRequirements))) },
 	}
+	fhs[reflect.TypeOf([]workload.CustomKind{})] = envconfig.FieldTypeHandler{
+		Parsers: map[string]func(string) (any, error){
+			"json-workload-kinds": func(js string) (any, error) {
+				if js == "" {
+					return nil, nil
+				}
+				var cks []workload.CustomKind
+				if err := json.Unmarshal([]byte(js), &cks); err != nil {
+					return nil, err
+				}
+				for _, ck := range cks {
+					if err := ck.Validate(); err != nil {
+						return nil, err
+					}
+				}
+				return cks, nil
+			},
+		},
+		Setter: func(dst reflect.Value, src interface{}) { dst.Set(reflect.ValueOf(src.([]workload.CustomKind))) },
+	}
 	return fhs
 }
 
@@ -81,6 +81,38 @@ func TestEnvconfig(t *testing.T) {
 				e.ClientRoutingNeverProxySubnets = []*net.IPNet{a, b}
 			},
 		},
+		"preview": {
+			Input: map[string]string{
+				"PREVIEW_DOMAIN": "preview.example.com",
+				"PREVIEW_PORT":   "8090",
+				"PREVIEW_SCHEME": "http",
+			},
+			Output: func(e *managerutil.Env) {
+				e.PreviewDomain = "preview.example.com"
+				e.PreviewPort = 8090
+				e.PreviewScheme = "http"
+			},
+		},
+		"state-store": {
+			Input: map[string]string{
+				"STATE_STORE":                "secret",
+				"STATE_RESTORE_GRACE_PERIOD": "1m",
+			},
+			Output: func(e *managerutil.Env) {
+				e.StateStore = "secret"
+				e.StateRestoreGracePeriod = time.Minute
+			},
+		},
+		"leader-election": {
+			Input: map[string]string{
+				"LEADER_ELECTION": "true",
+				"POD_NAME":        "traffic-manager-5c8f7b9d4-x2x7q",
+			},
+			Output: func(e *managerutil.Env) {
+				e.LeaderElection = true
+				e.PodName = "traffic-manager-5c8f7b9d4-x2x7q"
+			},
+		},
 	}
 
 	for tcName, tc := range testcases {
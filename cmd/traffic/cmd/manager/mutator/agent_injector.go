@@ -27,6 +27,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/agentmap"
 	"github.com/telepresenceio/telepresence/v2/pkg/maps"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 var podResource = meta.GroupVersionResource{Version: "v1", Group: "", Resource: "pods"} //nolint:gochecknoglobals // constant
@@ -158,7 +159,13 @@ func (a *agentInjector) Inject(ctx context.Context, req *admission.AdmissionRequ
 			return nil, nil
 		}
 
-		wl, err := agentmap.FindOwnerWorkload(ctx, workloadCache, k8sapi.Pod(pod))
+		var wl k8sapi.Workload
+		if scx != nil && scx.AgentConfig().WorkloadKind == "Pod" {
+			// The pod itself is the target of the agent.
+			wl = workload.Pod(pod)
+		} else {
+			wl, err = agentmap.FindOwnerWorkload(ctx, workloadCache, k8sapi.Pod(pod))
+		}
 		if err != nil {
 			if k8sErrors.IsNotFound(err) {
 				err = nil
@@ -678,6 +685,16 @@ func (a *agentInjector) findConfigMapValue(ctx context.Context, workloadCache ma
 	if a.agentConfigs == nil {
 		return nil, nil
 	}
+	if wl == nil && pod.Name != "" {
+		// An agent config for the pod itself takes precedence over one for its owner.
+		scx, err := a.agentConfigs.Get(pod.Name, pod.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if scx != nil && scx.AgentConfig().WorkloadKind == "Pod" {
+			return scx, nil
+		}
+	}
 	var refs []meta.OwnerReference
 	if wl != nil {
 		refs = wl.GetOwnerReferences()
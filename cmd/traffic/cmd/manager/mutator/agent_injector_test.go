@@ -500,6 +500,56 @@ func TestTrafficAgentConfigGenerator(t *testing.T) {
 			nil,
 			"found no service with a port that matches a container in pod <PODNAME>",
 		},
+		{
+			"Env prefix follows the container index",
+			&core.Pod{
+				ObjectMeta: podObjectMeta("named-port", "service"),
+				Spec: core.PodSpec{
+					Containers: []core.Container{
+						{
+							Name:  "sidecar",
+							Ports: []core.ContainerPort{{ContainerPort: 9090}},
+							VolumeMounts: []core.VolumeMount{
+								{Name: "data", MountPath: "/data"},
+							},
+						},
+						{
+							Name:  "some-container",
+							Ports: []core.ContainerPort{{Name: "http", ContainerPort: 8888}},
+						},
+					},
+				},
+			},
+			&agentconfig.Sidecar{
+				AgentName:    "named-port",
+				AgentImage:   "docker.io/datawire/tel2:2.13.3",
+				Namespace:    "some-ns",
+				WorkloadName: "named-port",
+				WorkloadKind: "Deployment",
+				ManagerHost:  "traffic-manager.default",
+				ManagerPort:  8081,
+				Containers: []*agentconfig.Container{
+					{
+						Name: "some-container",
+						Intercepts: []*agentconfig.Intercept{
+							{
+								ContainerPortName: "http",
+								ServiceName:       "named-port",
+								ServiceUID:        namedPortUID,
+								ServicePortName:   "http",
+								ServicePort:       80,
+								Protocol:          core.ProtocolTCP,
+								AgentPort:         9900,
+								ContainerPort:     8888,
+							},
+						},
+						EnvPrefix:  "B_",
+						MountPoint: "/tel_app_mounts/some-container",
+					},
+				},
+			},
+			"",
+		},
 		{
 			"Error Precondition: Sidecar has port collision",
 			&core.Pod{
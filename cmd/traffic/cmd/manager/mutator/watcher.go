@@ -32,6 +32,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/informer"
 	"github.com/telepresenceio/telepresence/v2/pkg/maps"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 type Map interface {
@@ -98,19 +99,25 @@ func isPodRunning(pod *core.Pod) bool {
 // isRolloutNeeded checks if the agent's entry in telepresence-agents matches the actual state of the
 // pods. If it does, then there's no reason to trigger a rollout.
 func isRolloutNeeded(ctx context.Context, wl k8sapi.Workload, ac *agentconfig.Sidecar) bool {
-	podLabels := wl.GetPodTemplate().GetObjectMeta().GetLabels()
-	if len(podLabels) == 0 {
-		// Have never seen this, but if it happens, then rollout only if an agent is desired
-		dlog.Debugf(ctx, "Rollout of %s.%s is necessary. Pod template has no pod labels",
-			wl.GetName(), wl.GetNamespace())
-		return true
-	}
+	var pods []k8sapi.Object
+	if pod, ok := workload.PodImpl(wl); ok {
+		// Only the targeted pod is of interest. Other pods with the same labels are left untouched.
+		pods = []k8sapi.Object{k8sapi.Pod(pod)}
+	} else {
+		podLabels := wl.GetPodTemplate().GetObjectMeta().GetLabels()
+		if len(podLabels) == 0 {
+			// Have never seen this, but if it happens, then rollout only if an agent is desired
+			dlog.Debugf(ctx, "Rollout of %s.%s is necessary. Pod template has no pod labels",
+				wl.GetName(), wl.GetNamespace())
+			return true
+		}
 
-	pods, err := k8sapi.Pods(ctx, wl.GetNamespace(), podLabels)
-	if err != nil {
-		dlog.Debugf(ctx, "Rollout of %s.%s is necessary. Unable to retrieve current pods: %v",
-			wl.GetName(), wl.GetNamespace(), err)
-		return true
+		var err error
+		if pods, err = k8sapi.Pods(ctx, wl.GetNamespace(), podLabels); err != nil {
+			dlog.Debugf(ctx, "Rollout of %s.%s is necessary. Unable to retrieve current pods: %v",
+				wl.GetName(), wl.GetNamespace(), err)
+			return true
+		}
 	}
 
 	runningPods := 0
@@ -185,6 +192,11 @@ func isRolloutNeeded(ctx context.Context, wl k8sapi.Workload, ac *agentconfig.Si
 }
 
 func triggerRollout(ctx context.Context, wl k8sapi.Workload, ac *agentconfig.Sidecar) {
+	if !managerutil.IsLeader(ctx) {
+		// The leader triggers the rollout. Doing it in all replicas would roll out the workload once per replica.
+		dlog.Debugf(ctx, "Leaving the rollout of %s.%s to the leader", wl.GetName(), wl.GetNamespace())
+		return
+	}
 	if !isRolloutNeeded(ctx, wl, ac) {
 		return
 	}
@@ -197,13 +209,22 @@ func triggerRollout(ctx context.Context, wl k8sapi.Workload, ac *agentconfig.Sid
 		triggerRolloutReplicaSet(ctx, wl, rs, span)
 		return
 	}
+	if pod, ok := workload.PodImpl(wl); ok {
+		triggerRolloutPod(ctx, wl, pod, span)
+		return
+	}
 	restartAnnotation := fmt.Sprintf(
 		`{"spec": {"template": {"metadata": {"annotations": {"%srestartedAt": "%s"}}}}}`,
 		DomainPrefix,
 		time.Now().Format(time.RFC3339),
 	)
+	pt := types.StrategicMergePatchType
+	if _, _, ok := workload.CustomImpl(wl); ok {
+		// Custom resources don't support strategic merge patches.
+		pt = types.MergePatchType
+	}
 	span.AddEvent("tel2.do-rollout")
-	if err := wl.Patch(ctx, types.StrategicMergePatchType, []byte(restartAnnotation)); err != nil {
+	if err := wl.Patch(ctx, pt, []byte(restartAnnotation)); err != nil {
 		err = fmt.Errorf("unable to patch %s %s.%s: %v", wl.GetKind(), wl.GetName(), wl.GetNamespace(), err)
 		dlog.Error(ctx, err)
 		span.SetStatus(codes.Error, err.Error())
@@ -266,6 +287,26 @@ func triggerRolloutReplicaSet(ctx context.Context, wl k8sapi.Workload, rs *v1.Re
 	}
 }
 
+// triggerRolloutPod deletes the pod so that the agent-injector can act on it when its controller recreates it. A
+// bare pod has no controller, and recreating it would lose its state, so it is left untouched.
+func triggerRolloutPod(ctx context.Context, wl k8sapi.Workload, pod *core.Pod, span trace.Span) {
+	if meta.GetControllerOf(pod) == nil {
+		err := fmt.Errorf("unable to roll out Pod %s.%s: a pod without a controller cannot be recreated without losing its state", wl.GetName(), wl.GetNamespace())
+		dlog.Error(ctx, err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	dlog.Debugf(ctx, "Performing Pod rollout of %s.%s by deleting it", wl.GetName(), wl.GetNamespace())
+	span.AddEvent("tel2.do-rollout")
+	if err := wl.Delete(ctx); err != nil && !errors.IsNotFound(err) {
+		err = fmt.Errorf("unable to delete Pod %s.%s: %v", wl.GetName(), wl.GetNamespace(), err)
+		dlog.Error(ctx, err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	dlog.Infof(ctx, "Successfully rolled out %s.%s", wl.GetName(), wl.GetNamespace())
+}
+
 // RegenerateAgentMaps load the telepresence-agents config map, regenerates all entries in it,
 // and then, if any of the entries changed, it updates the map.
 func RegenerateAgentMaps(ctx context.Context, agentImage string) error {
@@ -0,0 +1,113 @@
+package mutator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
+)
+
+func TestTriggerRollout_Leader(t *testing.T) {
+	for _, leader := range []bool{true, false} {
+		dep := &apps.Deployment{
+			TypeMeta:   meta.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: meta.ObjectMeta{Name: "echo", Namespace: "default"},
+		}
+		cs := fake.NewSimpleClientset(dep)
+		ctx := dlog.NewTestContext(t, false)
+		ctx = k8sapi.WithK8sInterface(ctx, cs)
+		ctx = managerutil.WithLeaderCheck(ctx, func() bool { return leader })
+
+		// A pod template without labels always results in a rollout.
+		triggerRollout(ctx, k8sapi.Deployment(dep), nil)
+		patched := false
+		for _, a := range cs.Actions() {
+			if a.GetVerb() == "patch" {
+				patched = true
+			}
+		}
+		assert.Equal(t, leader, patched, "leader %t", leader)
+	}
+}
+
+func TestIsLeader(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	require.True(t, managerutil.IsLeader(ctx))
+	require.False(t, managerutil.IsLeader(managerutil.WithLeaderCheck(ctx, func() bool { return false })))
+}
+
+func testPod(annotations map[string]string, owners ...meta.OwnerReference) *core.Pod {
+	return &core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            "echo",
+			Namespace:       "default",
+			Labels:          map[string]string{"app": "echo"},
+			Annotations:     annotations,
+			OwnerReferences: owners,
+		},
+		Spec: core.PodSpec{
+			NodeName:   "node-1",
+			Containers: []core.Container{{Name: "echo", Image: "echo:1"}},
+		},
+	}
+}
+
+func TestTriggerRolloutPod(t *testing.T) {
+	controller := true
+	tests := []struct {
+		name       string
+		pod        *core.Pod
+		wantDelete bool
+	}{
+		{
+			name: "bare pod",
+			pod:  testPod(nil),
+		},
+		{
+			name: "pod with controller",
+			pod: testPod(nil, meta.OwnerReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "echo",
+				Controller: &controller,
+			}),
+			wantDelete: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := fake.NewSimpleClientset(tt.pod)
+			ctx := dlog.NewTestContext(t, false)
+			ctx = k8sapi.WithK8sInterface(ctx, cs)
+			triggerRolloutPod(ctx, workload.Pod(tt.pod), tt.pod, trace.SpanFromContext(ctx))
+
+			deleted := false
+			for _, a := range cs.Actions() {
+				switch a.GetVerb() {
+				case "delete":
+					deleted = true
+				case "create":
+					t.Fatal("the pod must not be recreated")
+				}
+			}
+			assert.Equal(t, tt.wantDelete, deleted)
+			_, err := cs.CoreV1().Pods("default").Get(ctx, "echo", meta.GetOptions{})
+			if tt.wantDelete {
+				assert.True(t, errors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err, "a bare pod must be left untouched")
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/datawire/dlib/dhttp"
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/restapi"
+)
+
+// maxPreviewNameLength is the maximum length of the intercept name part of a preview domain label. The
+// label must not exceed 63 characters.
+const maxPreviewNameLength = 40
+
+// servePreview serves the preview URLs of intercepts if env.PreviewDomain and env.PreviewPort are set.
+func (s *service) servePreview(ctx context.Context) error {
+	env := managerutil.GetEnv(ctx)
+	if env.PreviewDomain == "" || env.PreviewPort == 0 {
+		dlog.Info(ctx, "Preview URL server not started")
+		return nil
+	}
+	lg := dlog.StdLogger(ctx, dlog.MaxLogLevel(ctx))
+	lg.SetPrefix(fmt.Sprintf("preview:%d", env.PreviewPort))
+	sc := &dhttp.ServerConfig{
+		Handler:  s.previewHandler(ctx),
+		ErrorLog: lg,
+	}
+	dlog.Infof(ctx, "Preview URL server for *.%s started on port: %d", env.PreviewDomain, env.PreviewPort)
+	defer dlog.Info(ctx, "Preview URL server stopped")
+	return sc.ListenAndServe(ctx, iputil.JoinHostPort(env.ServerHost, env.PreviewPort))
+}
+
+type previewInterceptKey struct{}
+
+// previewHandler returns a handler that proxies requests for <label>.<preview domain> to the ingress
+// of the intercept that owns the preview domain, or to the intercepted service when no ingress has been
+// given. The request will carry the x-telepresence-intercept-id header, which the traffic-agent uses to
+// route it to the intercepting client. Replicas that aren't the leader pass all requests on to the leader.
+func (s *service) previewHandler(ctx context.Context) http.Handler {
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			ii := pr.In.Context().Value(previewInterceptKey{}).(*rpc.InterceptInfo)
+			pr.SetXForwarded()
+			scheme, addr, host := previewTarget(ii)
+			pr.Out.URL.Scheme = scheme
+			pr.Out.URL.Host = addr
+			pr.Out.Host = host
+			for k, v := range ii.PreviewSpec.GetAddRequestHeaders() {
+				pr.Out.Header.Set(k, v)
+			}
+			pr.Out.Header.Set(restapi.HeaderInterceptID, ii.Id)
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			dlog.Errorf(ctx, "Preview of %s%s failed: %v", r.Host, r.URL.Path, err)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ip := s.leaderIP(); ip != nil {
+			// Only the leader knows about the intercepts.
+			u := &url.URL{Scheme: "http", Host: iputil.JoinHostPort(ip.String(), managerutil.GetEnv(ctx).PreviewPort)}
+			httputil.NewSingleHostReverseProxy(u).ServeHTTP(w, r)
+			return
+		}
+		ii, code, msg := s.previewIntercept(r.Host)
+		if ii == nil {
+			http.Error(w, msg, code)
+			return
+		}
+		rp.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), previewInterceptKey{}, ii)))
+	})
+}
+
+// previewIntercept returns the active intercept that owns the preview domain of the given host. An HTTP
+// status code and a message are returned when no such intercept is found.
+func (s *service) previewIntercept(host string) (*rpc.InterceptInfo, int, string) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+	if !strings.HasSuffix(host, "."+managerutil.GetEnv(s.ctx).PreviewDomain) {
+		return nil, http.StatusNotFound, fmt.Sprintf("%s is not a preview URL", host)
+	}
+	iis := s.state.LoadMatchingIntercepts(func(_ string, ii *rpc.InterceptInfo) bool {
+		return previewHost(ii.PreviewDomain) == host
+	})
+	for _, ii := range iis {
+		if ii.Disposition != rpc.InterceptDispositionType_ACTIVE {
+			return nil, http.StatusServiceUnavailable, fmt.Sprintf("intercept %s is not active", ii.Spec.Name)
+		}
+		return ii, 0, ""
+	}
+	return nil, http.StatusNotFound, fmt.Sprintf("found no intercept for preview URL %s", host)
+}
+
+// previewTarget returns the scheme, address, and host header to use when proxying a preview request for
+// the given intercept.
+func previewTarget(ii *rpc.InterceptInfo) (scheme, addr, host string) {
+	if ing := ii.PreviewSpec.GetIngress(); ing != nil {
+		scheme = "http"
+		if ing.UseTls {
+			scheme = "https"
+		}
+		addr = net.JoinHostPort(ing.Host, strconv.Itoa(int(ing.Port)))
+		host = ing.L5Host
+		if host == "" {
+			host = ing.Host
+		}
+		return scheme, addr, host
+	}
+	spec := ii.Spec
+	host = spec.ServiceName + "." + spec.Namespace
+	return "http", net.JoinHostPort(host, strconv.Itoa(int(spec.ServicePort))), host
+}
+
+// previewHost returns the host of a preview domain, which might be prefixed with a scheme.
+func previewHost(previewDomain string) string {
+	if _, host, ok := strings.Cut(previewDomain, "://"); ok {
+		return host
+	}
+	return previewDomain
+}
+
+// previewDomain returns the preview domain of the given intercept. The first label of the domain
+// is derived from the intercept name and ID, and is therefore unique for each intercept. The domain
+// is prefixed with the scheme unless that scheme is https.
+func previewDomain(ii *rpc.InterceptInfo, env *managerutil.Env) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, ii.Spec.Name)
+	if len(name) > maxPreviewNameLength {
+		name = name[:maxPreviewNameLength]
+	}
+	name = strings.Trim(name, "-")
+	sum := sha256.Sum256([]byte(ii.Id))
+	pd := name + "-" + hex.EncodeToString(sum[:6]) + "." + env.PreviewDomain
+	if env.PreviewScheme != "" && env.PreviewScheme != "https" {
+		pd = env.PreviewScheme + "://" + pd
+	}
+	return pd
+}
@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+	"github.com/telepresenceio/telepresence/v2/pkg/restapi"
+)
+
+func TestPreviewDomain(t *testing.T) {
+	ii := &rpc.InterceptInfo{
+		Id:   "8c5c1a6e-7f37-4e53-a1b0-6dc5e58c4a2f:My_Intercept",
+		Spec: &rpc.InterceptSpec{Name: "My_Intercept"},
+	}
+	env := &managerutil.Env{PreviewDomain: "preview.example.com"}
+	pd := previewDomain(ii, env)
+	assert.Regexp(t, `^my-intercept-[0-9a-f]{12}\.preview\.example\.com$`, pd)
+	assert.Equal(t, pd, previewDomain(ii, env), "preview domain must be stable")
+	assert.Equal(t, pd, previewHost(pd))
+
+	env.PreviewScheme = "http"
+	assert.Equal(t, "http://"+pd, previewDomain(ii, env))
+	assert.Equal(t, pd, previewHost(previewDomain(ii, env)))
+}
+
+func TestPreviewHandler(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	ctx = managerutil.WithEnv(ctx, &managerutil.Env{PreviewDomain: "preview.example.com", PreviewPort: 8090})
+
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Host", r.Host)
+		w.Header().Set("X-Intercept-Id", r.Header.Get(restapi.HeaderInterceptID))
+		w.Header().Set("X-Dev", r.Header.Get("X-Dev"))
+	}))
+	defer backend.Close()
+	host, port, err := net.SplitHostPort(backend.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	s := &service{ctx: ctx, state: state.NewState(ctx)}
+	sessionID := s.state.AddClient(&rpc.ClientInfo{Name: "alice", InstallId: "abc", Product: "test", Version: "2.19.0"}, time.Now())
+	_, ii, err := s.state.AddIntercept(ctx, sessionID, "cluster", &rpc.CreateInterceptRequest{
+		InterceptSpec: &rpc.InterceptSpec{
+			Name:      "hello",
+			Client:    "alice",
+			Agent:     "hello",
+			Namespace: "default",
+			Mechanism: "http",
+		},
+	})
+	require.NoError(t, err)
+	pd := previewDomain(ii, managerutil.GetEnv(ctx))
+
+	get := func(host string) *http.Response {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "http://"+host+"/some/path", nil)
+		s.previewHandler(ctx).ServeHTTP(rec, req)
+		return rec.Result()
+	}
+
+	// Unknown hosts and intercepts are not found
+	assert.Equal(t, http.StatusNotFound, get("example.com").StatusCode)
+	assert.Equal(t, http.StatusNotFound, get(pd).StatusCode)
+
+	// Inactive intercepts are unavailable
+	s.state.UpdateIntercept(ii.Id, func(ii *rpc.InterceptInfo) {
+		ii.PreviewDomain = pd
+		ii.PreviewSpec = &rpc.PreviewSpec{
+			Ingress:           &rpc.IngressInfo{Host: host, Port: int32(portNum), L5Host: "hello.example.com"},
+			AddRequestHeaders: map[string]string{"X-Dev": "alice"},
+		}
+	})
+	assert.Equal(t, http.StatusServiceUnavailable, get(pd).StatusCode)
+
+	// Active intercepts are reached using the ingress, with the intercept ID in a header
+	s.state.UpdateIntercept(ii.Id, func(ii *rpc.InterceptInfo) {
+		ii.Disposition = rpc.InterceptDispositionType_ACTIVE
+	})
+	rs := get(pd + ":8090")
+	assert.Equal(t, http.StatusOK, rs.StatusCode)
+	assert.Equal(t, "hello.example.com", rs.Header.Get("X-Host"))
+	assert.Equal(t, ii.Id, rs.Header.Get("X-Intercept-Id"))
+	assert.Equal(t, "alice", rs.Header.Get("X-Dev"))
+}
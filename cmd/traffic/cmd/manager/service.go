@@ -2,6 +2,8 @@ package manager
 
 import (
 	"context"
+	"os"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
 	"github.com/telepresenceio/telepresence/v2/pkg/dnsproxy"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
 	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
 	"github.com/telepresenceio/telepresence/v2/pkg/version"
@@ -51,6 +54,10 @@ type Service interface {
 	runSessionGCLoop(context.Context) error
 	serveHTTP(context.Context) error
 	servePrometheus(context.Context) error
+	servePreview(context.Context) error
+	persistState(context.Context) error
+	runLeaderElection(context.Context) error
+	isLeader() bool
 }
 
 type service struct {
@@ -58,6 +65,8 @@ type service struct {
 	clock              Clock
 	id                 string
 	state              state.State
+	store              state.Store
+	leader             *leader
 	clusterInfo        cluster.Info
 	configWatcher      config.Watcher
 	activeHttpRequests int32
@@ -92,6 +101,21 @@ func NewService(ctx context.Context) (Service, *dgroup.Group, error) {
 	// These are context dependent so build them once the pool is up
 	ret.clusterInfo = cluster.NewInfo(ctx)
 	ret.state = state.NewStateFunc(ctx)
+	if ret.store, err = state.NewStoreFunc(ctx); err != nil {
+		return nil, nil, err
+	}
+	if env := managerutil.GetEnv(ctx); env.LeaderElection {
+		// The state is restored when this replica becomes the leader.
+		identity := env.PodName
+		if identity == "" {
+			if identity, err = os.Hostname(); err != nil {
+				return nil, nil, err
+			}
+		}
+		ret.leader = &leader{identity: identity}
+	} else {
+		ret.restoreState(ctx)
+	}
 	ret.self = ret
 	g := dgroup.NewGroup(ctx, dgroup.GroupConfig{
 		EnableSignalHandling: true,
@@ -128,6 +152,27 @@ func (s *service) runConfigWatcher(ctx context.Context) error {
 	return s.configWatcher.Run(ctx)
 }
 
+// restoreState restores the client sessions and intercepts from the state store.
+func (s *service) restoreState(ctx context.Context) {
+	if s.store == nil {
+		return
+	}
+	// Restored clients must call Remain within the grace period, or their sessions expire.
+	env := managerutil.GetEnv(ctx)
+	lastMarked := s.clock.Now().Add(env.StateRestoreGracePeriod - env.ClientConnectionTTL)
+	if err := s.state.Restore(ctx, s.store, lastMarked); err != nil {
+		dlog.Errorf(ctx, "unable to restore state: %v", err)
+	}
+}
+
+// persistState saves the client sessions and intercepts in the state store when they change.
+func (s *service) persistState(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+	return s.state.Persist(ctx, s.store)
+}
+
 // Version returns the version information of the Manager.
 func (*service) Version(context.Context, *empty.Empty) (*rpc.VersionInfo2, error) {
 	return &rpc.VersionInfo2{Name: DisplayName, Version: version.Version}, nil
@@ -199,6 +244,24 @@ func (s *service) ReportMetrics(ctx context.Context, metrics *rpc.TunnelMetrics)
 	return &empty.Empty{}, nil
 }
 
+func (s *service) WatchConnectionStats(request *rpc.ConnectionStatsRequest, stream rpc.Manager_WatchConnectionStatsServer) error {
+	ctx := managerutil.WithSessionInfo(stream.Context(), request.Session)
+	dlog.Debug(ctx, "WatchConnectionStats called")
+	defer dlog.Debug(ctx, "WatchConnectionStats ended")
+
+	sessionID := request.Session.GetSessionId()
+	if s.state.GetClient(sessionID) == nil {
+		return status.Errorf(codes.NotFound, "Client session %q not found", sessionID)
+	}
+	if request.AllSessions {
+		if err := authorizeAllSessions(ctx, request.Token); err != nil {
+			return err
+		}
+		sessionID = ""
+	}
+	return s.state.GetConnStats().Watch(ctx, request, sessionID, stream.Send)
+}
+
 func (s *service) GetClientConfig(ctx context.Context, _ *empty.Empty) (*rpc.CLIConfig, error) {
 	dlog.Debug(ctx, "GetClientConfig called")
 
@@ -256,9 +319,9 @@ func (s *service) WatchAgentPods(session *rpc.SessionInfo, stream rpc.Manager_Wa
 	}
 
 	var interceptInfos map[string]*rpc.InterceptInfo
-	isIntercepted := func(name, namespace string) bool {
+	isIntercepted := func(name, podName, namespace string) bool {
 		for _, ii := range interceptInfos {
-			if name == ii.Spec.Agent && namespace == ii.Spec.Namespace {
+			if name == ii.Spec.Agent && namespace == ii.Spec.Namespace && (ii.Spec.PodName == "" || podName == ii.Spec.PodName) {
 				return true
 			}
 		}
@@ -286,7 +349,7 @@ func (s *service) WatchAgentPods(session *rpc.SessionInfo, stream rpc.Manager_Wa
 					Namespace:    a.Namespace,
 					PodIp:        iputil.Parse(a.PodIp),
 					ApiPort:      a.ApiPort,
-					Intercepted:  isIntercepted(a.Name, a.Namespace),
+					Intercepted:  isIntercepted(a.Name, a.PodName, a.Namespace),
 				}
 				agentNames[i] = a.Name
 				i++
@@ -297,7 +360,7 @@ func (s *service) WatchAgentPods(session *rpc.SessionInfo, stream rpc.Manager_Wa
 			}
 			interceptInfos = is.State
 			for i, a := range agents {
-				a.Intercepted = isIntercepted(agentNames[i], a.Namespace)
+				a.Intercepted = isIntercepted(agentNames[i], a.PodName, a.Namespace)
 			}
 		}
 		if agents != nil {
@@ -482,7 +545,7 @@ func (s *service) WatchIntercepts(session *rpc.SessionInfo, stream rpc.Manager_W
 		if agent := s.state.GetAgent(sessionID); agent != nil {
 			// sessionID refers to an agent session
 			filter = func(id string, info *rpc.InterceptInfo) bool {
-				if info.Spec.Namespace != agent.Namespace || info.Spec.Agent != agent.Name {
+				if !managerutil.AgentServesIntercept(agent, info.Spec) {
 					// Don't return intercepts for different agents.
 					return false
 				}
@@ -582,6 +645,11 @@ func (s *service) CreateIntercept(ctx context.Context, ciReq *rpc.CreateIntercep
 		return nil, status.Errorf(codes.InvalidArgument, val)
 	}
 
+	// The cluster-wide maximum time to live ensures that orphaned intercepts are removed eventually.
+	if maxTTL := int64(managerutil.GetEnv(ctx).InterceptMaxTTL); maxTTL > 0 && (spec.Ttl == 0 || spec.Ttl > maxTTL) {
+		spec.Ttl = maxTTL
+	}
+
 	if ciReq.InterceptSpec.Replace {
 		_, err := s.state.PrepareIntercept(ctx, ciReq)
 		if err != nil {
@@ -630,8 +698,69 @@ func (s *service) MakeInterceptID(_ context.Context, sessionID string, name stri
 	}
 }
 
-func (s *service) UpdateIntercept(context.Context, *rpc.UpdateInterceptRequest) (*rpc.InterceptInfo, error) { //nolint:gocognit
-	return nil, status.Error(codes.Unimplemented, "")
+// UpdateIntercept lets a client change the target port, the local ports, the mechanism args, or the
+// preview URL of an existing intercept without removing it.
+func (s *service) UpdateIntercept(ctx context.Context, req *rpc.UpdateInterceptRequest) (*rpc.InterceptInfo, error) {
+	ctx = managerutil.WithSessionInfo(ctx, req.GetSession())
+	interceptID, err := s.MakeInterceptID(ctx, req.GetSession().GetSessionId(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	dlog.Debugf(ctx, "UpdateIntercept called: %s", interceptID)
+
+	env := managerutil.GetEnv(ctx)
+	if req.PreviewDomainAction != nil && (env.PreviewDomain == "" || env.PreviewPort == 0) {
+		return nil, status.Error(codes.FailedPrecondition, "preview URLs are not enabled in the traffic-manager")
+	}
+
+	cept, ok := s.state.GetIntercept(interceptID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Intercept named %q not found", req.Name)
+	}
+	if val := validateInterceptUpdate(cept.Spec, req); val != "" {
+		return nil, status.Error(codes.InvalidArgument, val)
+	}
+
+	var m matcher.Request
+	if ma := req.MechanismArgs; ma != nil && (cept.Spec.Mechanism == "http" || cept.Spec.Mechanism == "grpc") {
+		if m, err = matcher.NewRequestFromMechanismArgs(cept.Spec.Mechanism, ma.Args); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	cept = s.state.UpdateIntercept(interceptID, func(cept *rpc.InterceptInfo) {
+		spec := cept.Spec
+		if req.TargetPort != 0 {
+			spec.TargetPort = req.TargetPort
+		}
+		if len(req.AddLocalPorts) > 0 || len(req.RemoveLocalPorts) > 0 {
+			spec.LocalPorts, spec.ExtraPorts = updateLocalPorts(spec.LocalPorts, req.AddLocalPorts, req.RemoveLocalPorts)
+		}
+		if req.MechanismArgs != nil {
+			spec.MechanismArgs = req.MechanismArgs.Args
+			if m != nil {
+				// The agent will use the new args when it receives the next snapshot. It will not
+				// review the intercept again, so the description and headers are updated here.
+				cept.MechanismArgsDesc = m.String()
+				cept.Headers = m.Map()
+			}
+		}
+		switch action := req.PreviewDomainAction.(type) {
+		case *rpc.UpdateInterceptRequest_AddPreviewDomain:
+			cept.PreviewDomain = previewDomain(cept, env)
+			cept.PreviewSpec = action.AddPreviewDomain
+		case *rpc.UpdateInterceptRequest_RemovePreviewDomain:
+			if action.RemovePreviewDomain {
+				cept.PreviewDomain = ""
+				cept.PreviewSpec = nil
+			}
+		}
+	})
+	if cept == nil {
+		return nil, status.Errorf(codes.NotFound, "Intercept named %q not found", req.Name)
+	}
+	tracing.RecordInterceptInfo(trace.SpanFromContext(ctx), cept)
+	return cept, nil
 }
 
 // RemoveIntercept lets a client remove an intercept.
@@ -666,6 +795,71 @@ func (s *service) GetIntercept(ctx context.Context, request *rpc.GetInterceptReq
 	}
 }
 
+// ListAllIntercepts returns the intercepts of all clients in the managed namespaces, together with the name
+// of the owning client and the tunnel traffic of its session.
+func (s *service) ListAllIntercepts(ctx context.Context, session *rpc.SessionInfo) (*rpc.AllInterceptsResponse, error) {
+	ctx = managerutil.WithSessionInfo(ctx, session)
+	dlog.Debug(ctx, "ListAllIntercepts called")
+	if s.state.GetClient(session.GetSessionId()) == nil {
+		return nil, status.Errorf(codes.NotFound, "Client session %q not found", session.GetSessionId())
+	}
+
+	managed := managerutil.GetEnv(ctx).ManagedNamespaces
+	intercepts := s.state.LoadMatchingIntercepts(func(_ string, ii *rpc.InterceptInfo) bool {
+		return ii.Disposition != rpc.InterceptDispositionType_REMOVED &&
+			(len(managed) == 0 || slices.Contains(managed, ii.Spec.Namespace))
+	})
+	ios := make([]*rpc.InterceptOwnership, 0, len(intercepts))
+	for _, ii := range intercepts {
+		ow := &rpc.InterceptOwnership{Intercept: ii}
+		ownerID := ii.ClientSession.GetSessionId()
+		if owner := s.state.GetClient(ownerID); owner != nil {
+			ow.ClientName = owner.Name
+		}
+		if cm := s.state.GetSessionConsumptionMetrics(ownerID); cm != nil {
+			ow.SessionIngressBytes = cm.FromClientBytes.GetValue()
+			ow.SessionEgressBytes = cm.ToClientBytes.GetValue()
+		}
+		ios = append(ios, ow)
+	}
+	sort.Slice(ios, func(i, j int) bool {
+		return ios[i].Intercept.Id < ios[j].Intercept.Id
+	})
+	return &rpc.AllInterceptsResponse{Intercepts: ios}, nil
+}
+
+// EvictIntercept lets a client remove an intercept that is owned by another client. The user of the calling
+// client must be allowed to update the intercepted workload.
+func (s *service) EvictIntercept(ctx context.Context, req *rpc.EvictInterceptRequest) (*empty.Empty, error) {
+	ctx = managerutil.WithSessionInfo(ctx, req.GetSession())
+	sessionID := req.GetSession().GetSessionId()
+	interceptID := req.InterceptId
+
+	dlog.Debugf(ctx, "EvictIntercept called: %s", interceptID)
+
+	client := s.state.GetClient(sessionID)
+	if client == nil {
+		return nil, status.Errorf(codes.NotFound, "Client session %q not found", sessionID)
+	}
+	ii, ok := s.state.GetIntercept(interceptID)
+	if !ok || ii.Disposition == rpc.InterceptDispositionType_REMOVED {
+		return nil, status.Errorf(codes.NotFound, "Intercept %q not found", interceptID)
+	}
+	user, err := authorizeEviction(ctx, req.GetToken(), ii.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerName := ""
+	if owner := s.state.GetClient(ii.ClientSession.GetSessionId()); owner != nil {
+		ownerName = owner.Name
+		SetGauge(s.state.GetInterceptActiveStatus(), owner.Name, owner.InstallId, &ii.Spec.Name, 0)
+	}
+	dlog.Infof(ctx, "Intercept %s owned by %q was evicted by %q (user %s)", interceptID, ownerName, client.Name, user.Username)
+	s.state.RemoveIntercept(ctx, interceptID)
+	return &empty.Empty{}, nil
+}
+
 // ReviewIntercept lets an agent approve or reject an intercept.
 func (s *service) ReviewIntercept(ctx context.Context, rIReq *rpc.ReviewInterceptRequest) (*empty.Empty, error) {
 	ctx = managerutil.WithSessionInfo(ctx, rIReq.GetSession())
@@ -683,7 +877,7 @@ func (s *service) ReviewIntercept(ctx context.Context, rIReq *rpc.ReviewIntercep
 
 	intercept := s.state.UpdateIntercept(ceptID, func(intercept *rpc.InterceptInfo) {
 		// Sanity check: The reviewing agent must be an agent for the intercept.
-		if intercept.Spec.Namespace != agent.Namespace || intercept.Spec.Agent != agent.Name {
+		if !managerutil.AgentServesIntercept(agent, intercept.Spec) {
 			return
 		}
 
@@ -726,12 +920,7 @@ func (s *service) removeExcludedEnvVars(ctx context.Context, envVars map[string]
 }
 
 func (s *service) Tunnel(server rpc.Manager_TunnelServer) error {
-	ctx := server.Context()
-	stream, err := tunnel.NewServerStream(ctx, server)
-	if err != nil {
-		return status.Errorf(codes.FailedPrecondition, "failed to connect stream: %v", err)
-	}
-	return s.state.Tunnel(ctx, stream)
+	return tunnel.ServeTunnel(server.Context(), server, s.state.Tunnel)
 }
 
 func (s *service) WatchDial(session *rpc.SessionInfo, stream rpc.Manager_WatchDialServer) error {
@@ -851,4 +1040,5 @@ const agentSessionTTL = 15 * time.Second
 func (s *service) expire(ctx context.Context) {
 	now := s.clock.Now()
 	s.state.ExpireSessions(ctx, now.Add(-managerutil.GetEnv(ctx).ClientConnectionTTL), now.Add(-agentSessionTTL))
+	s.state.ExpireIntercepts(ctx, now)
 }
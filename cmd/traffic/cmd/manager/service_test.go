@@ -8,7 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/proto"
 	empty "google.golang.org/protobuf/types/known/emptypb"
@@ -249,6 +251,120 @@ func TestConnect(t *testing.T) {
 	a.Nil(second)
 	t.Logf("=> intercept info: %s", dumps(second))
 
+	// Alice updates the intercept
+
+	updated, err := client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:       aliceSess2,
+		Name:          spec.Name,
+		TargetPort:    9877,
+		AddLocalPorts: []string{"8080", "8081/UDP"},
+	})
+	a.NoError(err)
+	a.Equal(int32(9877), updated.Spec.TargetPort)
+	a.Equal([]string{"8080", "8081/UDP"}, updated.Spec.LocalPorts)
+	a.Equal([]int32{8080}, updated.Spec.ExtraPorts)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, updated.Disposition)
+
+	aSnapI, err = aliceWI.Recv()
+	a.NoError(err)
+	a.Len(aSnapI.Intercepts, 1)
+	a.Equal(int32(9877), aSnapI.Intercepts[0].Spec.TargetPort)
+	t.Logf("=> client[alice] intercept snapshot = %s", dumps(aSnapI))
+
+	hSnapI, err = helloWI.Recv()
+	a.NoError(err)
+	a.Len(hSnapI.Intercepts, 1)
+	a.Equal(int32(9877), hSnapI.Intercepts[0].Spec.TargetPort)
+	a.Equal(rpc.InterceptDispositionType_ACTIVE, hSnapI.Intercepts[0].Disposition)
+	t.Logf("=> agent[hello] intercept snapshot = %s", dumps(hSnapI))
+
+	updated, err = client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:          aliceSess2,
+		Name:             spec.Name,
+		RemoveLocalPorts: []string{"8080/TCP"},
+	})
+	a.NoError(err)
+	a.Equal([]string{"8081/UDP"}, updated.Spec.LocalPorts)
+	a.Empty(updated.Spec.ExtraPorts)
+	_, err = aliceWI.Recv()
+	a.NoError(err)
+	_, err = helloWI.Recv()
+	a.NoError(err)
+
+	// Alice adds and removes a preview URL
+
+	updated, err = client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session: aliceSess2,
+		Name:    spec.Name,
+		PreviewDomainAction: &rpc.UpdateInterceptRequest_AddPreviewDomain{
+			AddPreviewDomain: &rpc.PreviewSpec{},
+		},
+	})
+	a.NoError(err)
+	a.Regexp(`^first-[0-9a-f]+\.preview\.example\.com$`, updated.PreviewDomain)
+	a.NotNil(updated.PreviewSpec)
+	_, err = aliceWI.Recv()
+	a.NoError(err)
+	_, err = helloWI.Recv()
+	a.NoError(err)
+
+	updated, err = client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:             aliceSess2,
+		Name:                spec.Name,
+		PreviewDomainAction: &rpc.UpdateInterceptRequest_RemovePreviewDomain{RemovePreviewDomain: true},
+	})
+	a.NoError(err)
+	a.Empty(updated.PreviewDomain)
+	a.Nil(updated.PreviewSpec)
+	_, err = aliceWI.Recv()
+	a.NoError(err)
+	_, err = helloWI.Recv()
+	a.NoError(err)
+
+	// Invalid updates yield errors
+
+	_, err = client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:       aliceSess2,
+		Name:          spec.Name,
+		MechanismArgs: &rpc.MechanismArgs{Args: []string{"--http-header=x=y"}},
+	})
+	a.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.UpdateIntercept(ctx, &rpc.UpdateInterceptRequest{
+		Session:    aliceSess2,
+		Name:       "no-such-intercept",
+		TargetPort: 9878,
+	})
+	a.Equal(codes.NotFound, status.Code(err))
+
+	// Bob sees Alice's intercept when listing the intercepts of all users
+
+	bobSess, err := client.ArriveAsClient(ctx, testClients["bob"])
+	a.NoError(err)
+
+	all, err := client.ListAllIntercepts(ctx, bobSess)
+	a.NoError(err)
+	a.Len(all.Intercepts, 1)
+	a.Equal(first.Id, all.Intercepts[0].Intercept.Id)
+	a.Equal(testClients["alice"].Name, all.Intercepts[0].ClientName)
+	a.NotNil(all.Intercepts[0].Intercept.CreatedAt)
+
+	_, err = client.EvictIntercept(ctx, &rpc.EvictInterceptRequest{
+		Session:     bobSess,
+		InterceptId: aliceSess2.SessionId + ":no-such-intercept",
+	})
+	a.Equal(codes.NotFound, status.Code(err))
+
+	// Bob's bearer token is required to verify that Bob may evict Alice's intercept
+	_, err = client.EvictIntercept(ctx, &rpc.EvictInterceptRequest{
+		Session:     bobSess,
+		InterceptId: first.Id,
+	})
+	a.Equal(codes.Unauthenticated, status.Code(err))
+
+	_, err = client.Depart(ctx, bobSess)
+	a.NoError(err)
+
 	// Alice removes the intercept
 
 	_, err = client.RemoveIntercept(ctx, &rpc.RemoveInterceptRequest2{
@@ -313,6 +429,8 @@ func getTestClientConn(ctx context.Context, t *testing.T) *grpc.ClientConn {
 	env := managerutil.Env{
 		MaxReceiveSize:  resource.Quantity{},
 		PodCIDRStrategy: "environment",
+		PreviewDomain:   "preview.example.com",
+		PreviewPort:     8090,
 		PodCIDRs: []*net.IPNet{{
 			IP:   net.IP{192, 168, 0, 0},
 			Mask: net.CIDRMask(16, 32),
@@ -0,0 +1,58 @@
+package manager
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+)
+
+// LookupSource returns the name, namespace, labels, and service account of the running pod that has the
+// given IP. Pods that use the host network are ignored, because they don't have an IP of their own. An
+// empty SourceInfo is returned when no pod is found. Only traffic-agents can look up sources.
+func (s *service) LookupSource(ctx context.Context, request *rpc.LookupSourceRequest) (*rpc.SourceInfo, error) {
+	ctx = managerutil.WithSessionInfo(ctx, request.GetSession())
+	sessionID := request.GetSession().GetSessionId()
+	if s.state.GetAgent(sessionID) == nil {
+		return nil, status.Errorf(codes.NotFound, "Agent session %q not found", sessionID)
+	}
+	ip := net.IP(request.Ip)
+	if len(ip) != net.IPv4len && len(ip) != net.IPv6len {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid IP %v", request.Ip)
+	}
+	dlog.Debugf(ctx, "LookupSource %s", ip)
+
+	namespaces := managerutil.GetEnv(ctx).ManagedNamespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+	api := k8sapi.GetK8sInterface(ctx).CoreV1()
+	ipStr := ip.String()
+	for _, ns := range namespaces {
+		pods, err := api.Pods(ns).List(ctx, meta.ListOptions{FieldSelector: "status.podIP=" + ipStr})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if pod.Status.PodIP != ipStr || pod.Spec.HostNetwork || pod.Status.Phase != core.PodRunning {
+				continue
+			}
+			return &rpc.SourceInfo{
+				Name:           pod.Name,
+				Namespace:      pod.Namespace,
+				Labels:         pod.Labels,
+				ServiceAccount: pod.Spec.ServiceAccountName,
+			}, nil
+		}
+	}
+	return &rpc.SourceInfo{}, nil
+}
@@ -0,0 +1,148 @@
+package manager
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/state"
+)
+
+func TestLookupSource(t *testing.T) {
+	pod := func(ns, name, ip string, phase core.PodPhase, hostNetwork bool) *core.Pod {
+		return &core.Pod{
+			ObjectMeta: meta.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+				Labels:    map[string]string{"app": name},
+			},
+			Spec: core.PodSpec{
+				ServiceAccountName: name + "-sa",
+				HostNetwork:        hostNetwork,
+			},
+			Status: core.PodStatus{PodIP: ip, Phase: phase},
+		}
+	}
+	cs := fake.NewSimpleClientset(
+		pod("default", "client", "10.42.0.17", core.PodRunning, false),
+		pod("default", "completed", "10.42.0.18", core.PodSucceeded, false),
+		pod("default", "node-agent", "10.42.0.19", core.PodRunning, true),
+		pod("other", "outside", "10.42.0.20", core.PodRunning, false),
+		pod("default", "dual", "fd00::17", core.PodRunning, false),
+	)
+
+	tests := []struct {
+		name       string
+		namespaces []string
+		ip         net.IP
+		expected   *rpc.SourceInfo
+	}{
+		{
+			name: "running pod",
+			ip:   net.IP{10, 42, 0, 17},
+			expected: &rpc.SourceInfo{
+				Name:           "client",
+				Namespace:      "default",
+				Labels:         map[string]string{"app": "client"},
+				ServiceAccount: "client-sa",
+			},
+		},
+		{
+			name:     "pod that isn't running",
+			ip:       net.IP{10, 42, 0, 18},
+			expected: &rpc.SourceInfo{},
+		},
+		{
+			name:     "pod using the host network",
+			ip:       net.IP{10, 42, 0, 19},
+			expected: &rpc.SourceInfo{},
+		},
+		{
+			name:     "unknown IP",
+			ip:       net.IP{10, 42, 0, 99},
+			expected: &rpc.SourceInfo{},
+		},
+		{
+			name: "pod in any namespace",
+			ip:   net.IP{10, 42, 0, 20},
+			expected: &rpc.SourceInfo{
+				Name:           "outside",
+				Namespace:      "other",
+				Labels:         map[string]string{"app": "outside"},
+				ServiceAccount: "outside-sa",
+			},
+		},
+		{
+			name:       "pod outside of the managed namespaces",
+			namespaces: []string{"default"},
+			ip:         net.IP{10, 42, 0, 20},
+			expected:   &rpc.SourceInfo{},
+		},
+		{
+			name:       "IPv6",
+			namespaces: []string{"default", "other"},
+			ip:         net.ParseIP("fd00::17"),
+			expected: &rpc.SourceInfo{
+				Name:           "dual",
+				Namespace:      "default",
+				Labels:         map[string]string{"app": "dual"},
+				ServiceAccount: "dual-sa",
+			},
+		},
+	}
+	// newService returns a service that knows about one agent session, and the session of that agent.
+	newService := func(t *testing.T, namespaces []string) (*service, *rpc.SessionInfo) {
+		ctx := dlog.NewTestContext(t, false)
+		ctx = managerutil.WithEnv(ctx, &managerutil.Env{ManagedNamespaces: namespaces})
+		ctx = k8sapi.WithK8sInterface(ctx, cs)
+		s := &service{ctx: ctx, state: state.NewState(ctx)}
+		sessionID := s.state.AddAgent(&rpc.AgentInfo{Name: "hello", Namespace: "default", Product: "test", Version: "2.19.0"}, time.Now())
+		return s, &rpc.SessionInfo{SessionId: sessionID}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, session := newService(t, tt.namespaces)
+			si, err := s.LookupSource(s.ctx, &rpc.LookupSourceRequest{Session: session, Ip: tt.ip})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.Name, si.Name)
+			assert.Equal(t, tt.expected.Namespace, si.Namespace)
+			assert.Equal(t, tt.expected.Labels, si.Labels)
+			assert.Equal(t, tt.expected.ServiceAccount, si.ServiceAccount)
+		})
+	}
+
+	t.Run("invalid IP", func(t *testing.T) {
+		s, session := newService(t, nil)
+		_, err := s.LookupSource(s.ctx, &rpc.LookupSourceRequest{Session: session, Ip: []byte{10, 42, 0}})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		s, _ := newService(t, nil)
+		_, err := s.LookupSource(s.ctx, &rpc.LookupSourceRequest{Session: &rpc.SessionInfo{SessionId: "unknown"}, Ip: net.IP{10, 42, 0, 17}})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("client session", func(t *testing.T) {
+		s, _ := newService(t, nil)
+		sessionID := s.state.AddClient(&rpc.ClientInfo{Name: "alice", InstallId: "abc", Product: "test", Version: "2.19.0"}, time.Now())
+		_, err := s.LookupSource(s.ctx, &rpc.LookupSourceRequest{Session: &rpc.SessionInfo{SessionId: sessionID}, Ip: net.IP{10, 42, 0, 17}})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -14,10 +14,12 @@ const SessionConsumptionMetricsStaleTTL = 60 * time.Minute
 
 func NewSessionConsumptionMetrics() *SessionConsumptionMetrics {
 	return &SessionConsumptionMetrics{
-		connectDuration: 0,
-		FromClientBytes: tunnel.NewCounterProbe("FromClientBytes"),
-		ToClientBytes:   tunnel.NewCounterProbe("ToClientBytes"),
-		lastUpdate:      time.Now().UnixNano(),
+		connectDuration:     0,
+		FromClientBytes:     tunnel.NewCounterProbe("FromClientBytes"),
+		ToClientBytes:       tunnel.NewCounterProbe("ToClientBytes"),
+		FromClientWireBytes: tunnel.NewCounterProbe("FromClientWireBytes"),
+		ToClientWireBytes:   tunnel.NewCounterProbe("ToClientWireBytes"),
+		lastUpdate:          time.Now().UnixNano(),
 	}
 }
 
@@ -29,6 +31,11 @@ type SessionConsumptionMetrics struct {
 	FromClientBytes *tunnel.CounterProbe
 	// data from the traffic manager to the client.
 	ToClientBytes *tunnel.CounterProbe
+
+	// data from the client to the traffic manager, as sent over the wire after compression.
+	FromClientWireBytes *tunnel.CounterProbe
+	// data from the traffic manager to the client, as sent over the wire after compression.
+	ToClientWireBytes *tunnel.CounterProbe
 }
 
 func (m *SessionConsumptionMetrics) ConnectDuration() time.Duration {
@@ -71,6 +78,8 @@ func (s *state) AddSessionConsumptionMetrics(metrics *manager.TunnelMetrics) {
 		cm := cs.consumptionMetrics
 		cm.FromClientBytes.Increment(metrics.IngressBytes)
 		cm.ToClientBytes.Increment(metrics.EgressBytes)
+		cm.FromClientWireBytes.Increment(metrics.IngressWireBytes)
+		cm.ToClientWireBytes.Increment(metrics.EgressWireBytes)
 	}
 }
 
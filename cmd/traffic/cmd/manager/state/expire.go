@@ -0,0 +1,52 @@
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// ExpireIntercepts removes all intercepts that have outlived their time to live, and all intercepts
+// with an idle timeout whose client session hasn't sent or received any tunnel traffic for that long.
+func (s *state) ExpireIntercepts(ctx context.Context, now time.Time) {
+	expired := s.intercepts.LoadAllMatching(func(_ string, ii *rpc.InterceptInfo) bool {
+		return ii.Disposition != rpc.InterceptDispositionType_REMOVED
+	})
+	for id, ii := range expired {
+		if ea := ii.ExpiresAt; ea != nil && !now.Before(ea.AsTime()) {
+			dlog.Infof(ctx, "Intercept %s expired after %s", id, time.Duration(ii.Spec.Ttl))
+			s.self.RemoveIntercept(ctx, id)
+			continue
+		}
+		idleTimeout := time.Duration(ii.Spec.IdleTimeout)
+		if idleTimeout <= 0 {
+			continue
+		}
+		is, ok := s.interceptStates.Load(id)
+		if !ok {
+			continue
+		}
+		var traffic uint64
+		if cm := s.GetSessionConsumptionMetrics(ii.ClientSession.SessionId); cm != nil {
+			traffic = cm.FromClientBytes.GetValue() + cm.ToClientBytes.GetValue()
+		}
+		if is.idleDuration(now, traffic) >= idleTimeout {
+			dlog.Infof(ctx, "Intercept %s has been idle for more than %s", id, idleTimeout)
+			s.self.RemoveIntercept(ctx, id)
+		}
+	}
+}
+
+// idleDuration returns the time that has passed since the traffic counter last changed. The first call
+// starts the measurement.
+func (is *interceptState) idleDuration(now time.Time, traffic uint64) time.Duration {
+	is.Lock()
+	defer is.Unlock()
+	if is.lastActive.IsZero() || traffic != is.lastTraffic {
+		is.lastActive = now
+		is.lastTraffic = traffic
+	}
+	return now.Sub(is.lastActive)
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+func TestExpireIntercepts(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	s := NewState(ctx)
+	sessionID := s.AddClient(&rpc.ClientInfo{Name: "alice", InstallId: "abc", Product: "telepresence", Version: "2.19.0"}, time.Now())
+
+	addIntercept := func(name string, ttl, idleTimeout time.Duration) string {
+		_, ii, err := s.AddIntercept(ctx, sessionID, "cluster", &rpc.CreateInterceptRequest{
+			InterceptSpec: &rpc.InterceptSpec{
+				Name:        name,
+				Client:      "alice",
+				Agent:       name,
+				Namespace:   "default",
+				Mechanism:   "tcp",
+				Ttl:         int64(ttl),
+				IdleTimeout: int64(idleTimeout),
+			},
+		})
+		require.NoError(t, err)
+		return ii.Id
+	}
+	exists := func(id string) bool {
+		_, ok := s.GetIntercept(id)
+		return ok
+	}
+
+	ttlID := addIntercept("ttl", time.Hour, 0)
+	idleID := addIntercept("idle", 0, 30*time.Minute)
+	foreverID := addIntercept("forever", 0, 0)
+
+	ii, _ := s.GetIntercept(ttlID)
+	require.NotNil(t, ii.ExpiresAt)
+
+	now := time.Now()
+	s.ExpireIntercepts(ctx, now)
+	assert.True(t, exists(ttlID))
+	assert.True(t, exists(idleID))
+
+	// Traffic restarts the idle timeout.
+	s.AddSessionConsumptionMetrics(&rpc.TunnelMetrics{ClientSessionId: sessionID, IngressBytes: 10, EgressBytes: 20})
+	now = now.Add(20 * time.Minute)
+	s.ExpireIntercepts(ctx, now)
+	assert.True(t, exists(idleID))
+
+	now = now.Add(20 * time.Minute)
+	s.ExpireIntercepts(ctx, now)
+	assert.True(t, exists(idleID))
+
+	now = now.Add(15 * time.Minute)
+	s.ExpireIntercepts(ctx, now)
+	assert.True(t, exists(ttlID))
+	assert.False(t, exists(idleID))
+
+	now = now.Add(10 * time.Minute)
+	s.ExpireIntercepts(ctx, now)
+	assert.False(t, exists(ttlID))
+	assert.True(t, exists(foreverID))
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +34,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
 	"github.com/telepresenceio/telepresence/v2/pkg/version"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 // PrepareIntercept ensures that the given request can be matched against the intercept configuration of
@@ -71,12 +74,39 @@ func (s *state) PrepareIntercept(
 		}
 		return interceptError(err)
 	}
+	wl, podName, err := podAgentWorkload(ctx, wl)
+	if err != nil {
+		return interceptError(err)
+	}
 
 	ac, err := s.ensureAgent(ctx, wl, s.isExtended(spec), spec)
 	if err != nil {
 		return interceptError(err)
 	}
-	_, ic, err := findIntercept(ac, spec)
+	if spec.Ingest {
+		cn, err := findContainer(ac, spec.ContainerName)
+		if err != nil {
+			return interceptError(err)
+		}
+		return &managerrpc.PreparedIntercept{
+			Namespace:     ac.Namespace,
+			AgentImage:    ac.AgentImage,
+			WorkloadKind:  ac.WorkloadKind,
+			ContainerName: cn.Name,
+			AgentName:     ac.AgentName,
+			PodName:       podName,
+		}, nil
+	}
+	var ic *agentconfig.Intercept
+	var aps []*managerrpc.InterceptPort
+	if spec.AllPorts {
+		ic, aps, err = findAllPorts(ac, spec.ServiceName)
+	} else {
+		_, ic, err = findIntercept(ac, spec)
+		if err == nil {
+			aps, err = findAdditionalPorts(ac, ic, spec.AdditionalPorts)
+		}
+	}
 	if err != nil {
 		return interceptError(err)
 	}
@@ -88,9 +118,47 @@ func (s *state) PrepareIntercept(
 		ServicePort:     int32(ic.ServicePort),
 		AgentImage:      ac.AgentImage,
 		WorkloadKind:    ac.WorkloadKind,
+		AdditionalPorts: aps,
+		AgentName:       ac.AgentName,
+		PodName:         podName,
 	}, nil
 }
 
+// podAgentWorkload returns the workload whose traffic-agent serves an intercept of the given workload, together
+// with the name of the pod that the intercept is restricted to. Workloads other than pods are returned as is.
+//
+// A pod that is controlled by a StatefulSet keeps its name when it is recreated, so it gets a traffic-agent of its
+// own. Other controllers create replacement pods using generated names, so a pod that they control must already
+// have a traffic-agent, which is then an agent of the pod's owner workload. The intercept is restricted to the
+// agent of that pod. Bare pods cannot be recreated with a traffic-agent without losing their state, and are
+// therefore rejected.
+func podAgentWorkload(ctx context.Context, wl k8sapi.Workload) (k8sapi.Workload, string, error) {
+	pod, ok := workload.PodImpl(wl)
+	if !ok {
+		return wl, "", nil
+	}
+	or := meta.GetControllerOf(pod)
+	switch {
+	case or == nil:
+		return nil, "", errcat.User.Newf(
+			"pod %s.%s has no controller and cannot be recreated with a traffic-agent without losing its state",
+			pod.Name, pod.Namespace)
+	case or.Kind == "StatefulSet":
+		return wl, "", nil
+	}
+	if !slices.ContainsFunc(pod.Spec.Containers, func(c core.Container) bool { return c.Name == agentconfig.ContainerName }) {
+		return nil, "", errcat.User.Newf(
+			"pod %s.%s has no traffic-agent, and a pod that is controlled by %s %s gets a new name when it is recreated with one; "+
+				"intercept the %s instead, or target a pod that already has a traffic-agent",
+			pod.Name, pod.Namespace, or.Kind, or.Name, or.Kind)
+	}
+	owner, err := agentmap.FindOwnerWorkload(ctx, make(map[string]k8sapi.Workload), k8sapi.Pod(pod))
+	if err != nil {
+		return nil, "", err
+	}
+	return owner, pod.Name, nil
+}
+
 func (s *state) EnsureAgent(ctx context.Context, n, ns string) error {
 	wl, err := tracing.GetWorkload(ctx, n, ns, "")
 	if err != nil {
@@ -133,7 +201,12 @@ func (s *state) ensureAgent(parentCtx context.Context, wl k8sapi.Workload, exten
 }
 
 func (s *state) isExtended(spec *managerrpc.InterceptSpec) bool {
-	return spec.Mechanism != "tcp"
+	switch spec.Mechanism {
+	case "tcp", "http", "grpc":
+		return false
+	default:
+		return true
+	}
 }
 
 func (s *state) ValidateAgentImage(agentImage string, extended bool) (err error) {
@@ -695,6 +768,103 @@ func findIntercept(ac *agentconfig.Sidecar, spec *managerrpc.InterceptSpec) (fou
 	return nil, nil, errcat.User.Newf("%s %s.%s has no interceptable port%s", ac.WorkloadKind, ac.WorkloadName, ac.Namespace, ss)
 }
 
+// interceptPort returns an InterceptPort for the given intercept config, using the given port on the
+// intercepting workstation.
+func interceptPort(ic *agentconfig.Intercept, targetPort int32) (*managerrpc.InterceptPort, error) {
+	spi := ic.ServicePortName
+	if spi == "" {
+		spi = strconv.Itoa(int(ic.ServicePort))
+	}
+	pi, err := agentconfig.NewPortIdentifier(string(ic.Protocol), spi)
+	if err != nil {
+		return nil, err
+	}
+	return &managerrpc.InterceptPort{
+		ServicePortIdentifier: string(pi),
+		ServicePortName:       ic.ServicePortName,
+		ServicePort:           int32(ic.ServicePort),
+		Protocol:              string(ic.Protocol),
+		TargetPort:            targetPort,
+	}, nil
+}
+
+// findAdditionalPorts resolves the given additional ports. They must all be ports of the service of the
+// primary intercept config, and no service port can be intercepted more than once.
+func findAdditionalPorts(ac *agentconfig.Sidecar, primary *agentconfig.Intercept, ports []*managerrpc.InterceptPort) ([]*managerrpc.InterceptPort, error) {
+	if len(ports) == 0 {
+		return nil, nil
+	}
+	seen := map[*agentconfig.Intercept]struct{}{primary: {}}
+	aps := make([]*managerrpc.InterceptPort, len(ports))
+	for i, p := range ports {
+		_, ic, err := findIntercept(ac, &managerrpc.InterceptSpec{
+			ServiceName:           primary.ServiceName,
+			ServicePortIdentifier: p.ServicePortIdentifier,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := seen[ic]; dup {
+			return nil, errcat.User.Newf("port %s of service %s is intercepted more than once", p.ServicePortIdentifier, ic.ServiceName)
+		}
+		seen[ic] = struct{}{}
+		if aps[i], err = interceptPort(ic, p.TargetPort); err != nil {
+			return nil, err
+		}
+	}
+	return aps, nil
+}
+
+// findAllPorts finds all ports of the given service. The first port is returned as the primary intercept config
+// and the rest as additional ports. Each port is sent to the same port number on the intercepting workstation.
+// The service name can be empty if the workload only has one interceptable service.
+func findAllPorts(ac *agentconfig.Sidecar, serviceName string) (*agentconfig.Intercept, []*managerrpc.InterceptPort, error) {
+	var ics []*agentconfig.Intercept
+	for _, cn := range ac.Containers {
+		for _, ic := range cn.Intercepts {
+			if serviceName != "" && ic.ServiceName != serviceName {
+				continue
+			}
+			if len(ics) > 0 && ics[0].ServiceName != ic.ServiceName {
+				return nil, nil, errcat.User.Newf("%s %s.%s has multiple interceptable services.\n"+
+					"Please specify the service you want to intercept by passing the --service=<svc> flag.",
+					ac.WorkloadKind, ac.WorkloadName, ac.Namespace)
+			}
+			ics = append(ics, ic)
+		}
+	}
+	if len(ics) == 0 {
+		ss := ""
+		if serviceName != "" {
+			ss = " matching service " + serviceName
+		}
+		return nil, nil, errcat.User.Newf("%s %s.%s has no interceptable port%s", ac.WorkloadKind, ac.WorkloadName, ac.Namespace, ss)
+	}
+	aps := make([]*managerrpc.InterceptPort, len(ics)-1)
+	for i, ic := range ics[1:] {
+		ap, err := interceptPort(ic, int32(ic.ServicePort))
+		if err != nil {
+			return nil, nil, err
+		}
+		aps[i] = ap
+	}
+	return ics[0], aps, nil
+}
+
+// findContainer finds the container with the given name in the agent config. The first container is
+// returned when the name is empty.
+func findContainer(ac *agentconfig.Sidecar, name string) (*agentconfig.Container, error) {
+	for _, cn := range ac.Containers {
+		if name == "" || name == cn.Name {
+			return cn, nil
+		}
+	}
+	if name == "" {
+		return nil, errcat.User.Newf("%s %s.%s has no containers that can be ingested", ac.WorkloadKind, ac.WorkloadName, ac.Namespace)
+	}
+	return nil, errcat.User.Newf("%s %s.%s has no container named %s that can be ingested", ac.WorkloadKind, ac.WorkloadName, ac.Namespace, name)
+}
+
 type InterceptFinalizer func(ctx context.Context, interceptInfo *managerrpc.InterceptInfo) error
 
 type interceptState struct {
@@ -702,6 +872,10 @@ type interceptState struct {
 	lastInfoCh  chan *managerrpc.InterceptInfo
 	finalizers  []InterceptFinalizer
 	interceptID string
+
+	// lastTraffic is the tunnel traffic of the client session when the intercept was last found active
+	lastTraffic uint64
+	lastActive  time.Time
 }
 
 func newInterceptState(interceptID string) *interceptState {
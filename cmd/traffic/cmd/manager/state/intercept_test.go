@@ -0,0 +1,282 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	managerrpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
+)
+
+func TestPodAgentWorkload(t *testing.T) {
+	controller := true
+	controlledPod := func(name, kind, owner string, withAgent bool) *core.Pod {
+		pod := &core.Pod{
+			ObjectMeta: meta.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				OwnerReferences: []meta.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       kind,
+					Name:       owner,
+					Controller: &controller,
+				}},
+			},
+			Spec: core.PodSpec{Containers: []core.Container{{Name: "echo"}}},
+		}
+		if withAgent {
+			pod.Spec.Containers = append(pod.Spec.Containers, core.Container{Name: agentconfig.ContainerName})
+		}
+		return pod
+	}
+	deployment := &apps.Deployment{ObjectMeta: meta.ObjectMeta{Name: "echo", Namespace: "default"}}
+	replicaSet := &apps.ReplicaSet{ObjectMeta: meta.ObjectMeta{
+		Name:            "echo-7d9f",
+		Namespace:       "default",
+		OwnerReferences: []meta.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "echo", Controller: &controller}},
+	}}
+	ctx := dlog.NewTestContext(t, false)
+	ctx = k8sapi.WithK8sInterface(ctx, fake.NewSimpleClientset(deployment, replicaSet))
+
+	tests := []struct {
+		name         string
+		wl           k8sapi.Workload
+		wantKind     string
+		wantName     string
+		wantPod      string
+		wantErr      string
+		wantCategory errcat.Category
+	}{
+		{
+			name:     "deployment",
+			wl:       k8sapi.Deployment(deployment),
+			wantKind: "Deployment",
+			wantName: "echo",
+		},
+		{
+			name:         "bare pod",
+			wl:           workload.Pod(&core.Pod{ObjectMeta: meta.ObjectMeta{Name: "echo", Namespace: "default"}}),
+			wantErr:      "pod echo.default has no controller and cannot be recreated with a traffic-agent without losing its state",
+			wantCategory: errcat.User,
+		},
+		{
+			name:     "stateful set pod",
+			wl:       workload.Pod(controlledPod("echo-0", "StatefulSet", "echo", false)),
+			wantKind: "Pod",
+			wantName: "echo-0",
+		},
+		{
+			name:     "deployment pod with agent",
+			wl:       workload.Pod(controlledPod("echo-7d9f-x2k4p", "ReplicaSet", "echo-7d9f", true)),
+			wantKind: "Deployment",
+			wantName: "echo",
+			wantPod:  "echo-7d9f-x2k4p",
+		},
+		{
+			name: "deployment pod without agent",
+			wl:   workload.Pod(controlledPod("echo-7d9f-x2k4p", "ReplicaSet", "echo-7d9f", false)),
+			wantErr: "pod echo-7d9f-x2k4p.default has no traffic-agent, and a pod that is controlled by ReplicaSet echo-7d9f gets a new name " +
+				"when it is recreated with one; intercept the ReplicaSet instead, or target a pod that already has a traffic-agent",
+			wantCategory: errcat.User,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wl, podName, err := podAgentWorkload(ctx, tt.wl)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				assert.Equal(t, tt.wantCategory, errcat.GetCategory(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKind, wl.GetKind())
+			assert.Equal(t, tt.wantName, wl.GetName())
+			assert.Equal(t, tt.wantPod, podName)
+		})
+	}
+}
+
+func multiPortSidecar() *agentconfig.Sidecar {
+	return &agentconfig.Sidecar{
+		WorkloadName: "echo",
+		WorkloadKind: "Deployment",
+		Namespace:    "default",
+		Containers: []*agentconfig.Container{
+			{
+				Name: "echo",
+				Intercepts: []*agentconfig.Intercept{
+					{ServiceName: "echo", ServicePortName: "http", ServicePort: 80, Protocol: core.ProtocolTCP, ContainerPort: 8080},
+					{ServiceName: "echo", ServicePortName: "grpc", ServicePort: 90, Protocol: core.ProtocolTCP, ContainerPort: 9090},
+					{ServiceName: "echo", ServicePort: 53, Protocol: core.ProtocolUDP, ContainerPort: 5353},
+				},
+			},
+			{
+				Name: "admin",
+				Intercepts: []*agentconfig.Intercept{
+					{ServiceName: "echo-admin", ServicePortName: "http", ServicePort: 80, Protocol: core.ProtocolTCP, ContainerPort: 8081},
+				},
+			},
+		},
+	}
+}
+
+func TestFindAdditionalPorts(t *testing.T) {
+	ac := multiPortSidecar()
+	primary := ac.Containers[0].Intercepts[0]
+	tests := []struct {
+		name     string
+		ports    []*managerrpc.InterceptPort
+		expected []*managerrpc.InterceptPort
+		wantErr  string
+	}{
+		{
+			name: "no additional ports",
+		},
+		{
+			name:  "port name",
+			ports: []*managerrpc.InterceptPort{{ServicePortIdentifier: "grpc", TargetPort: 9000}},
+			expected: []*managerrpc.InterceptPort{{
+				ServicePortIdentifier: "grpc/TCP",
+				ServicePortName:       "grpc",
+				ServicePort:           90,
+				Protocol:              "TCP",
+				TargetPort:            9000,
+			}},
+		},
+		{
+			name: "port number and protocol",
+			ports: []*managerrpc.InterceptPort{
+				{ServicePortIdentifier: "90", TargetPort: 9000},
+				{ServicePortIdentifier: "53/UDP", TargetPort: 5300},
+			},
+			expected: []*managerrpc.InterceptPort{
+				{
+					ServicePortIdentifier: "grpc/TCP",
+					ServicePortName:       "grpc",
+					ServicePort:           90,
+					Protocol:              "TCP",
+					TargetPort:            9000,
+				},
+				{
+					ServicePortIdentifier: "53/UDP",
+					ServicePort:           53,
+					Protocol:              "UDP",
+					TargetPort:            5300,
+				},
+			},
+		},
+		{
+			name:    "primary port",
+			ports:   []*managerrpc.InterceptPort{{ServicePortIdentifier: "http", TargetPort: 9000}},
+			wantErr: "port http of service echo is intercepted more than once",
+		},
+		{
+			name: "same port twice",
+			ports: []*managerrpc.InterceptPort{
+				{ServicePortIdentifier: "grpc", TargetPort: 9000},
+				{ServicePortIdentifier: "90", TargetPort: 9001},
+			},
+			wantErr: "port 90 of service echo is intercepted more than once",
+		},
+		{
+			name:    "port of other service",
+			ports:   []*managerrpc.InterceptPort{{ServicePortIdentifier: "8081", TargetPort: 9000}},
+			wantErr: "matching service echo, port 8081",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aps, err := findAdditionalPorts(ac, primary, tt.ports)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Equal(t, errcat.User, errcat.GetCategory(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, aps)
+		})
+	}
+}
+
+func TestFindAllPorts(t *testing.T) {
+	ac := multiPortSidecar()
+	tests := []struct {
+		name        string
+		serviceName string
+		sidecar     *agentconfig.Sidecar
+		primary     *agentconfig.Intercept
+		expected    []*managerrpc.InterceptPort
+		wantErr     string
+	}{
+		{
+			name:        "all ports of service",
+			serviceName: "echo",
+			primary:     ac.Containers[0].Intercepts[0],
+			expected: []*managerrpc.InterceptPort{
+				{
+					ServicePortIdentifier: "grpc/TCP",
+					ServicePortName:       "grpc",
+					ServicePort:           90,
+					Protocol:              "TCP",
+					TargetPort:            90,
+				},
+				{
+					ServicePortIdentifier: "53/UDP",
+					ServicePort:           53,
+					Protocol:              "UDP",
+					TargetPort:            53,
+				},
+			},
+		},
+		{
+			name:        "service with one port",
+			serviceName: "echo-admin",
+			primary:     ac.Containers[1].Intercepts[0],
+			expected:    []*managerrpc.InterceptPort{},
+		},
+		{
+			name:    "multiple services",
+			wantErr: "Deployment echo.default has multiple interceptable services",
+		},
+		{
+			name:        "unknown service",
+			serviceName: "other",
+			wantErr:     "Deployment echo.default has no interceptable port matching service other",
+		},
+		{
+			name:    "no intercepts",
+			sidecar: &agentconfig.Sidecar{WorkloadName: "echo", WorkloadKind: "Deployment", Namespace: "default"},
+			wantErr: "Deployment echo.default has no interceptable port",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := tt.sidecar
+			if sc == nil {
+				sc = ac
+			}
+			primary, aps, err := findAllPorts(sc, tt.serviceName)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Equal(t, errcat.User, errcat.GetCategory(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Same(t, tt.primary, primary)
+			assert.Equal(t, tt.expected, aps)
+		})
+	}
+}
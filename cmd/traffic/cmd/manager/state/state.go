@@ -48,6 +48,7 @@ type State interface {
 	CountTunnels() int
 	CountTunnelIngress() uint64
 	CountTunnelEgress() uint64
+	ExpireIntercepts(context.Context, time.Time)
 	ExpireSessions(context.Context, time.Time, time.Time)
 	GetAgent(string) *rpc.AgentInfo
 	GetActiveAgent(string) *rpc.AgentInfo
@@ -58,6 +59,7 @@ type State interface {
 	GetAllSessionConsumptionMetrics() map[string]*SessionConsumptionMetrics
 	GetIntercept(string) (*rpc.InterceptInfo, bool)
 	GetConnectCounter() *prometheus.CounterVec
+	GetConnStats() *tunnel.ConnStatsRegistry
 	GetConnectActiveStatus() *prometheus.GaugeVec
 	GetInterceptCounter() *prometheus.CounterVec
 	GetInterceptActiveStatus() *prometheus.GaugeVec
@@ -72,6 +74,8 @@ type State interface {
 	FinalizeIntercept(ctx context.Context, intercept *rpc.InterceptInfo)
 	LoadMatchingIntercepts(filter func(string, *rpc.InterceptInfo) bool) map[string]*rpc.InterceptInfo
 	RemoveSession(context.Context, string)
+	Restore(context.Context, Store, time.Time) error
+	Persist(context.Context, Store) error
 	SessionDone(string) (<-chan struct{}, error)
 	SetTempLogLevel(context.Context, *rpc.LogLevelRequest)
 	SetAllClientSessionsFinalizer(finalizer allClientSessionsFinalizer)
@@ -134,6 +138,7 @@ type state struct {
 	timedLogLevel              log.TimedLevel
 	llSubs                     *loglevelSubscribers
 	tunnelCounter              int32
+	connStats                  *tunnel.ConnStatsRegistry
 	tunnelIngressCounter       uint64
 	tunnelEgressCounter        uint64
 	connectCounter             *prometheus.CounterVec
@@ -157,6 +162,7 @@ func NewState(ctx context.Context) State {
 		interceptStates: xsync.NewMapOf[string, *interceptState](),
 		timedLogLevel:   log.NewTimedLevel(loglevel, log.SetLevel),
 		llSubs:          newLoglevelSubscribers(),
+		connStats:       tunnel.NewConnStatsRegistry(),
 	}
 	s.self = s
 	return s
@@ -206,7 +212,7 @@ func (s *state) checkAgentsForIntercept(intercept *rpc.InterceptInfo) (errCode r
 	var agentList []*rpc.AgentInfo
 	if agentSet, ok := s.agentsByName.Load(intercept.Spec.Agent); ok {
 		agentSet.Range(func(_ string, agent *rpc.AgentInfo) bool {
-			if agent.Namespace == intercept.Spec.Namespace {
+			if managerutil.AgentServesIntercept(agent, intercept.Spec) {
 				agentList = append(agentList, agent)
 			}
 			return true
@@ -527,14 +533,20 @@ func (s *state) AddIntercept(ctx context.Context, sessionID, clusterID string, c
 }
 
 func (s *state) NewInterceptInfo(interceptID string, session *rpc.SessionInfo, ciReq *rpc.CreateInterceptRequest) *rpc.InterceptInfo {
-	return &rpc.InterceptInfo{
+	now := time.Now()
+	ii := &rpc.InterceptInfo{
 		Spec:          ciReq.InterceptSpec,
 		Disposition:   rpc.InterceptDispositionType_WAITING,
 		Message:       "Waiting for Agent approval",
 		Id:            interceptID,
 		ClientSession: session,
-		ModifiedAt:    timestamppb.Now(),
+		ModifiedAt:    timestamppb.New(now),
+		CreatedAt:     timestamppb.New(now),
 	}
+	if ttl := ciReq.InterceptSpec.Ttl; ttl > 0 {
+		ii.ExpiresAt = timestamppb.New(now.Add(time.Duration(ttl)))
+	}
+	return ii
 }
 
 func (s *state) AddInterceptFinalizer(interceptID string, finalizer InterceptFinalizer) error {
@@ -557,7 +569,7 @@ func (s *state) getAgentsInterceptedByClient(clientSessionID string) map[string]
 	}
 	return s.agents.LoadAllMatching(func(_ string, ai *rpc.AgentInfo) bool {
 		for _, ii := range intercepts {
-			if ai.Name == ii.Spec.Agent && ai.Namespace == ii.Spec.Namespace {
+			if managerutil.AgentServesIntercept(ai, ii.Spec) {
 				return true
 			}
 		}
@@ -686,6 +698,13 @@ func (s *state) Tunnel(ctx context.Context, stream tunnel.Stream) error {
 		}
 	case *clientSessionState:
 		scm = sst.ConsumptionMetrics()
+		defer func() {
+			// The bytes on the wire between the client and the traffic-manager reflect the compression of the stream.
+			if in, out, ok := tunnel.WireBytes(stream); ok {
+				scm.FromClientWireBytes.Increment(in)
+				scm.ToClientWireBytes.Increment(out)
+			}
+		}()
 	default:
 	}
 
@@ -724,10 +743,20 @@ func (s *state) Tunnel(ctx context.Context, stream tunnel.Stream) error {
 		peerSession, _ = s.sessions.Load(peerID)
 	} else {
 		span.SetAttributes(attribute.String("session-type", "userd"))
-		peerSession, err = s.getAgentForDial(ctx, sessionID, stream.ID().Destination())
-		if err != nil {
+		var agentKey string
+		if agentKey, peerSession, err = s.getAgentForDial(ctx, sessionID, stream.ID().Destination()); err != nil {
 			return err
 		}
+
+		// Keep track of the connection for the duration of this call.
+		servedBy := "traffic-manager"
+		if ai := s.GetAgent(agentKey); ai != nil {
+			servedBy = ai.PodName + "." + ai.Namespace
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		stream = s.connStats.Track(ctx, stream, servedBy, false)
 	}
 
 	var endPoint tunnel.Endpoint
@@ -747,13 +776,13 @@ func (s *state) Tunnel(ctx context.Context, stream tunnel.Stream) error {
 	return nil
 }
 
-func (s *state) getAgentForDial(ctx context.Context, clientSessionID string, podIP net.IP) (SessionState, error) {
+func (s *state) getAgentForDial(ctx context.Context, clientSessionID string, podIP net.IP) (string, SessionState, error) {
 	agentKey, err := s.getAgentIdForDial(ctx, clientSessionID, podIP)
 	if err != nil || agentKey == "" {
-		return nil, err
+		return "", nil, err
 	}
 	agent, _ := s.sessions.Load(agentKey)
-	return agent, nil
+	return agentKey, agent, nil
 }
 
 func (s *state) getAgentIdForDial(ctx context.Context, clientSessionID string, podIP net.IP) (string, error) {
@@ -834,6 +863,12 @@ func (s *state) WaitForTempLogLevel(stream rpc.Manager_WatchLogLevelServer) erro
 	return s.llSubs.subscriberLoop(stream.Context(), stream)
 }
 
+// GetConnStats returns the registry of the statistics of the connections that are tunneled
+// through the traffic-manager.
+func (s *state) GetConnStats() *tunnel.ConnStatsRegistry {
+	return s.connStats
+}
+
 func (s *state) SetPrometheusMetrics(
 	connectCounterVec *prometheus.CounterVec,
 	connectStatusGaugeVec *prometheus.GaugeVec,
@@ -0,0 +1,254 @@
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	core "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/cmd/traffic/cmd/manager/managerutil"
+)
+
+const (
+	// StoreSecretName is the name of the Secret that the secret store uses. A Secret is used because
+	// the intercepts contain the environment of the intercepted containers.
+	StoreSecretName = "traffic-manager-state"
+
+	clientsKey    = "clients"
+	interceptsKey = "intercepts"
+)
+
+// StoredState is the part of the State that survives a restart of the traffic-manager.
+type StoredState struct {
+	// Clients are keyed by session ID.
+	Clients map[string]*rpc.ClientInfo
+
+	// Intercepts are keyed by intercept ID.
+	Intercepts map[string]*rpc.InterceptInfo
+}
+
+// Store persists the client sessions and intercepts of the traffic-manager.
+type Store interface {
+	// Load returns the last saved state, or an empty state if nothing has been saved.
+	Load(context.Context) (*StoredState, error)
+
+	// Save replaces the stored state with the given state.
+	Save(context.Context, *StoredState) error
+}
+
+var NewStoreFunc = NewStore //nolint:gochecknoglobals // extension point
+
+// NewStore returns the Store that is declared by the STATE_STORE environment variable, or nil
+// when the state is kept in memory only.
+func NewStore(ctx context.Context) (Store, error) {
+	env := managerutil.GetEnv(ctx)
+	switch env.StateStore {
+	case "":
+		return nil, nil
+	case "secret":
+		return NewSecretStore(k8sapi.GetK8sInterface(ctx), env.ManagerNamespace), nil
+	default:
+		return nil, fmt.Errorf("unsupported state store %q", env.StateStore)
+	}
+}
+
+type secretStore struct {
+	ki        kubernetes.Interface
+	namespace string
+}
+
+// NewSecretStore returns a Store that keeps the state in the traffic-manager-state Secret
+// of the given namespace.
+func NewSecretStore(ki kubernetes.Interface, namespace string) Store {
+	return &secretStore{ki: ki, namespace: namespace}
+}
+
+func (c *secretStore) Load(ctx context.Context) (*StoredState, error) {
+	st := &StoredState{
+		Clients:    make(map[string]*rpc.ClientInfo),
+		Intercepts: make(map[string]*rpc.InterceptInfo),
+	}
+	sc, err := c.ki.CoreV1().Secrets(c.namespace).Get(ctx, StoreSecretName, meta.GetOptions{})
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return st, nil
+		}
+		return nil, err
+	}
+	if err = unmarshalMap(sc.Data[clientsKey], st.Clients); err != nil {
+		return nil, fmt.Errorf("unable to decode %s in Secret %s.%s: %w", clientsKey, StoreSecretName, c.namespace, err)
+	}
+	if err = unmarshalMap(sc.Data[interceptsKey], st.Intercepts); err != nil {
+		return nil, fmt.Errorf("unable to decode %s in Secret %s.%s: %w", interceptsKey, StoreSecretName, c.namespace, err)
+	}
+	return st, nil
+}
+
+func (c *secretStore) Save(ctx context.Context, st *StoredState) error {
+	clients, err := marshalMap(st.Clients)
+	if err != nil {
+		return err
+	}
+	intercepts, err := marshalMap(st.Intercepts)
+	if err != nil {
+		return err
+	}
+	data := map[string][]byte{
+		clientsKey:    clients,
+		interceptsKey: intercepts,
+	}
+	api := c.ki.CoreV1().Secrets(c.namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sc, err := api.Get(ctx, StoreSecretName, meta.GetOptions{})
+		if err != nil {
+			if !k8sErrors.IsNotFound(err) {
+				return err
+			}
+			_, err = api.Create(ctx, &core.Secret{
+				ObjectMeta: meta.ObjectMeta{
+					Name:      StoreSecretName,
+					Namespace: c.namespace,
+				},
+				Type: core.SecretTypeOpaque,
+				Data: data,
+			}, meta.CreateOptions{})
+			return err
+		}
+		sc.Data = data
+		_, err = api.Update(ctx, sc, meta.UpdateOptions{})
+		return err
+	})
+}
+
+// marshalMap encodes the given map as a JSON object where each value is the JSON encoding of a proto message.
+func marshalMap[V proto.Message](m map[string]V) ([]byte, error) {
+	jm := make(map[string]json.RawMessage, len(m))
+	for k, v := range m {
+		data, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		jm[k] = data
+	}
+	return json.Marshal(jm)
+}
+
+// unmarshalMap is the inverse of marshalMap.
+func unmarshalMap[V proto.Message](data []byte, m map[string]V) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var jm map[string]json.RawMessage
+	if err := json.Unmarshal(data, &jm); err != nil {
+		return err
+	}
+	var zero V
+	for k, raw := range jm {
+		v := zero.ProtoReflect().New().Interface().(V)
+		if err := protojson.Unmarshal(raw, v); err != nil {
+			return err
+		}
+		m[k] = v
+	}
+	return nil
+}
+
+// persistInterval is the minimum time between two saves of the state.
+const persistInterval = time.Second
+
+// Restore adds the client sessions and intercepts that were saved in the given store to the state. The
+// restored sessions are considered marked at the given time, which gives their clients a grace period in
+// which they must call Remain. Intercepts that lack an agent will transition to the WAITING state when the
+// agent arrives, so that they are reviewed again.
+func (s *state) Restore(ctx context.Context, store Store, lastMarked time.Time) error {
+	st, err := store.Load(ctx)
+	if err != nil {
+		return err
+	}
+	for sessionID, client := range st.Clients {
+		s.addClient(sessionID, client, lastMarked)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for interceptID, intercept := range st.Intercepts {
+		if _, ok := st.Clients[intercept.ClientSession.GetSessionId()]; !ok {
+			continue
+		}
+		if errCode, errMsg := s.checkAgentsForIntercept(intercept); errCode != 0 {
+			intercept.Disposition = errCode
+			intercept.Message = errMsg
+		}
+		is := newInterceptState(interceptID)
+		if intercept.Spec.Replace {
+			is.addFinalizer(s.self.RestoreAppContainer)
+		}
+		s.interceptStates.Store(interceptID, is)
+		s.intercepts.Store(interceptID, intercept)
+	}
+	dlog.Infof(ctx, "Restored %d client sessions and %d intercepts", s.clients.CountAll(), s.intercepts.CountAll())
+	return nil
+}
+
+// Persist saves the client sessions and intercepts in the given store when they change, until the
+// given context is cancelled.
+func (s *state) Persist(ctx context.Context, store Store) error {
+	clientsCh := s.clients.Subscribe(ctx)
+	interceptsCh := s.intercepts.Subscribe(ctx)
+	ticker := time.NewTicker(persistInterval)
+	defer ticker.Stop()
+
+	dirty := false
+	for {
+		select {
+		case <-ctx.Done():
+			if dirty {
+				// Save the last changes using a context that isn't cancelled.
+				ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+				defer cancel()
+				if err := s.save(ctx, store); err != nil {
+					dlog.Errorf(ctx, "unable to save state: %v", err)
+				}
+			}
+			return nil
+		case _, ok := <-clientsCh:
+			if !ok {
+				clientsCh = nil
+			}
+			dirty = true
+		case _, ok := <-interceptsCh:
+			if !ok {
+				interceptsCh = nil
+			}
+			dirty = true
+		case <-ticker.C:
+			if dirty {
+				if err := s.save(ctx, store); err != nil {
+					dlog.Errorf(ctx, "unable to save state: %v", err)
+				} else {
+					dirty = false
+				}
+			}
+		}
+	}
+}
+
+func (s *state) save(ctx context.Context, store Store) error {
+	return store.Save(ctx, &StoredState{
+		Clients: s.clients.LoadAll(),
+		Intercepts: s.intercepts.LoadAllMatching(func(_ string, ii *rpc.InterceptInfo) bool {
+			return ii.Disposition != rpc.InterceptDispositionType_REMOVED
+		}),
+	})
+}
@@ -0,0 +1,118 @@
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+func TestSecretStore(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	ki := fake.NewSimpleClientset()
+	store := NewSecretStore(ki, "ambassador")
+
+	st, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, st.Clients)
+	assert.Empty(t, st.Intercepts)
+
+	saved := &StoredState{
+		Clients: map[string]*rpc.ClientInfo{
+			"s1": {Name: "alice", InstallId: "abc", Product: "telepresence", Version: "2.19.0"},
+		},
+		Intercepts: map[string]*rpc.InterceptInfo{
+			"s1:hello": {
+				Id:            "s1:hello",
+				Spec:          &rpc.InterceptSpec{Name: "hello", Agent: "hello", Namespace: "default", Mechanism: "tcp"},
+				Disposition:   rpc.InterceptDispositionType_ACTIVE,
+				ClientSession: &rpc.SessionInfo{SessionId: "s1"},
+			},
+		},
+	}
+	require.NoError(t, store.Save(ctx, saved))
+	_, err = ki.CoreV1().Secrets("ambassador").Get(ctx, StoreSecretName, meta.GetOptions{})
+	require.NoError(t, err)
+	_, err = ki.CoreV1().ConfigMaps("ambassador").Get(ctx, StoreSecretName, meta.GetOptions{})
+	require.Error(t, err, "the state must not be stored in a ConfigMap")
+
+	// A second save updates the existing Secret
+	delete(saved.Intercepts, "s1:hello")
+	require.NoError(t, store.Save(ctx, saved))
+
+	st, err = store.Load(ctx)
+	require.NoError(t, err)
+	require.Len(t, st.Clients, 1)
+	assert.True(t, proto.Equal(saved.Clients["s1"], st.Clients["s1"]))
+	assert.Empty(t, st.Intercepts)
+}
+
+func TestRestore(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	store := NewSecretStore(fake.NewSimpleClientset(), "ambassador")
+
+	s1 := NewState(ctx)
+	persistCtx, cancel := context.WithCancel(ctx)
+	persistDone := make(chan struct{})
+	go func() {
+		defer close(persistDone)
+		_ = s1.Persist(persistCtx, store)
+	}()
+
+	sessionID := s1.AddClient(&rpc.ClientInfo{Name: "alice", InstallId: "abc", Product: "telepresence", Version: "2.19.0"}, time.Now())
+	_, ii, err := s1.AddIntercept(ctx, sessionID, "cluster", &rpc.CreateInterceptRequest{
+		InterceptSpec: &rpc.InterceptSpec{
+			Name:      "hello",
+			Client:    "alice",
+			Agent:     "hello",
+			Namespace: "default",
+			Mechanism: "tcp",
+		},
+	})
+	require.NoError(t, err)
+	s1.UpdateIntercept(ii.Id, func(ii *rpc.InterceptInfo) {
+		ii.Disposition = rpc.InterceptDispositionType_ACTIVE
+		ii.Message = ""
+	})
+	require.Eventually(t, func() bool {
+		st, err := store.Load(ctx)
+		return err == nil && len(st.Intercepts) == 1 && st.Intercepts[ii.Id].Disposition == rpc.InterceptDispositionType_ACTIVE
+	}, 5*time.Second, 100*time.Millisecond)
+	cancel()
+	<-persistDone
+
+	// Restore the state in a new instance, as if the traffic-manager was restarted.
+	s2 := NewState(ctx)
+	lastMarked := time.Now().Add(-time.Hour)
+	require.NoError(t, s2.Restore(ctx, store, lastMarked))
+
+	client := s2.GetClient(sessionID)
+	require.NotNil(t, client)
+	assert.Equal(t, "alice", client.Name)
+	sess := s2.GetSession(sessionID)
+	require.NotNil(t, sess)
+	assert.True(t, lastMarked.Equal(sess.LastMarked()))
+
+	// The intercept lacks an agent until the agent arrives and reviews it again.
+	rii, ok := s2.GetIntercept(ii.Id)
+	require.True(t, ok)
+	assert.Equal(t, rpc.InterceptDispositionType_NO_AGENT, rii.Disposition)
+	s2.AddAgent(&rpc.AgentInfo{Name: "hello", Namespace: "default", Mechanisms: []*rpc.AgentInfo_Mechanism{{Name: "tcp"}}}, time.Now())
+	rii, ok = s2.GetIntercept(ii.Id)
+	require.True(t, ok)
+	assert.Equal(t, rpc.InterceptDispositionType_WAITING, rii.Disposition)
+
+	// The restored session expires unless the client calls Remain.
+	s2.ExpireSessions(ctx, lastMarked.Add(time.Second), time.Now().Add(-time.Minute))
+	assert.Nil(t, s2.GetClient(sessionID))
+	_, ok = s2.GetIntercept(ii.Id)
+	assert.False(t, ok)
+}
@@ -1,16 +1,44 @@
 package agentconfig
 
 import (
+	"google.golang.org/protobuf/proto"
+
 	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 )
 
 // SpecMatchesIntercept answers the question if an InterceptSpec matches the given
 // Intercept config. The spec matches if:
+//   - it is not an ingest
 //   - its ServiceName is equal to the config's ServiceName
 //   - its PortIdentifier is equal to the config's ServicePortName, or can
 //     be parsed to an integer equal to the config's ServicePort
 func SpecMatchesIntercept(spec *manager.InterceptSpec, ic *Intercept) bool {
-	return ic.ServiceName == spec.ServiceName && IsInterceptFor(PortIdentifier(spec.ServicePortIdentifier), ic)
+	return !spec.Ingest && ic.ServiceName == spec.ServiceName && IsInterceptFor(PortIdentifier(spec.ServicePortIdentifier), ic)
+}
+
+// InterceptSpecFor returns the InterceptSpec that targets the given Intercept config, or nil if no port of the
+// spec matches the config. The spec itself is returned when its primary port matches. When one of its additional
+// ports matches, a copy of the spec is returned where the primary port is replaced by that additional port.
+func InterceptSpecFor(spec *manager.InterceptSpec, ic *Intercept) *manager.InterceptSpec {
+	if SpecMatchesIntercept(spec, ic) {
+		return spec
+	}
+	if spec.Ingest || ic.ServiceName != spec.ServiceName {
+		return nil
+	}
+	for _, ap := range spec.AdditionalPorts {
+		if IsInterceptFor(PortIdentifier(ap.ServicePortIdentifier), ic) {
+			ps := proto.Clone(spec).(*manager.InterceptSpec)
+			ps.ServicePortIdentifier = ap.ServicePortIdentifier
+			ps.ServicePortName = ap.ServicePortName
+			ps.ServicePort = ap.ServicePort
+			ps.Protocol = ap.Protocol
+			ps.TargetPort = ap.TargetPort
+			ps.AdditionalPorts = nil
+			return ps
+		}
+	}
+	return nil
 }
 
 // IsInterceptFor returns true when the given PortIdentifier is equal to the
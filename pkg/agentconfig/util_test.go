@@ -0,0 +1,54 @@
+package agentconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+func TestInterceptSpecFor(t *testing.T) {
+	spec := &manager.InterceptSpec{
+		Name:                  "echo",
+		ServiceName:           "echo",
+		ServicePortIdentifier: "http",
+		ServicePortName:       "http",
+		ServicePort:           80,
+		Protocol:              "TCP",
+		TargetPort:            8080,
+		AdditionalPorts: []*manager.InterceptPort{
+			{
+				ServicePortIdentifier: "metrics",
+				ServicePortName:       "metrics",
+				ServicePort:           9090,
+				Protocol:              "TCP",
+				TargetPort:            9091,
+			},
+		},
+	}
+	http := &Intercept{ServiceName: "echo", ServicePortName: "http", ServicePort: 80, Protocol: core.ProtocolTCP}
+	metrics := &Intercept{ServiceName: "echo", ServicePortName: "metrics", ServicePort: 9090, Protocol: core.ProtocolTCP}
+	grpc := &Intercept{ServiceName: "echo", ServicePortName: "grpc", ServicePort: 50051, Protocol: core.ProtocolTCP}
+	other := &Intercept{ServiceName: "other", ServicePortName: "metrics", ServicePort: 9090, Protocol: core.ProtocolTCP}
+
+	// The primary port yields the spec itself
+	assert.Same(t, spec, InterceptSpecFor(spec, http))
+
+	// An additional port yields a copy that targets that port
+	ms := InterceptSpecFor(spec, metrics)
+	require.NotNil(t, ms)
+	assert.NotSame(t, spec, ms)
+	assert.Equal(t, "echo", ms.Name)
+	assert.Equal(t, "metrics", ms.ServicePortIdentifier)
+	assert.Equal(t, int32(9090), ms.ServicePort)
+	assert.Equal(t, int32(9091), ms.TargetPort)
+	assert.Empty(t, ms.AdditionalPorts)
+	assert.Equal(t, "http", spec.ServicePortIdentifier)
+
+	// Ports and services that aren't intercepted yield nil
+	assert.Nil(t, InterceptSpecFor(spec, grpc))
+	assert.Nil(t, InterceptSpecFor(spec, other))
+}
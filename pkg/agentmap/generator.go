@@ -3,6 +3,7 @@ package agentmap
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -99,6 +100,7 @@ func (cfg *BasicGeneratorConfig) Generate(
 			return nil, err
 		}
 	}
+
 	if len(ccs) == 0 {
 		return nil, fmt.Errorf("found no service with a port that matches a container in pod %s.%s", pod.Name, pod.Namespace)
 	}
@@ -168,17 +170,6 @@ nextSvcPort:
 			ContainerPort:     uint16(appPort.ContainerPort),
 		}
 
-		// Validate that we're not being asked to clobber an existing configuration
-		var replaceContainer agentconfig.ReplacePolicy
-		if existingConfig != nil {
-			for _, cc := range existingConfig.AgentConfig().Containers {
-				if cc.Name == cn.Name {
-					replaceContainer = cc.Replace
-					break
-				}
-			}
-		}
-
 		// The container might already have intercepts declared
 		for _, cc := range ccs {
 			if cc.Name == cn.Name {
@@ -186,23 +177,43 @@ nextSvcPort:
 				continue nextSvcPort
 			}
 		}
-		var mounts []string
-		if l := len(cn.VolumeMounts); l > 0 {
-			mounts = make([]string, l)
-			for i, vm := range cn.VolumeMounts {
-				mounts[i] = vm.MountPath
+		ccs = append(ccs, newContainerConfig(cn, slices.IndexFunc(pod.Spec.Containers, func(c core.Container) bool {
+			return c.Name == cn.Name
+		}), ic, existingConfig))
+	}
+	return ccs, nil
+}
+
+// newContainerConfig creates the agent configuration of the given container, which is the container with
+// the given index in the pod. The environment prefix is derived from that index, so that it doesn't depend
+// on the order in which services and their ports are found.
+func newContainerConfig(cn *core.Container, index int, ic *agentconfig.Intercept, existingConfig agentconfig.SidecarExt) *agentconfig.Container {
+	// Validate that we're not being asked to clobber an existing configuration
+	var replaceContainer agentconfig.ReplacePolicy
+	if existingConfig != nil {
+		for _, cc := range existingConfig.AgentConfig().Containers {
+			if cc.Name == cn.Name {
+				replaceContainer = cc.Replace
+				break
 			}
 		}
-		ccs = append(ccs, &agentconfig.Container{
-			Name:       cn.Name,
-			EnvPrefix:  CapsBase26(uint64(len(ccs))) + "_",
-			MountPoint: agentconfig.MountPrefixApp + "/" + cn.Name,
-			Mounts:     mounts,
-			Intercepts: []*agentconfig.Intercept{ic},
-			Replace:    replaceContainer,
-		})
 	}
-	return ccs, nil
+
+	var mounts []string
+	if l := len(cn.VolumeMounts); l > 0 {
+		mounts = make([]string, l)
+		for i, vm := range cn.VolumeMounts {
+			mounts[i] = vm.MountPath
+		}
+	}
+	return &agentconfig.Container{
+		Name:       cn.Name,
+		EnvPrefix:  CapsBase26(uint64(index)) + "_",
+		MountPoint: agentconfig.MountPrefixApp + "/" + cn.Name,
+		Mounts:     mounts,
+		Intercepts: []*agentconfig.Intercept{ic},
+		Replace:    replaceContainer,
+	}
 }
 
 // filterServicePorts iterates through a list of ports in a service and
@@ -19,6 +19,7 @@ import (
 	"github.com/datawire/dlib/dtime"
 	"github.com/telepresenceio/telepresence/rpc/v2/agent"
 	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	client2 "github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/k8sclient"
 	"github.com/telepresenceio/telepresence/v2/pkg/dnet"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
@@ -31,6 +32,7 @@ type client struct {
 	cancelDialWatch context.CancelFunc
 	client          agent.AgentClient
 	info            *manager.AgentPodInfo
+	mux             *tunnel.Mux
 	tunnelCount     int32
 }
 
@@ -57,6 +59,10 @@ func (ac *client) Tunnel(ctx context.Context, opts ...grpc.CallOption) (tunnel.C
 	return tc, nil
 }
 
+func (ac *client) OpenStream(ctx context.Context, id tunnel.ConnID, roundtripLatency, dialTimeout time.Duration) (tunnel.Stream, error) {
+	return ac.mux.OpenStream(ctx, id, roundtripLatency, dialTimeout)
+}
+
 func newAgentClient(ctx context.Context, session *manager.SessionInfo, info *manager.AgentPodInfo) (*client, error) {
 	pfDialer := dnet.GetPortForwardDialer(ctx)
 	if pfDialer == nil {
@@ -80,6 +86,7 @@ func newAgentClient(ctx context.Context, session *manager.SessionInfo, info *man
 		client:       cli,
 		info:         info,
 	}
+	ac.mux = tunnel.NewMux(ctx, ac, session.SessionId)
 	if info.Intercepted {
 		if err = ac.startDialWatcher(ctx); err != nil {
 			cancelClient()
@@ -116,6 +123,7 @@ func (ac *client) startDialWatcher(ctx context.Context) error {
 	}
 	ac.info.Intercepted = true
 	go func() {
+		ctx := tunnel.WithCompression(ctx, client2.GetConfig(ctx).Tunnel().Compression)
 		err := tunnel.DialWaitLoop(ctx, tunnel.AgentProvider(ac.client), watcher, ac.session.SessionId)
 		if err != nil {
 			dlog.Error(ctx, err)
@@ -125,11 +133,11 @@ func (ac *client) startDialWatcher(ctx context.Context) error {
 }
 
 type Clients interface {
-	GetClient(net.IP) (ag tunnel.Provider)
+	GetClient(net.IP) (ag tunnel.StreamOpener)
 	WatchAgentPods(ctx context.Context, rmc manager.ManagerClient) error
 	WaitForIP(ctx context.Context, timeout time.Duration, ip net.IP) error
 	WaitForWorkload(ctx context.Context, timeout time.Duration, name string) error
-	GetWorkloadClient(workload string) (ag tunnel.Provider)
+	GetWorkloadClient(workload string) (ag tunnel.StreamOpener)
 	SetProxyVia(workload string)
 }
 
@@ -152,7 +160,7 @@ func NewClients(session *manager.SessionInfo) Clients {
 	}
 }
 
-// GetClient returns tunnel.Provider that opens a tunnel to a known traffic-agent.
+// GetClient returns tunnel.StreamOpener that opens a tunnel to a known traffic-agent.
 // The traffic-agent is chosen using the following rules in the order mentioned:
 //
 //  1. agent has a pod_ip that matches the given ip
@@ -160,8 +168,8 @@ func NewClients(session *manager.SessionInfo) Clients {
 //  3. any agent
 //
 // The function returns nil when there are no agents in the connected namespace.
-func (s *clients) GetClient(ip net.IP) (pvd tunnel.Provider) {
-	var primary, secondary, ternary tunnel.Provider
+func (s *clients) GetClient(ip net.IP) (pvd tunnel.StreamOpener) {
+	var primary, secondary, ternary tunnel.StreamOpener
 	s.clients.Range(func(_ string, c *client) bool {
 		switch {
 		case ip.Equal(c.info.PodIp):
@@ -184,11 +192,11 @@ func (s *clients) GetClient(ip net.IP) (pvd tunnel.Provider) {
 	return pvd
 }
 
-// GetWorkloadClient returns tunnel.Provider that opens a tunnel to a traffic-agent that
+// GetWorkloadClient returns tunnel.StreamOpener that opens a tunnel to a traffic-agent that
 // belongs to a pod created for the given workload.
 //
 // The function returns nil when there are no agents for the given workload in the connected namespace.
-func (s *clients) GetWorkloadClient(workload string) (pvd tunnel.Provider) {
+func (s *clients) GetWorkloadClient(workload string) (pvd tunnel.StreamOpener) {
 	s.clients.Range(func(_ string, ac *client) bool {
 		if ac.info.WorkloadName == workload {
 			pvd = ac
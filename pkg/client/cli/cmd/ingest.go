@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/ann"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/intercept"
+)
+
+func ingestCmd() *cobra.Command {
+	ic := &intercept.Command{}
+	cmd := &cobra.Command{
+		Use:   "ingest [flags] <workload> [-- <command with arguments...>]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Ingest a container",
+		Long: `Ingest a container of a workload. The local handler gets the environment and mounts of the container,
+but no traffic is redirected, so several users can ingest the same container at the same time.
+Use "telepresence leave <workload>` + intercept.IngestSuffix + `" to end the ingest.`,
+		Annotations: map[string]string{
+			ann.Session:           ann.Required,
+			ann.UpdateCheckFormat: ann.Tel2,
+		},
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		RunE:              ic.Run,
+		ValidArgsFunction: ic.ValidArgs,
+	}
+	ic.AddIngestFlags(cmd)
+	return cmd
+}
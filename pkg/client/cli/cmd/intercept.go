@@ -10,8 +10,13 @@ import (
 func interceptCmd() *cobra.Command {
 	ic := &intercept.Command{}
 	cmd := &cobra.Command{
-		Use:   "intercept [flags] <intercept_base_name> [-- <command with arguments...>]",
-		Args:  cobra.MinimumNArgs(1),
+		Use: "intercept [flags] <intercept_base_name> [-- <command with arguments...>]",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if ic.File != "" {
+				return nil
+			}
+			return cobra.MinimumNArgs(1)(cmd, args)
+		},
 		Short: "Intercept a service",
 		Annotations: map[string]string{
 			ann.Session:           ann.Required,
@@ -21,9 +21,19 @@ import (
 )
 
 func leave() *cobra.Command {
-	return &cobra.Command{
-		Use:  "leave [flags] <intercept_name>",
-		Args: cobra.ExactArgs(1),
+	var file string
+	var force bool
+	cmd := &cobra.Command{
+		Use: "leave [flags] <intercept_name>",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if file != "" {
+				if force {
+					return errcat.User.New("--force cannot be used together with --file")
+				}
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 
 		Short: "Remove existing intercept",
 		Annotations: map[string]string{
@@ -33,6 +43,12 @@ func leave() *cobra.Command {
 			if err := connect.InitCommand(cmd); err != nil {
 				return err
 			}
+			if file != "" {
+				return intercept.LeaveFile(cmd.Context(), file, removeIntercept)
+			}
+			if force {
+				return evictIntercept(cmd.Context(), strings.TrimSpace(args[0]))
+			}
 			return removeIntercept(cmd.Context(), strings.TrimSpace(args[0]))
 		},
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -65,6 +81,11 @@ func leave() *cobra.Command {
 			return completions, shellCompDir
 		},
 	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Remove the intercepts that are declared in this YAML or JSON file")
+	cmd.Flags().BoolVar(&force, "force", false, ``+
+		`Remove an intercept that is owned by another user. The argument is then the id of the intercept, as shown by `+
+		`"telepresence list --all-users". Requires permission to update the intercepted workload`)
+	return cmd
 }
 
 func removeIntercept(ctx context.Context, name string) error {
@@ -94,3 +115,16 @@ func removeIntercept(ctx context.Context, name string) error {
 	}
 	return err
 }
+
+func evictIntercept(ctx context.Context, id string) error {
+	_, err := daemon.GetUserClient(ctx).EvictIntercept(ctx, &manager.EvictInterceptRequest{InterceptId: id})
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			return errcat.User.Newf("Intercept with id %q not found", id)
+		case codes.PermissionDenied:
+			return errcat.User.New(st.Message())
+		}
+	}
+	return err
+}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	empty "google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/datawire/dlib/dlog"
 	"github.com/telepresenceio/telepresence/rpc/v2/connector"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/ann"
@@ -19,6 +22,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/intercept"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/output"
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/ioutil"
 )
 
 type listCommand struct {
@@ -28,6 +32,22 @@ type listCommand struct {
 	debug             bool
 	namespace         string
 	watch             bool
+	allUsers          bool
+}
+
+// interceptOwnership is the formatted output of "list --all-users". The traffic is that of the owning
+// client's session, which includes the traffic of its other intercepts.
+type interceptOwnership struct {
+	ID                  string     `json:"id"                      yaml:"id"`
+	Name                string     `json:"name"                    yaml:"name"`
+	Client              string     `json:"client,omitempty"        yaml:"client,omitempty"`
+	Workload            string     `json:"workload"                yaml:"workload"`
+	WorkloadKind        string     `json:"workload_kind,omitempty" yaml:"workload_kind,omitempty"`
+	Namespace           string     `json:"namespace"               yaml:"namespace"`
+	Disposition         string     `json:"disposition"             yaml:"disposition"`
+	StartedAt           *time.Time `json:"started_at,omitempty"    yaml:"started_at,omitempty"`
+	SessionIngressBytes uint64     `json:"session_ingress_bytes"   yaml:"session_ingress_bytes"`
+	SessionEgressBytes  uint64     `json:"session_egress_bytes"    yaml:"session_egress_bytes"`
 }
 
 type workloadJSONOutput struct {
@@ -53,6 +73,7 @@ func list() *cobra.Command {
 	flags.BoolVarP(&s.onlyInterceptable, "only-interceptable", "o", true, "interceptable workloads only")
 	flags.BoolVar(&s.debug, "debug", false, "include debugging information")
 	flags.StringVarP(&s.namespace, "namespace", "n", "", "If present, the namespace scope for this CLI request")
+	flags.BoolVar(&s.allUsers, "all-users", false, "list the intercepts of all users in the managed namespaces, together with their owners")
 
 	flags.BoolVarP(&s.watch, "watch", "w", false, "watch a namespace. --agents and --intercepts are disabled if this flag is set")
 	wf := flags.Lookup("watch")
@@ -94,6 +115,17 @@ func (s *listCommand) list(cmd *cobra.Command, _ []string) error {
 	stdout := cmd.OutOrStdout()
 	ctx := cmd.Context()
 	userD := daemon.GetUserClient(ctx)
+	if s.allUsers {
+		if output.WantsStream(cmd) {
+			return errcat.User.New("--all-users cannot be used with a streaming output format")
+		}
+		r, err := userD.ListAllIntercepts(ctx, &empty.Empty{})
+		if err != nil {
+			return err
+		}
+		s.printAllUsers(ctx, r.Intercepts, stdout, output.WantsFormatted(cmd))
+		return nil
+	}
 	var filter connector.ListRequest_Filter
 	switch {
 	case s.onlyIntercepts:
@@ -248,3 +280,51 @@ func (s *listCommand) printList(ctx context.Context, workloads []*connector.Work
 		}
 	}
 }
+
+func (s *listCommand) printAllUsers(ctx context.Context, ios []*manager.InterceptOwnership, stdout io.Writer, formattedOut bool) {
+	ous := make([]*interceptOwnership, 0, len(ios))
+	for _, ow := range ios {
+		ii := ow.Intercept
+		if s.namespace != "" && ii.Spec.Namespace != s.namespace {
+			continue
+		}
+		o := &interceptOwnership{
+			ID:                  ii.Id,
+			Name:                ii.Spec.Name,
+			Client:              ow.ClientName,
+			Workload:            ii.Spec.Agent,
+			WorkloadKind:        ii.Spec.WorkloadKind,
+			Namespace:           ii.Spec.Namespace,
+			Disposition:         ii.Disposition.String(),
+			SessionIngressBytes: ow.SessionIngressBytes,
+			SessionEgressBytes:  ow.SessionEgressBytes,
+		}
+		if ca := ii.CreatedAt; ca != nil {
+			t := ca.AsTime().Local()
+			o.StartedAt = &t
+		}
+		ous = append(ous, o)
+	}
+	if formattedOut {
+		output.Object(ctx, ous, false)
+		return
+	}
+	if len(ous) == 0 {
+		fmt.Fprintln(stdout, "No Intercepts")
+		return
+	}
+	for _, o := range ous {
+		fmt.Fprintf(stdout, "%s\n", o.ID)
+		kvf := ioutil.DefaultKeyValueFormatter()
+		kvf.Prefix = "   "
+		kvf.Add("Intercept name", o.Name)
+		kvf.Add("Client", o.Client)
+		kvf.Add("Workload", fmt.Sprintf("%s %s.%s", o.WorkloadKind, o.Workload, o.Namespace))
+		kvf.Add("State", o.Disposition)
+		if o.StartedAt != nil {
+			kvf.Add("Started", o.StartedAt.Format(time.DateTime))
+		}
+		kvf.Add("Session traffic", fmt.Sprintf("%d bytes in, %d bytes out", o.SessionIngressBytes, o.SessionEgressBytes))
+		kvf.Println(stdout)
+	}
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/recording"
+)
+
+// replay sends the traffic that was recorded using telepresence intercept --record to a local process.
+func replay() *cobra.Command {
+	var to string
+	cmd := &cobra.Command{
+		Use:   "replay <file> --to <host:port>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replay recorded intercept traffic",
+		Long: "Replay the TCP and UDP streams that were recorded using telepresence intercept --record. " +
+			"The streams are sent to the given address with the same timing and concurrency as when they " +
+			"were recorded. Responses are discarded.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return errcat.User.New(err)
+			}
+			defer f.Close()
+			n, err := recording.Replay(cmd.Context(), f, to)
+			fmt.Fprintf(cmd.OutOrStdout(), "Replayed %d streams\n", n)
+			return err
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&to, "to", "", "The address, e.g. localhost:8080, to send the recorded traffic to")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
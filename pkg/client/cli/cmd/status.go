@@ -49,25 +49,26 @@ type RootDaemonStatus struct {
 }
 
 type UserDaemonStatus struct {
-	Running           bool                     `json:"running,omitempty" yaml:"running,omitempty"`
-	InDocker          bool                     `json:"in_docker,omitempty" yaml:"in_docker,omitempty"`
-	Name              string                   `json:"name,omitempty" yaml:"name,omitempty"`
-	DaemonPort        int                      `json:"daemon_port,omitempty" yaml:"daemon_port,omitempty"`
-	ContainerNetwork  string                   `json:"container_network,omitempty" yaml:"container_network,omitempty"`
-	Hostname          string                   `json:"hostname,omitempty" yaml:"hostname,omitempty"`
-	ExposedPorts      []string                 `json:"exposedPorts,omitempty" yaml:"exposedPorts,omitempty"`
-	Version           string                   `json:"version,omitempty" yaml:"version,omitempty"`
-	Executable        string                   `json:"executable,omitempty" yaml:"executable,omitempty"`
-	InstallID         string                   `json:"install_id,omitempty" yaml:"install_id,omitempty"`
-	Status            string                   `json:"status,omitempty" yaml:"status,omitempty"`
-	Error             string                   `json:"error,omitempty" yaml:"error,omitempty"`
-	KubernetesServer  string                   `json:"kubernetes_server,omitempty" yaml:"kubernetes_server,omitempty"`
-	KubernetesContext string                   `json:"kubernetes_context,omitempty" yaml:"kubernetes_context,omitempty"`
-	Namespace         string                   `json:"namespace,omitempty" yaml:"namespace,omitempty"`
-	ManagerNamespace  string                   `json:"manager_namespace,omitempty" yaml:"manager_namespace,omitempty"`
-	MappedNamespaces  []string                 `json:"mapped_namespaces,omitempty" yaml:"mapped_namespaces,omitempty"`
-	Intercepts        []ConnectStatusIntercept `json:"intercepts,omitempty" yaml:"intercepts,omitempty"`
-	versionName       string
+	Running            bool                     `json:"running,omitempty" yaml:"running,omitempty"`
+	InDocker           bool                     `json:"in_docker,omitempty" yaml:"in_docker,omitempty"`
+	Name               string                   `json:"name,omitempty" yaml:"name,omitempty"`
+	DaemonPort         int                      `json:"daemon_port,omitempty" yaml:"daemon_port,omitempty"`
+	ContainerNetwork   string                   `json:"container_network,omitempty" yaml:"container_network,omitempty"`
+	Hostname           string                   `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	ExposedPorts       []string                 `json:"exposedPorts,omitempty" yaml:"exposedPorts,omitempty"`
+	Version            string                   `json:"version,omitempty" yaml:"version,omitempty"`
+	Executable         string                   `json:"executable,omitempty" yaml:"executable,omitempty"`
+	InstallID          string                   `json:"install_id,omitempty" yaml:"install_id,omitempty"`
+	Status             string                   `json:"status,omitempty" yaml:"status,omitempty"`
+	Error              string                   `json:"error,omitempty" yaml:"error,omitempty"`
+	KubernetesServer   string                   `json:"kubernetes_server,omitempty" yaml:"kubernetes_server,omitempty"`
+	KubernetesContext  string                   `json:"kubernetes_context,omitempty" yaml:"kubernetes_context,omitempty"`
+	Namespace          string                   `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	ManagerNamespace   string                   `json:"manager_namespace,omitempty" yaml:"manager_namespace,omitempty"`
+	MappedNamespaces   []string                 `json:"mapped_namespaces,omitempty" yaml:"mapped_namespaces,omitempty"`
+	Intercepts         []ConnectStatusIntercept `json:"intercepts,omitempty" yaml:"intercepts,omitempty"`
+	RestoredIntercepts []RestoredIntercept      `json:"restored_intercepts,omitempty" yaml:"restored_intercepts,omitempty"`
+	versionName        string
 }
 
 type ContainerizedDaemonStatus struct {
@@ -88,6 +89,14 @@ type ConnectStatusIntercept struct {
 	Client string `json:"client,omitempty" yaml:"client,omitempty"`
 }
 
+// RestoredIntercept is an intercept that the user daemon restored when it established its session.
+type RestoredIntercept struct {
+	Name            string `json:"name,omitempty" yaml:"name,omitempty"`
+	Error           string `json:"error,omitempty" yaml:"error,omitempty"`
+	WorkloadChanged bool   `json:"workload_changed,omitempty" yaml:"workload_changed,omitempty"`
+	HandlerError    string `json:"handler_error,omitempty" yaml:"handler_error,omitempty"`
+}
+
 const (
 	multiDaemonFlag = "multi-daemon"
 	jsonFlag        = "json"
@@ -293,6 +302,14 @@ func getStatusInfo(ctx context.Context, di *daemon.Info) (*StatusInfo, error) {
 				Client: icept.Spec.Client,
 			})
 		}
+		for _, ri := range status.RestoredIntercepts {
+			us.RestoredIntercepts = append(us.RestoredIntercepts, RestoredIntercept{
+				Name:            ri.Name,
+				Error:           ri.Error,
+				WorkloadChanged: ri.WorkloadChanged,
+				HandlerError:    ri.HandlerError,
+			})
+		}
 		us.Namespace = status.Namespace
 		us.ManagerNamespace = status.ManagerNamespace
 		us.MappedNamespaces = status.MappedNamespaces
@@ -337,6 +354,7 @@ func getStatusInfo(ctx context.Context, di *daemon.Info) (*StatusInfo, error) {
 			rs.DNS.IncludeSuffixes = dns.IncludeSuffixes
 			rs.DNS.Excludes = dns.Excludes
 			rs.DNS.Mappings.FromRPC(dns.Mappings)
+			rs.DNS.ForwardRules.FromRPC(dns.ForwardRules)
 			rs.DNS.LookupTimeout = dns.LookupTimeout.AsDuration()
 			rs.RoutingSnake = &client.RoutingSnake{}
 			for _, subnet := range rStatus.Subnets {
@@ -513,10 +531,32 @@ func printDNS(kvf *ioutil.KeyValueFormatter, d *client.DNSSnake) {
 		}
 		dnsKvf.Add("Mappings", "\n"+mappingsKvf.String())
 	}
+	if len(d.ForwardRules) > 0 {
+		rulesKvf := ioutil.DefaultKeyValueFormatter()
+		for _, r := range d.ForwardRules {
+			rulesKvf.Add(r.Domain, formatForwardRule(r))
+		}
+		dnsKvf.Add("Forward rules", "\n"+rulesKvf.String())
+	}
 	dnsKvf.Add("Timeout", fmt.Sprintf("%v", d.LookupTimeout))
 	kvf.Add("DNS", "\n"+dnsKvf.String())
 }
 
+func formatForwardRule(r *client.DNSForwardRule) string {
+	sb := strings.Builder{}
+	sb.WriteString(r.Server)
+	if r.Timeout > 0 {
+		fmt.Fprintf(&sb, ", timeout %v", r.Timeout)
+	}
+	switch {
+	case r.NoCache:
+		sb.WriteString(", no cache")
+	case r.CacheTTL > 0:
+		fmt.Fprintf(&sb, ", cache TTL %v", r.CacheTTL)
+	}
+	return sb.String()
+}
+
 func printRouting(kvf *ioutil.KeyValueFormatter, r *client.RoutingSnake) {
 	printSubnets := func(title string, subnets []*iputil.Subnet) {
 		if len(subnets) == 0 {
@@ -585,6 +625,25 @@ func (cs *UserDaemonStatus) print(kvf *ioutil.KeyValueFormatter) {
 		subKvf.Println(out)
 	}
 	kvf.Add("Intercepts", out.String())
+	if len(cs.RestoredIntercepts) > 0 {
+		out.Reset()
+		subKvf := ioutil.DefaultKeyValueFormatter()
+		subKvf.Indent = "  "
+		for _, ri := range cs.RestoredIntercepts {
+			switch {
+			case ri.Error != "":
+				subKvf.Add(ri.Name, "failed: "+ri.Error)
+			case ri.HandlerError != "":
+				subKvf.Add(ri.Name, "restored, but "+ri.HandlerError)
+			case ri.WorkloadChanged:
+				subKvf.Add(ri.Name, "restored, but the workload has changed since the intercept was created")
+			default:
+				subKvf.Add(ri.Name, "restored")
+			}
+		}
+		subKvf.Println(out)
+		kvf.Add("Restored intercepts", out.String())
+	}
 }
 
 func (ts *TrafficManagerStatus) MarshalJSON() ([]byte, error) {
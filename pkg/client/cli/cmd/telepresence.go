@@ -135,8 +135,8 @@ func OnlySubcommands(cmd *cobra.Command, args []string) error {
 func WithSubCommands(ctx context.Context) context.Context {
 	return MergeSubCommands(ctx,
 		configCmd(), connectCmd(), currentClusterId(), gatherLogs(), gatherTraces(), genYAML(), helmCmd(),
-		interceptCmd(), kubeauthCmd(), leave(), list(), listContexts(), listNamespaces(), loglevel(), quit(), statusCmd(),
-		testVPN(), uninstall(), uploadTraces(), version(), listNamespaces(), listContexts(),
+		ingestCmd(), interceptCmd(), kubeauthCmd(), leave(), list(), listContexts(), listNamespaces(), loglevel(), quit(), replay(), statusCmd(),
+		testVPN(), top(), uninstall(), uploadTraces(), version(), listNamespaces(), listContexts(),
 	)
 }
 
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/moby/term"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/connector"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/ann"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/output"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+type topCommand struct {
+	interval       time.Duration
+	trafficManager bool
+	allUsers       bool
+	limit          int
+}
+
+// connectionStats is the formatted output of one connection in "top".
+type connectionStats struct {
+	Protocol     string    `json:"protocol"               yaml:"protocol"`
+	Source       string    `json:"source"                 yaml:"source"`
+	Destination  string    `json:"destination"            yaml:"destination"`
+	ServedBy     string    `json:"served_by"              yaml:"served_by"`
+	Client       string    `json:"client,omitempty"       yaml:"client,omitempty"`
+	IngressBytes uint64    `json:"ingress_bytes"          yaml:"ingress_bytes"`
+	EgressBytes  uint64    `json:"egress_bytes"           yaml:"egress_bytes"`
+	IngressRate  float64   `json:"ingress_rate"           yaml:"ingress_rate"`
+	EgressRate   float64   `json:"egress_rate"            yaml:"egress_rate"`
+	DialLatency  string    `json:"dial_latency,omitempty" yaml:"dial_latency,omitempty"`
+	StartedAt    time.Time `json:"started_at"             yaml:"started_at"`
+}
+
+func top() *cobra.Command {
+	s := &topCommand{}
+	cmd := &cobra.Command{
+		Use:  "top",
+		Args: cobra.NoArgs,
+
+		Short: "Show the tunneled connections, sorted by throughput",
+		Long: "Show a live view of the active connections that are tunneled to the cluster, sorted by throughput. " +
+			"The connections are those of the root daemon unless --traffic-manager is used, in which case they are " +
+			"the ones that pass through the traffic-manager.",
+		RunE: s.run,
+		Annotations: map[string]string{
+			ann.Session: ann.Required,
+		},
+	}
+	flags := cmd.Flags()
+	flags.DurationVar(&s.interval, "interval", tunnel.DefaultStatsInterval, "time between updates")
+	flags.BoolVar(&s.trafficManager, "traffic-manager", false, "show the connections as seen by the traffic-manager")
+	flags.BoolVar(&s.allUsers, "all-users", false, "show the connections of all users. Requires --traffic-manager, and permission to read the traffic-manager's logs")
+	flags.IntVar(&s.limit, "limit", 0, "maximum number of connections to show. Zero means no limit")
+	return cmd
+}
+
+func (s *topCommand) run(cmd *cobra.Command, _ []string) error {
+	if s.allUsers && !s.trafficManager {
+		return errcat.User.New("--all-users can only be used together with --traffic-manager")
+	}
+	if s.interval < tunnel.MinStatsInterval {
+		return errcat.User.Newf("--interval must be at least %s", tunnel.MinStatsInterval)
+	}
+	if err := connect.InitCommand(cmd); err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+	stream, err := daemon.GetUserClient(ctx).WatchConnectionStats(ctx, &connector.WatchConnectionStatsRequest{
+		Interval:       durationpb.New(s.interval),
+		TrafficManager: s.trafficManager,
+		AllSessions:    s.allUsers,
+	})
+	if err != nil {
+		return err
+	}
+
+	stdout := cmd.OutOrStdout()
+	formatted := output.WantsFormatted(cmd)
+	clearScreen := !formatted && term.IsTerminal(1)
+	var rc rateCalculator
+	for n := 0; ; n++ {
+		snapshot, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return errcat.NoDaemonLogs.Newf("%v", err)
+		}
+		css := rc.update(snapshot.Connections, time.Now())
+		if s.limit > 0 && len(css) > s.limit {
+			css = css[:s.limit]
+		}
+		switch {
+		case output.WantsStream(cmd):
+			output.Object(ctx, css, false)
+		case formatted:
+			// Other formats can only output one object. Use the second snapshot, because that's
+			// the first one with rates that reflect the interval.
+			if n > 0 {
+				output.Object(ctx, css, false)
+				return nil
+			}
+		default:
+			if clearScreen {
+				// Move the cursor to the top left corner and clear the screen.
+				fmt.Fprint(stdout, "\x1b[H\x1b[2J")
+			}
+			s.print(stdout, css)
+		}
+	}
+}
+
+// rateCalculator computes the throughput of connections using consecutive snapshots.
+type rateCalculator struct {
+	prev     map[string]*connectionStats
+	prevTime time.Time
+}
+
+// update returns the given connections sorted by throughput, highest first. The rates of a connection are
+// computed from the bytes that it transferred since the previous update, or since it started if it wasn't
+// present in the previous update.
+func (rc *rateCalculator) update(conns []*manager.ConnectionStats, now time.Time) []*connectionStats {
+	css := make([]*connectionStats, len(conns))
+	next := make(map[string]*connectionStats, len(conns))
+	for i, c := range conns {
+		id := tunnel.ConnID(c.ConnId)
+		cs := &connectionStats{
+			Protocol:     ipproto.String(id.Protocol()),
+			Source:       id.SourceAddr().String(),
+			Destination:  id.DestinationAddr().String(),
+			ServedBy:     c.ServedBy,
+			Client:       c.ClientSessionId,
+			IngressBytes: c.IngressBytes,
+			EgressBytes:  c.EgressBytes,
+			StartedAt:    c.StartTime.AsTime(),
+		}
+		if c.DialLatency != nil {
+			cs.DialLatency = c.DialLatency.AsDuration().Round(time.Microsecond).String()
+		}
+
+		// The same ConnID can be reused by a later connection, so the start time is part of the key.
+		key := fmt.Sprintf("%s/%s/%d", c.ClientSessionId, id, cs.StartedAt.UnixNano())
+		var in, out uint64
+		var elapsed time.Duration
+		if p, ok := rc.prev[key]; ok {
+			in, out, elapsed = cs.IngressBytes-p.IngressBytes, cs.EgressBytes-p.EgressBytes, now.Sub(rc.prevTime)
+		} else {
+			in, out, elapsed = cs.IngressBytes, cs.EgressBytes, now.Sub(cs.StartedAt)
+		}
+		if elapsed > 0 {
+			cs.IngressRate = float64(in) / elapsed.Seconds()
+			cs.EgressRate = float64(out) / elapsed.Seconds()
+		}
+		css[i] = cs
+		next[key] = cs
+	}
+	rc.prev, rc.prevTime = next, now
+
+	sort.SliceStable(css, func(i, j int) bool {
+		ri := css[i].IngressRate + css[i].EgressRate
+		rj := css[j].IngressRate + css[j].EgressRate
+		if ri != rj {
+			return ri > rj
+		}
+		return css[i].IngressBytes+css[i].EgressBytes > css[j].IngressBytes+css[j].EgressBytes
+	})
+	return css
+}
+
+func (s *topCommand) print(out io.Writer, css []*connectionStats) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if s.allUsers {
+		fmt.Fprint(tw, "CLIENT\t")
+	}
+	fmt.Fprintln(tw, "PROTO\tSOURCE\tDESTINATION\tSERVED BY\tIN/s\tOUT/s\tIN\tOUT\tDIAL\tAGE")
+	for _, cs := range css {
+		if s.allUsers {
+			fmt.Fprintf(tw, "%s\t", cs.Client)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			cs.Protocol, cs.Source, cs.Destination, cs.ServedBy,
+			formatBytes(cs.IngressRate), formatBytes(cs.EgressRate),
+			formatBytes(float64(cs.IngressBytes)), formatBytes(float64(cs.EgressBytes)),
+			cs.DialLatency, time.Since(cs.StartedAt).Round(time.Second))
+	}
+	_ = tw.Flush()
+}
+
+// formatBytes formats the given number of bytes using binary prefixes.
+func formatBytes(n float64) string {
+	const units = "KMGTPE"
+	if n < 1024 {
+		return fmt.Sprintf("%.0fB", n)
+	}
+	i := -1
+	for n >= 1024 && i < len(units)-1 {
+		n /= 1024
+		i++
+	}
+	return fmt.Sprintf("%.1f%ciB", n, units[i])
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+func Test_rateCalculator(t *testing.T) {
+	start := time.Now()
+	conn := func(port uint16, in, out uint64) *manager.ConnectionStats {
+		id := tunnel.NewConnID(ipproto.TCP, iputil.Parse("10.0.0.1"), iputil.Parse("10.0.0.2"), port, 8080)
+		return &manager.ConnectionStats{
+			ConnId:       []byte(id),
+			ServedBy:     "traffic-manager",
+			IngressBytes: in,
+			EgressBytes:  out,
+			StartTime:    timestamppb.New(start),
+		}
+	}
+
+	var rc rateCalculator
+	css := rc.update([]*manager.ConnectionStats{conn(1000, 1000, 1000), conn(1001, 4000, 0)}, start.Add(time.Second))
+	require.Len(t, css, 2)
+	assert.Equal(t, "10.0.0.1:1001", css[0].Source)
+	assert.Equal(t, "10.0.0.2:8080", css[0].Destination)
+	assert.Equal(t, "tcp", css[0].Protocol)
+	assert.InDelta(t, 4000.0, css[0].IngressRate, 0.001)
+
+	// The rates are now computed from the difference to the previous snapshot.
+	css = rc.update([]*manager.ConnectionStats{conn(1000, 11000, 3000), conn(1001, 5000, 0)}, start.Add(2*time.Second))
+	require.Len(t, css, 2)
+	assert.Equal(t, "10.0.0.1:1000", css[0].Source)
+	assert.InDelta(t, 10000.0, css[0].IngressRate, 0.001)
+	assert.InDelta(t, 2000.0, css[0].EgressRate, 0.001)
+	assert.InDelta(t, 1000.0, css[1].IngressRate, 0.001)
+}
+
+func Test_formatBytes(t *testing.T) {
+	assert.Equal(t, "0B", formatBytes(0))
+	assert.Equal(t, "1023B", formatBytes(1023))
+	assert.Equal(t, "1.0KiB", formatBytes(1024))
+	assert.Equal(t, "1.5MiB", formatBytes(1.5*1024*1024))
+}
@@ -33,6 +33,11 @@ func (info *Info) DaemonID() *Identifier {
 	return id
 }
 
+// ErrRetainInfo is used as the cause when cancelling the context passed to KeepInfoAlive to
+// prevent that the Info is deleted. The daemon uses it when it replaces its session with a new
+// one, because the clients of the daemon must not consider it gone.
+var ErrRetainInfo = errors.New("daemon info retained")
+
 const (
 	daemonsDirName    = "daemons"
 	keepAliveInterval = 5 * time.Second
@@ -218,7 +223,8 @@ func CancelWhenRmFromCache(ctx context.Context, cancel context.CancelFunc, filen
 // any file with a modification time older than the current time minus two keepAliveIntervals
 // can be considered stale and should be removed.
 //
-// The alive poll ends and the Info is deleted when the context is cancelled.
+// The alive poll ends and the Info is deleted when the context is cancelled, unless the
+// cause of the cancellation is ErrRetainInfo.
 func KeepInfoAlive(ctx context.Context, file string) error {
 	daemonFile := filepath.Join(filelocation.AppUserCacheDir(ctx), daemonsDirName, file)
 	ticker := time.NewTicker(keepAliveInterval)
@@ -235,6 +241,10 @@ func KeepInfoAlive(ctx context.Context, file string) error {
 		}
 		select {
 		case <-ctx.Done():
+			if errors.Is(context.Cause(ctx), ErrRetainInfo) {
+				dlog.Debugf(ctx, "Retaining daemon info %s", file)
+				return nil
+			}
 			dlog.Debugf(ctx, "Deleting daemon info %s because context was cancelled", file)
 			_ = DeleteInfo(ctx, file)
 			return nil
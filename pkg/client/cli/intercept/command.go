@@ -3,34 +3,58 @@ package intercept
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/datawire/dlib/dlog"
 	"github.com/telepresenceio/telepresence/rpc/v2/connector"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/flags"
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
 )
 
 type Command struct {
-	Name           string // Command[0] || `${Command[0]}-${--namespace}` // which depends on a combinationof --workload and --namespace
-	AgentName      string // --workload || Command[0] // only valid if !localOnly
-	Port           string // --port // only valid if !localOnly
-	ServiceName    string // --service // only valid if !localOnly
-	Address        string // --address // only valid if !localOnly
-	LocalOnly      bool   // --local-only
-	LocalMountPort uint16 // --local-mount-port
-
-	Replace bool // whether --replace was passed
-
-	EnvFile  string   // --env-file
-	EnvJSON  string   // --env-json
-	Mount    string   // --mount // "true", "false", or desired mount point // only valid if !localOnly
-	MountSet bool     // whether --mount was passed
-	ToPod    []string // --to-pod
+	Name           string   // Command[0] || `${Command[0]}-${--namespace}` // which depends on a combinationof --workload and --namespace
+	AgentName      string   // --workload || --pod || Command[0] // only valid if !localOnly
+	Pod            string   // --pod // only valid if !localOnly
+	Port           string   // first --port // only valid if !localOnly
+	Ports          []string // --port (repeatable) // only valid if !localOnly
+	AllPorts       bool     // --all-ports // only valid if !localOnly
+	ServiceName    string   // --service // only valid if !localOnly
+	Address        string   // --address // only valid if !localOnly
+	LocalOnly      bool     // --local-only
+	LocalMountPort uint16   // --local-mount-port
+	Ingest         bool     // true when the command is "ingest"
+	ContainerName  string   // --container // only valid if Ingest
+
+	Replace bool  // whether --replace was passed
+	Mirror  bool  // --mirror
+	Weight  int32 // --weight
+	Update  bool  // --update
+
+	TTL         time.Duration // --ttl
+	IdleTimeout time.Duration // --idle-timeout
+
+	SourceLabels         map[string]string // --source-label
+	SourceNamespace      string            // --source-namespace
+	SourceServiceAccount string            // --source-service-account
+
+	EnvFile     string   // --env-file
+	EnvJSON     string   // --env-json
+	EnvFormat   string   // --env-format
+	EnvOverride []string // --env-override
+	EnvExclude  []string // --env-exclude
+	EnvTemplate string   // --env-template
+	Mount       string   // --mount // "true", "false", or desired mount point // only valid if !localOnly
+	MountSet    bool     // whether --mount was passed
+	ToPod       []string // --to-pod
+	Record      string   // --record
 
 	DockerRun          bool     // --docker-run
 	DockerBuild        string   // --docker-build DIR | URL
@@ -39,22 +63,50 @@ type Command struct {
 	DockerMount        string   // --docker-mount // where to mount in a docker container. Defaults to mount unless mount is "true" or "false".
 	Cmdline            []string // Command[1:]
 
-	Mechanism      string // --mechanism tcp
-	MechanismArgs  []string
+	Mechanism     string // --mechanism tcp
+	MechanismArgs []string
+
+	HTTPHeader     []string // --http-header
+	HTTPPathEqual  string   // --http-path-equal
+	HTTPPathPrefix string   // --http-path-prefix
+	HTTPPathRegex  string   // --http-path-regex
+
+	GRPCMethod   string   // --grpc-method
+	GRPCMetadata []string // --grpc-metadata
+
+	PreviewURL  bool   // --preview-url
+	IngressHost string // --ingress-host
+	IngressPort uint16 // --ingress-port
+	IngressTLS  bool   // --ingress-tls
+	IngressL5   string // --ingress-l5
+
+	File string // --file
+
 	ExtendedInfo   []byte
 	DetailedOutput bool
+
+	envEditor *envEditor
 }
 
 func (a *Command) AddFlags(cmd *cobra.Command) {
 	flagSet := cmd.Flags()
-	flagSet.StringVarP(&a.AgentName, "workload", "w", "", "Name of workload (Deployment, ReplicaSet) to intercept, if different from <name>")
-	flagSet.StringVarP(&a.Port, "port", "p", "", ``+
+	flagSet.StringVarP(&a.AgentName, "workload", "w", "", "Name of workload (Deployment, ReplicaSet, StatefulSet, DaemonSet) to intercept, if different from <name>")
+	flagSet.StringVar(&a.Pod, "pod", "", ``+
+		`Name of a single pod to intercept. Other replicas of its workload are left untouched. A pod of a StatefulSet gets a `+
+		`traffic-agent of its own. A pod of another workload must already have a traffic-agent, and only that agent serves `+
+		`the intercept. Bare pods cannot be intercepted. Mutually exclusive to --workload`)
+	flagSet.StringArrayVarP(&a.Ports, "port", "p", nil, ``+
 		`Local port to forward to. If intercepting a service with multiple ports, `+
 		`use <local port>:<svcPortIdentifier>, where the identifier is the port name or port number. `+
 		`With --docker-run and a daemon that doesn't run in docker', use <local port>:<container port> or `+
-		`<local port>:<container port>:<svcPortIdentifier>.`,
+		`<local port>:<container port>:<svcPortIdentifier>. Can be repeated to intercept several ports of the `+
+		`service using one intercept, e.g. --port 8080:http --port 9090:metrics`,
 	)
 
+	flagSet.BoolVar(&a.AllPorts, "all-ports", false, ``+
+		`Intercept all ports of the service, and forward each port to the same port number on localhost. `+
+		`Cannot be used together with --port`)
+
 	flagSet.StringVar(&a.Address, "address", "127.0.0.1", ``+
 		`Local address to forward to, Only accepts IP address as a value. `+
 		`e.g. '--address 10.0.0.2'`,
@@ -65,21 +117,129 @@ func (a *Command) AddFlags(cmd *cobra.Command) {
 	flagSet.BoolVarP(&a.LocalOnly, "local-only", "l", false, ``+
 		`Declare a local-only intercept for the purpose of getting direct outbound access to the intercept's namespace`)
 
+	a.addHandlerFlags(flagSet)
+
+	flagSet.StringVar(&a.Record, "record", "", ``+
+		`Record all intercepted TCP and UDP streams to this file. The recording can be replayed using telepresence replay`)
+
+	flagSet.StringSliceVar(&a.ToPod, "to-pod", []string{}, ``+
+		`An additional port to forward from the intercepted pod, will be made available at localhost:PORT `+
+		`Use this to, for example, access proxy/helper sidecars in the intercepted pod. The default protocol is TCP. `+
+		`Use <port>/UDP for UDP ports`)
+
+	flagSet.StringP("namespace", "n", "", "If present, the namespace scope for this CLI request")
+
+	flagSet.StringVar(&a.Mechanism, "mechanism", "tcp", "Which extension `mechanism` to use")
+
+	flagSet.StringArrayVar(&a.HTTPHeader, matcher.HeaderFlag, nil, ``+
+		`Only intercept HTTP requests with a header that matches <name>=<value>, where the value is an exact value or a `+
+		`regular expression. Can be repeated. Implies --mechanism=http`)
+
+	flagSet.StringVar(&a.HTTPPathEqual, matcher.PathEqualFlag, "", ``+
+		`Only intercept HTTP requests with a path that is equal to the given value. Implies --mechanism=http`)
+
+	flagSet.StringVar(&a.HTTPPathPrefix, matcher.PathPrefixFlag, "", ``+
+		`Only intercept HTTP requests with a path that has the given prefix. Implies --mechanism=http`)
+
+	flagSet.StringVar(&a.HTTPPathRegex, matcher.PathRegexFlag, "", ``+
+		`Only intercept HTTP requests with a path that matches the given regular expression. Implies --mechanism=http`)
+
+	flagSet.StringVar(&a.GRPCMethod, matcher.GRPCMethodFlag, "", ``+
+		`Only intercept gRPC calls to the given fully qualified method, e.g. /orders.v1.OrderService/Create, or to `+
+		`all methods of a service, e.g. /orders.v1.OrderService/*. Implies --mechanism=grpc`)
+
+	flagSet.StringArrayVar(&a.GRPCMetadata, matcher.GRPCMetadataFlag, nil, ``+
+		`Only intercept gRPC calls with metadata that matches <name>=<value>, where the value is an exact value or a `+
+		`regular expression. Can be repeated. Implies --mechanism=grpc`)
+
+	flagSet.BoolVar(&a.PreviewURL, "preview-url", false, ``+
+		`Generate a preview URL that routes HTTP requests to this intercept. Unless the --http-XXX or --grpc-XXX flags `+
+		`are given, the intercept only receives the requests that use the preview URL. Requires that the traffic-manager `+
+		`is configured with a preview domain`)
+
+	flagSet.StringVar(&a.IngressHost, "ingress-host", "", ``+
+		`The ingress hostname or IP address that requests for the preview URL are sent to. If not given, the requests `+
+		`are sent directly to the intercepted service`)
+
+	flagSet.Uint16Var(&a.IngressPort, "ingress-port", 0, ``+
+		`The ingress port. Defaults to 443 when --ingress-tls is used, or 80 otherwise`)
+
+	flagSet.BoolVar(&a.IngressTLS, "ingress-tls", false, `Use TLS when sending requests to the ingress`)
+
+	flagSet.StringVar(&a.IngressL5, "ingress-l5", "", ``+
+		`The host header to use when sending requests to the ingress. Defaults to --ingress-host`)
+
+	flagSet.StringVarP(&a.File, "file", "f", "", ``+
+		`Create the intercepts that are declared in this YAML or JSON file and run their handlers. No other flags or `+
+		`arguments can be used together with this flag`)
+
+	flagSet.BoolVarP(&a.Replace, "replace", "", false,
+		`Indicates if the traffic-agent should replace application containers in workload pods. `+
+			`The default behavior is for the agent sidecar to be installed alongside existing containers.`)
+
+	flagSet.BoolVar(&a.Mirror, "mirror", false, ``+
+		`Mirror the intercepted traffic instead of taking it over. The workload keeps serving all requests, and a copy `+
+		`of the inbound traffic is sent to the local process. Responses from the local process are discarded`)
+
+	flagSet.Int32Var(&a.Weight, "weight", 0, ``+
+		`Percentage of new connections, or of matched HTTP requests when --mechanism=http is used, that are sent to `+
+		`the local process. The rest is served by the workload. Defaults to sending everything to the local process`)
+
+	flagSet.StringToStringVar(&a.SourceLabels, "source-label", nil, ``+
+		`Only intercept connections from pods that have this label. Can be repeated. Connections from other pods `+
+		`are served by the workload, so that several clients can intercept the same port`)
+
+	flagSet.StringVar(&a.SourceNamespace, "source-namespace", "", ``+
+		`Only intercept connections from pods in this namespace`)
+
+	flagSet.StringVar(&a.SourceServiceAccount, "source-service-account", "", ``+
+		`Only intercept connections from pods that use this service account`)
+
+	flagSet.DurationVar(&a.TTL, "ttl", 0, ``+
+		`Remove the intercept when it has existed for this long, e.g. 2h. The traffic-manager may enforce a shorter maximum`)
+
+	flagSet.DurationVar(&a.IdleTimeout, "idle-timeout", 0, ``+
+		`Remove the intercept when no traffic has passed through the tunnels of this client for this long, e.g. 30m`)
+
+	flagSet.BoolVar(&a.Update, "update", false, ``+
+		`Update an existing intercept in place. Only --port, --to-pod, --mechanism, --preview-url, and the --http-XXX, --grpc-XXX, `+
+		`and --ingress-XXX flags can be used together with this flag. The mounts and the environment of the intercept are retained`)
+
+	// Hide these flags. They are still functional but deprecated. Using them will yield a deprecation message.
+	flagSet.Lookup("local-only").Hidden = true
+	flagSet.Lookup("namespace").Hidden = true
+}
+
+// addHandlerFlags adds the flags that control how the environment and mounts are provided to the
+// local handler. These flags are shared by the intercept and the ingest commands.
+func (a *Command) addHandlerFlags(flagSet *pflag.FlagSet) {
 	flagSet.StringVarP(&a.EnvFile, "env-file", "e", "", ``+
-		`Also emit the remote environment to an env file in Docker Compose format. `+
-		`See https://docs.docker.com/compose/env-file/ for more information on the limitations of this format.`)
+		`Also emit the remote environment to an env file in Docker Compose format, or in the format given by --env-format. `+
+		`See https://docs.docker.com/compose/env-file/ for more information on the limitations of the Docker Compose format.`)
 
 	flagSet.StringVarP(&a.EnvJSON, "env-json", "j", "", `Also emit the remote environment to a file as a JSON blob.`)
 
+	flagSet.StringVar(&a.EnvFormat, "env-format", EnvFormatCompose, ``+
+		`The format of the --env-file. One of `+strings.Join(EnvFormats, ", ")+`. The "dotenv", "sh", and "direnv" formats `+
+		`use export statements, "systemd" is an EnvironmentFile that cannot contain values with line breaks, and "k8s-secret" `+
+		`is a Kubernetes Secret manifest`)
+
+	flagSet.StringArrayVar(&a.EnvOverride, "env-override", nil, ``+
+		`Set an environment variable in the form KEY=VALUE, overriding the remote value. Can be repeated`)
+
+	flagSet.StringArrayVar(&a.EnvExclude, "env-exclude", nil, ``+
+		`Remove the remote environment variables with names that match this regular expression. The expression `+
+		`must match the whole name. Can be repeated`)
+
+	flagSet.StringVar(&a.EnvTemplate, "env-template", "", ``+
+		`File with lines in the form KEY=TEMPLATE, where TEMPLATE is a Go template with sprig functions that is `+
+		`executed using the remote environment, e.g. DB_HOST={{ .DB_HOST | trimSuffix ".svc.cluster.local" }}. `+
+		`Applied after --env-exclude and before --env-override`)
+
 	flagSet.StringVar(&a.Mount, "mount", "true", ``+
 		`The absolute path for the root directory where volumes will be mounted, $TELEPRESENCE_ROOT. Use "true" to `+
 		`have Telepresence pick a random mount point (default). Use "false" to disable filesystem mounting entirely.`)
 
-	flagSet.StringSliceVar(&a.ToPod, "to-pod", []string{}, ``+
-		`An additional port to forward from the intercepted pod, will be made available at localhost:PORT `+
-		`Use this to, for example, access proxy/helper sidecars in the intercepted pod. The default protocol is TCP. `+
-		`Use <port>/UDP for UDP ports`)
-
 	flagSet.BoolVar(&a.DockerRun, "docker-run", false, ``+
 		`Run a Docker container with intercepted environment, volume mount, by passing arguments after -- to 'docker run', `+
 		`e.g. '--docker-run -- -it --rm ubuntu:20.04 /bin/bash'`)
@@ -97,23 +257,11 @@ func (a *Command) AddFlags(cmd *cobra.Command) {
 	flagSet.StringVar(&a.DockerMount, "docker-mount", "", ``+
 		`The volume mount point in docker. Defaults to same as "--mount"`)
 
-	flagSet.StringP("namespace", "n", "", "If present, the namespace scope for this CLI request")
-
-	flagSet.StringVar(&a.Mechanism, "mechanism", "tcp", "Which extension `mechanism` to use")
-
 	flagSet.BoolVar(&a.DetailedOutput, "detailed-output", false,
 		`Provide very detailed info about the intercept when used together with --output=json or --output=yaml'`)
 
 	flagSet.Uint16Var(&a.LocalMountPort, "local-mount-port", 0,
 		`Do not mount remote directories. Instead, expose this port on localhost to an external mounter`)
-
-	flagSet.BoolVarP(&a.Replace, "replace", "", false,
-		`Indicates if the traffic-agent should replace application containers in workload pods. `+
-			`The default behavior is for the agent sidecar to be installed alongside existing containers.`)
-
-	// Hide these flags. They are still functional but deprecated. Using them will yield a deprecation message.
-	flagSet.Lookup("local-only").Hidden = true
-	flagSet.Lookup("namespace").Hidden = true
 }
 
 func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
@@ -124,15 +272,27 @@ func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
 	}
 	a.Name = positional[0]
 	a.Cmdline = positional[1:]
+	if len(a.Ports) > 0 {
+		a.Port = a.Ports[0]
+	}
+	if err := a.validatePreview(cmd); err != nil {
+		return err
+	}
+	if a.Update {
+		return a.validateUpdate(cmd)
+	}
+	if a.TTL < 0 || a.IdleTimeout < 0 {
+		return errcat.User.New("--ttl and --idle-timeout must not be negative")
+	}
 	if a.LocalOnly {
 		// Not actually intercepting anything -- check that the flags make sense for that
-		if a.AgentName != "" {
+		if a.AgentName != "" || a.Pod != "" {
 			return errcat.User.New("a local-only intercept cannot have a workload")
 		}
 		if a.ServiceName != "" {
 			return errcat.User.New("a local-only intercept cannot have a service")
 		}
-		if cmd.Flag("port").Changed {
+		if cmd.Flag("port").Changed || a.AllPorts {
 			return errcat.User.New("a local-only intercept cannot have a port")
 		}
 		if cmd.Flag("mount").Changed {
@@ -143,18 +303,76 @@ func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
 		return nil
 	}
 
-	if a.LocalMountPort > 0 && client.GetConfig(cmd.Context()).Intercept().UseFtp {
-		return errcat.User.New("only SFTP can be used with --local-mount-port. Client is configured to perform remote mounts using FTP")
-	}
-
 	// Actually intercepting something
+	if a.Pod != "" {
+		if a.AgentName != "" {
+			return errcat.User.New("--pod and --workload are mutually exclusive")
+		}
+		a.AgentName = a.Pod
+	}
 	if a.AgentName == "" {
 		a.AgentName = a.Name
 	}
-	if a.Port == "" {
+	switch {
+	case a.AllPorts:
+		if a.Port != "" {
+			return errcat.User.New("--all-ports cannot be used together with --port")
+		}
+	case a.Port == "":
 		a.Port = strconv.Itoa(client.GetConfig(cmd.Context()).Intercept().DefaultPort)
 	}
+	if a.Record != "" && (a.AllPorts || len(a.Ports) > 1) {
+		return errcat.User.New("--record can only be used when intercepting one port")
+	}
+	if err := a.validateHandler(cmd); err != nil {
+		return err
+	}
+	if err := a.validateMechanism(cmd); err != nil {
+		return err
+	}
+	if err := a.previewMechanism(cmd); err != nil {
+		return err
+	}
+	if a.Weight < 0 || a.Weight > 100 {
+		return errcat.User.New("--weight must be a percentage between 0 and 100")
+	}
+	if a.Mirror {
+		if a.Replace {
+			return errcat.User.New("--mirror cannot be used together with --replace")
+		}
+		if a.Mechanism != "tcp" {
+			return errcat.User.New("--mirror can only be used with --mechanism=tcp")
+		}
+	}
+	if a.SourceSelector() != nil {
+		if a.Replace {
+			return errcat.User.New("--source-XXX flags cannot be used together with --replace")
+		}
+		if a.Mechanism != "tcp" {
+			return errcat.User.New("--source-XXX flags can only be used with --mechanism=tcp")
+		}
+	}
+	return nil
+}
+
+// validateHandler validates the flags added by addHandlerFlags.
+func (a *Command) validateHandler(cmd *cobra.Command) error {
+	if a.LocalMountPort > 0 && client.GetConfig(cmd.Context()).Intercept().UseFtp {
+		return errcat.User.New("only SFTP can be used with --local-mount-port. Client is configured to perform remote mounts using FTP")
+	}
 	a.MountSet = cmd.Flag("mount").Changed
+	if cmd.Flag("env-format").Changed {
+		if a.EnvFile == "" {
+			return errcat.User.New("--env-format must be used together with --env-file")
+		}
+		if err := validateEnvFormat(a.EnvFormat); err != nil {
+			return err
+		}
+	}
+	var err error
+	if a.envEditor, err = newEnvEditor(a.EnvOverride, a.EnvExclude, a.EnvTemplate); err != nil {
+		return err
+	}
 	drCount := 0
 	if a.DockerRun {
 		drCount++
@@ -170,20 +388,96 @@ func (a *Command) Validate(cmd *cobra.Command, positional []string) error {
 	}
 	a.DockerRun = drCount == 1
 	if a.DockerRun {
-		if err := a.ValidateDockerArgs(); err != nil {
-			return err
+		return a.ValidateDockerArgs()
+	}
+	return nil
+}
+
+// SourceSelector returns the selector of the pods that the intercept receives connections from, or nil
+// when the intercept receives connections from everywhere.
+func (a *Command) SourceSelector() *manager.SourceSelector {
+	if len(a.SourceLabels) == 0 && a.SourceNamespace == "" && a.SourceServiceAccount == "" {
+		return nil
+	}
+	return &manager.SourceSelector{
+		Labels:         a.SourceLabels,
+		Namespace:      a.SourceNamespace,
+		ServiceAccount: a.SourceServiceAccount,
+	}
+}
+
+// mechanismFlags are the flags of the intercept command that are converted into mechanism args.
+var mechanismFlags = []string{ //nolint:gochecknoglobals // this is a constant
+	matcher.HeaderFlag, matcher.PathEqualFlag, matcher.PathPrefixFlag, matcher.PathRegexFlag,
+	matcher.GRPCMethodFlag, matcher.GRPCMetadataFlag,
+}
+
+// validateMechanism converts the --http-XXX and --grpc-XXX flags into mechanism args, and ensures that
+// they are used together with the "http" and "grpc" mechanism respectively.
+func (a *Command) validateMechanism(cmd *cobra.Command) error {
+	flagSet := cmd.Flags()
+	implied := ""
+	for _, f := range mechanismFlags {
+		if !flagSet.Changed(f) {
+			continue
+		}
+		m := "http"
+		if strings.HasPrefix(f, "grpc-") {
+			m = "grpc"
+		}
+		if implied != "" && implied != m {
+			return errcat.User.New("the --http-XXX flags cannot be used together with the --grpc-XXX flags")
+		}
+		implied = m
+		switch f {
+		case matcher.HeaderFlag:
+			for _, h := range a.HTTPHeader {
+				a.MechanismArgs = append(a.MechanismArgs, "--"+f+"="+h)
+			}
+		case matcher.GRPCMetadataFlag:
+			for _, md := range a.GRPCMetadata {
+				a.MechanismArgs = append(a.MechanismArgs, "--"+f+"="+md)
+			}
+		default:
+			a.MechanismArgs = append(a.MechanismArgs, "--"+f+"="+flagSet.Lookup(f).Value.String())
+		}
+	}
+	if implied != "" && !flagSet.Changed("mechanism") {
+		a.Mechanism = implied
+	}
+	switch a.Mechanism {
+	case "tcp":
+		if len(a.MechanismArgs) > 0 {
+			return errcat.User.Newf(`the --%s-XXX flags can only be used with --mechanism=%s`, implied, implied)
+		}
+	case "http", "grpc":
+		if implied != "" && implied != a.Mechanism {
+			return errcat.User.Newf(`the --%s-XXX flags can only be used with --mechanism=%s`, implied, implied)
+		}
+		if _, err := matcher.NewRequestFromMechanismArgs(a.Mechanism, a.MechanismArgs); err != nil {
+			return errcat.User.New(err)
 		}
 	}
 	return nil
 }
 
 func (a *Command) Run(cmd *cobra.Command, positional []string) error {
-	if err := a.Validate(cmd, positional); err != nil {
+	if a.File != "" {
+		return a.runFile(cmd, positional)
+	}
+	validate := a.Validate
+	if a.Ingest {
+		validate = a.ValidateIngest
+	}
+	if err := validate(cmd, positional); err != nil {
 		return err
 	}
 	if err := connect.InitCommand(cmd); err != nil {
 		return err
 	}
+	if a.Update {
+		return a.update(cmd)
+	}
 	return NewState(cmd, a).Run(cmd.Context())
 }
 
@@ -207,8 +501,7 @@ func (a *Command) ValidArgs(cmd *cobra.Command, args []string, toComplete string
 	req := connector.ListRequest{
 		Filter: connector.ListRequest_INTERCEPTABLE,
 	}
-	nf := cmd.Flag("namespace")
-	if nf.Changed {
+	if nf := cmd.Flag("namespace"); nf != nil && nf.Changed {
 		req.Namespace = nf.Value.String()
 	}
 	ctx := cmd.Context()
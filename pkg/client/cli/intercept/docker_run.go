@@ -175,7 +175,11 @@ func (s *state) startInDocker(ctx context.Context, envFile string, args []string
 		return dr
 	}
 	if dr.name == "" {
-		dr.name = fmt.Sprintf("intercept-%s-%d", s.Name(), s.localPort)
+		if s.Ingest {
+			dr.name = s.Name()
+		} else {
+			dr.name = fmt.Sprintf("intercept-%s-%d", s.Name(), s.localPort)
+		}
 		ourArgs = append(ourArgs, "--name", dr.name)
 	}
 	if s.DockerDebug != "" {
@@ -187,6 +191,11 @@ func (s *state) startInDocker(ctx context.Context, envFile string, args []string
 		if s.dockerPort != 0 {
 			ourArgs = append(ourArgs, "-p", fmt.Sprintf("%d:%d", s.localPort, s.dockerPort))
 		}
+		for _, pm := range s.extraPorts {
+			if pm.docker != 0 {
+				ourArgs = append(ourArgs, "-p", fmt.Sprintf("%d:%d", pm.local, pm.docker))
+			}
+		}
 		dockerMount := ""
 		if s.mountPoint != "" { // do we have a mount point at all?
 			if dockerMount = s.DockerMount; dockerMount == "" {
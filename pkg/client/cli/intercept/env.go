@@ -0,0 +1,123 @@
+package intercept
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+// envEditor edits the remote environment before it reaches the local handler, the --env-file, and the --env-json.
+// The edits are applied in the following order:
+//  1. Variables with names that match an --env-exclude expression are removed.
+//  2. Variables declared in the --env-template file are set. The templates are executed using the unedited
+//     remote environment, so they can use the values of excluded variables.
+//  3. Variables given with --env-override are set.
+type envEditor struct {
+	excludes  []*regexp.Regexp
+	templates []envTemplate
+	overrides []envOverride
+}
+
+type envTemplate struct {
+	key  string
+	tmpl *template.Template
+}
+
+type envOverride struct {
+	key   string
+	value string
+}
+
+// newEnvEditor returns an editor for the given --env-override, --env-exclude, and --env-template flag values,
+// or nil if the flags contain no edits.
+func newEnvEditor(overrides, excludes []string, templateFile string) (*envEditor, error) {
+	if len(overrides) == 0 && len(excludes) == 0 && templateFile == "" {
+		return nil, nil
+	}
+	e := &envEditor{}
+	for _, x := range excludes {
+		// The expression must match the whole name of the variable.
+		rx, err := regexp.Compile("^(?:" + x + ")$")
+		if err != nil {
+			return nil, errcat.User.Newf("invalid --env-exclude %q: %v", x, err)
+		}
+		e.excludes = append(e.excludes, rx)
+	}
+	for _, o := range overrides {
+		k, v, ok := strings.Cut(o, "=")
+		if !ok || k == "" {
+			return nil, errcat.User.Newf("invalid --env-override %q: must be in the form KEY=VALUE", o)
+		}
+		e.overrides = append(e.overrides, envOverride{key: k, value: v})
+	}
+	if templateFile != "" {
+		if err := e.loadTemplates(templateFile); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// loadTemplates loads the given --env-template file. Each line of the file is in the form KEY=TEMPLATE, where
+// TEMPLATE is a Go template that can use the sprig functions. Empty lines and lines starting with '#' are ignored.
+func (e *envEditor) loadTemplates(templateFile string) error {
+	data, err := os.ReadFile(templateFile)
+	if err != nil {
+		return errcat.User.New(err)
+	}
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for ln := 1; sc.Scan(); ln++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok || k == "" {
+			return errcat.User.Newf("%s:%d: must be in the form KEY=TEMPLATE", templateFile, ln)
+		}
+		tmpl, err := template.New(k).Funcs(sprig.TxtFuncMap()).Option("missingkey=zero").Parse(v)
+		if err != nil {
+			return errcat.User.Newf("%s:%d: %v", templateFile, ln, err)
+		}
+		e.templates = append(e.templates, envTemplate{key: k, tmpl: tmpl})
+	}
+	return sc.Err()
+}
+
+// edit applies the edits to the given environment.
+func (e *envEditor) edit(env map[string]string) error {
+	var templated map[string]string
+	if len(e.templates) > 0 {
+		templated = make(map[string]string, len(e.templates))
+		sb := strings.Builder{}
+		for _, et := range e.templates {
+			sb.Reset()
+			if err := et.tmpl.Execute(&sb, env); err != nil {
+				return errcat.User.Newf("unable to execute the --env-template for %s: %v", et.key, err)
+			}
+			templated[et.key] = sb.String()
+		}
+	}
+	for k := range env {
+		for _, rx := range e.excludes {
+			if rx.MatchString(k) {
+				delete(env, k)
+				break
+			}
+		}
+	}
+	for k, v := range templated {
+		env[k] = v
+	}
+	for _, o := range e.overrides {
+		env[o.key] = o.value
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package intercept
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvEditor(t *testing.T) {
+	tf := filepath.Join(t.TempDir(), "env.tpl")
+	require.NoError(t, os.WriteFile(tf, []byte(`
+# Use the local database
+DB_HOST={{ .DB_HOST | trimSuffix ".svc.cluster.local" }}
+DB_URL=postgres://{{ .DB_USER }}@localhost:{{ .DB_PORT }}
+MISSING={{ .NO_SUCH_VAR }}
+`), 0o600))
+
+	e, err := newEnvEditor([]string{"DB_PORT=15432", "EMPTY="}, []string{"AWS_.*", "DB_USER"}, tf)
+	require.NoError(t, err)
+
+	env := map[string]string{
+		"DB_HOST":        "postgres.db.svc.cluster.local",
+		"DB_USER":        "orders",
+		"DB_PORT":        "5432",
+		"AWS_REGION":     "eu-north-1",
+		"MY_AWS_PROFILE": "dev",
+	}
+	require.NoError(t, e.edit(env))
+	assert.Equal(t, map[string]string{
+		"DB_HOST":        "postgres.db",
+		"DB_URL":         "postgres://orders@localhost:5432",
+		"DB_PORT":        "15432",
+		"MISSING":        "",
+		"EMPTY":          "",
+		"MY_AWS_PROFILE": "dev",
+	}, env)
+}
+
+func TestEnvEditor_invalid(t *testing.T) {
+	e, err := newEnvEditor(nil, nil, "")
+	require.NoError(t, err)
+	assert.Nil(t, e)
+
+	_, err = newEnvEditor([]string{"NOVALUE"}, nil, "")
+	assert.ErrorContains(t, err, "must be in the form KEY=VALUE")
+
+	_, err = newEnvEditor(nil, []string{"AWS_("}, "")
+	assert.ErrorContains(t, err, "invalid --env-exclude")
+
+	tf := filepath.Join(t.TempDir(), "env.tpl")
+	require.NoError(t, os.WriteFile(tf, []byte("DB_HOST={{ .DB_HOST\n"), 0o600))
+	_, err = newEnvEditor(nil, nil, tf)
+	assert.ErrorContains(t, err, "env.tpl:1:")
+}
@@ -0,0 +1,105 @@
+package intercept
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/shellquote"
+)
+
+// The formats that can be used with --env-format.
+const (
+	EnvFormatCompose   = "compose"
+	EnvFormatDotenv    = "dotenv"
+	EnvFormatSh        = "sh"
+	EnvFormatDirenv    = "direnv"
+	EnvFormatSystemd   = "systemd"
+	EnvFormatK8sSecret = "k8s-secret"
+)
+
+// EnvFormats are the formats that can be used with --env-format.
+var EnvFormats = []string{EnvFormatCompose, EnvFormatDotenv, EnvFormatSh, EnvFormatDirenv, EnvFormatSystemd, EnvFormatK8sSecret}
+
+func validateEnvFormat(format string) error {
+	for _, f := range EnvFormats {
+		if f == format {
+			return nil
+		}
+	}
+	return errcat.User.Newf("invalid --env-format %q, must be one of %s", format, strings.Join(EnvFormats, ", "))
+}
+
+var (
+	envKeyRx       = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	dotenvEscaper  = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "\n", `\n`)
+	systemdEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "`", "\\`")
+)
+
+// writeEnv writes the given environment to w using the given format. The name is used as the name of the
+// secret when the format is EnvFormatK8sSecret. Keys that aren't valid variable names are skipped with a
+// warning, unless the format is EnvFormatK8sSecret, because they would be written verbatim and could inject
+// arbitrary content into a file that might be sourced by a shell.
+func writeEnv(ctx context.Context, w io.Writer, format, name string, env map[string]string) error {
+	if format == EnvFormatK8sSecret {
+		data, err := yaml.Marshal(map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]string{"name": name},
+			"type":       "Opaque",
+			"stringData": env,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+
+	var line func(k, v string) string
+	switch format {
+	case EnvFormatDotenv:
+		// The export prefix is understood by dotenv parsers, and makes it possible to source the file in a shell.
+		line = func(k, v string) string { return "export " + k + `="` + dotenvEscaper.Replace(v) + `"` }
+	case EnvFormatSh, EnvFormatDirenv:
+		line = func(k, v string) string { return "export " + k + "=" + shellquote.Posix(v) }
+	case EnvFormatSystemd:
+		line = func(k, v string) string { return k + `="` + systemdEscaper.Replace(v) + `"` }
+	default:
+		// Docker Compose format. See https://docs.docker.com/compose/env-file/ for its limitations.
+		line = func(k, v string) string { return k + "=" + v }
+	}
+
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		if !envKeyRx.MatchString(k) {
+			dlog.Warnf(ctx, "environment variable %q is not written to the environment file because its name is invalid", k)
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	bw := bufio.NewWriter(w)
+	for _, k := range keys {
+		v := env[k]
+		if format == EnvFormatSystemd && strings.ContainsAny(v, "\r\n") {
+			// A systemd EnvironmentFile has no escape sequence for line breaks.
+			return errcat.User.Newf("the value of %s contains a line break, which cannot be written using --env-format %s", k, format)
+		}
+		if _, err := bw.WriteString(line(k, v)); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,114 @@
+package intercept
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func TestWriteEnv(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	env := map[string]string{
+		"GREETING": `it's "$HOME"`,
+		"LINES":    "one\ntwo",
+		"PLAIN":    "value",
+	}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: EnvFormatCompose,
+			want:   "GREETING=it's \"$HOME\"\nLINES=one\ntwo\nPLAIN=value\n",
+		},
+		{
+			format: EnvFormatDotenv,
+			want:   `export GREETING="it's \"\$HOME\""` + "\n" + `export LINES="one\ntwo"` + "\n" + `export PLAIN="value"` + "\n",
+		},
+		{
+			format: EnvFormatSh,
+			want:   `export GREETING=it\''s "$HOME"'` + "\n" + "export LINES='one\ntwo'\n" + "export PLAIN=value\n",
+		},
+		{
+			format: EnvFormatK8sSecret,
+			want: `apiVersion: v1
+kind: Secret
+metadata:
+  name: orders
+stringData:
+  GREETING: it's "$HOME"
+  LINES: |-
+    one
+    two
+  PLAIN: value
+type: Opaque
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			sb := strings.Builder{}
+			require.NoError(t, writeEnv(ctx, &sb, tt.format, "orders", env))
+			assert.Equal(t, tt.want, sb.String())
+		})
+	}
+}
+
+func TestWriteEnv_Systemd(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	sb := strings.Builder{}
+	require.NoError(t, writeEnv(ctx, &sb, EnvFormatSystemd, "orders", map[string]string{
+		"GREETING": "it's \"$HOME\" `now`",
+		"PLAIN":    "value",
+	}))
+	assert.Equal(t, "GREETING=\"it's \\\"\\$HOME\\\" \\`now\\`\"\nPLAIN=\"value\"\n", sb.String())
+
+	// Line breaks cannot be escaped in an EnvironmentFile.
+	for _, v := range []string{"one\ntwo", "one\r\ntwo", "one\r"} {
+		sb.Reset()
+		err := writeEnv(ctx, &sb, EnvFormatSystemd, "orders", map[string]string{"LINES": v, "PLAIN": "value"})
+		require.Error(t, err)
+		assert.Equal(t, errcat.User, errcat.GetCategory(err))
+		assert.Contains(t, err.Error(), "LINES")
+		assert.Empty(t, sb.String())
+	}
+}
+
+func TestWriteEnv_InvalidKeys(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	env := map[string]string{
+		"PLAIN":                  "value",
+		"_UNDERSCORE1":           "ok",
+		"X=1\nexport EVIL":       "pwned",
+		"A;touch /tmp/pwned;B":   "pwned",
+		"$(touch /tmp/pwned)":    "pwned",
+		"WITH SPACE":             "pwned",
+		"1DIGIT":                 "pwned",
+		"dotted.name":            "pwned",
+		"export EVIL=`id` #":     "pwned",
+		"NEWLINE\nexport EVIL=1": "pwned",
+	}
+	for _, format := range []string{EnvFormatCompose, EnvFormatDotenv, EnvFormatSh, EnvFormatDirenv, EnvFormatSystemd} {
+		t.Run(format, func(t *testing.T) {
+			sb := strings.Builder{}
+			require.NoError(t, writeEnv(ctx, &sb, format, "orders", env))
+			lines := strings.Split(strings.TrimSuffix(sb.String(), "\n"), "\n")
+			require.Len(t, lines, 2)
+			assert.Contains(t, lines[0], "PLAIN=")
+			assert.Contains(t, lines[1], "_UNDERSCORE1=")
+			assert.NotContains(t, sb.String(), "pwned")
+		})
+	}
+}
+
+func TestValidateEnvFormat(t *testing.T) {
+	for _, f := range EnvFormats {
+		assert.NoError(t, validateEnvFormat(f))
+	}
+	assert.ErrorContains(t, validateEnvFormat("ini"), `invalid --env-format "ini"`)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
@@ -28,23 +29,35 @@ type Mount struct {
 	Mounts    []string `json:"mounts,omitempty"        yaml:"mounts,omitempty"`
 }
 
+// Port is a service port that is intercepted in addition to the primary port of an intercept.
+type Port struct {
+	TargetPort    int32  `json:"target_port,omitempty"     yaml:"target_port,omitempty"`
+	ServicePortID string `json:"service_port_id,omitempty" yaml:"service_port_id,omitempty"`
+}
+
 type Info struct {
 	ID            string            `json:"id,omitempty"              yaml:"id,omitempty"`
 	Name          string            `json:"name,omitempty"            yaml:"name,omitempty"`
 	Disposition   string            `json:"disposition,omitempty"     yaml:"disposition,omitempty"`
 	Message       string            `json:"message,omitempty"         yaml:"message,omitempty"`
 	WorkloadKind  string            `json:"workload_kind,omitempty"   yaml:"workload_kind,omitempty"`
+	Ingest        bool              `json:"ingest,omitempty"          yaml:"ingest,omitempty"`
+	ContainerName string            `json:"container_name,omitempty"  yaml:"container_name,omitempty"`
 	TargetHost    string            `json:"target_host,omitempty"     yaml:"target_host,omitempty"`
 	TargetPort    int32             `json:"target_port,omitempty"     yaml:"target_port,omitempty"`
 	ServicePortID string            `json:"service_port_id,omitempty" yaml:"service_port_id,omitempty"`
+	Ports         []*Port           `json:"ports,omitempty"           yaml:"ports,omitempty"`
 	Environment   map[string]string `json:"environment,omitempty"     yaml:"environment,omitempty"`
 	Mount         *Mount            `json:"mount,omitempty"           yaml:"mount,omitempty"`
 	FilterDesc    string            `json:"filter_desc,omitempty"     yaml:"filter_desc,omitempty"`
 	Metadata      map[string]string `json:"metadata,omitempty"     yaml:"metadata,omitempty"`
 	HttpFilter    []string          `json:"http_filter,omitempty"     yaml:"http_filter,omitempty"`
 	Global        bool              `json:"global,omitempty"          yaml:"global,omitempty"`
+	Weight        int32             `json:"weight,omitempty"          yaml:"weight,omitempty"`
 	PreviewURL    string            `json:"preview_url,omitempty"     yaml:"preview_url,omitempty"`
 	Ingress       *Ingress          `json:"ingress,omitempty"         yaml:"ingress,omitempty"`
+	ExpiresAt     *time.Time        `json:"expires_at,omitempty"      yaml:"expires_at,omitempty"`
+	IdleTimeout   string            `json:"idle_timeout,omitempty"    yaml:"idle_timeout,omitempty"`
 	debug         bool
 }
 
@@ -100,30 +113,59 @@ func NewMount(ctx context.Context, ii *manager.InterceptInfo, mountError string)
 
 func NewInfo(ctx context.Context, ii *manager.InterceptInfo, mountError string) *Info {
 	spec := ii.Spec
+	var ports []*Port
+	if len(spec.AdditionalPorts) > 0 {
+		ports = make([]*Port, len(spec.AdditionalPorts))
+		for i, ap := range spec.AdditionalPorts {
+			ports[i] = &Port{TargetPort: ap.TargetPort, ServicePortID: ap.ServicePortName}
+			if ports[i].ServicePortID == "" {
+				ports[i].ServicePortID = ap.ServicePortIdentifier
+			}
+		}
+	}
+	var expiresAt *time.Time
+	if ea := ii.ExpiresAt; ea != nil {
+		t := ea.AsTime().Local()
+		expiresAt = &t
+	}
+	var idleTimeout string
+	if spec.IdleTimeout > 0 {
+		idleTimeout = time.Duration(spec.IdleTimeout).String()
+	}
 	return &Info{
 		ID:            ii.Id,
 		Name:          spec.Name,
 		Disposition:   ii.Disposition.String(),
 		Message:       ii.Message,
 		WorkloadKind:  spec.WorkloadKind,
+		Ingest:        spec.Ingest,
+		ContainerName: spec.ContainerName,
 		TargetHost:    spec.TargetHost,
 		TargetPort:    spec.TargetPort,
 		Mount:         NewMount(ctx, ii, mountError),
 		ServicePortID: spec.ServicePortName,
+		Ports:         ports,
 		Environment:   ii.Environment,
 		FilterDesc:    ii.MechanismArgsDesc,
 		Metadata:      ii.Metadata,
 		HttpFilter:    spec.MechanismArgs,
 		Global:        spec.Mechanism == "tcp",
+		Weight:        spec.Weight,
 		PreviewURL:    PreviewURL(ii.PreviewDomain),
 		Ingress:       NewIngress(ii.PreviewSpec),
+		ExpiresAt:     expiresAt,
+		IdleTimeout:   idleTimeout,
 	}
 }
 
 func (ii *Info) WriteTo(w io.Writer) (int64, error) {
 	kvf := ioutil.DefaultKeyValueFormatter()
 	kvf.Prefix = "   "
-	kvf.Add("Intercept name", ii.Name)
+	if ii.Ingest {
+		kvf.Add("Ingest name", ii.Name)
+	} else {
+		kvf.Add("Intercept name", ii.Name)
+	}
 	kvf.Add("State", func() string {
 		msg := ""
 		if manager.InterceptDispositionType_value[ii.Disposition] > int32(manager.InterceptDispositionType_WAITING) {
@@ -136,19 +178,28 @@ func (ii *Info) WriteTo(w io.Writer) (int64, error) {
 		return msg
 	}())
 	kvf.Add("Workload kind", ii.WorkloadKind)
+	if ii.ContainerName != "" {
+		kvf.Add("Container", ii.ContainerName)
+	}
 
 	if ii.debug {
 		kvf.Add("ID", ii.ID)
 	}
 
-	kvf.Add(
-		"Destination",
-		net.JoinHostPort(ii.TargetHost, fmt.Sprintf("%d", ii.TargetPort)),
-	)
+	if !ii.Ingest {
+		kvf.Add(
+			"Destination",
+			net.JoinHostPort(ii.TargetHost, fmt.Sprintf("%d", ii.TargetPort)),
+		)
+	}
 
 	if ii.ServicePortID != "" {
 		kvf.Add("Service Port Identifier", ii.ServicePortID)
 	}
+	for _, p := range ii.Ports {
+		kvf.Add("Additional Port", fmt.Sprintf("%s -> %s",
+			p.ServicePortID, net.JoinHostPort(ii.TargetHost, fmt.Sprintf("%d", p.TargetPort))))
+	}
 	if ii.debug {
 		m := "http"
 		if ii.Global {
@@ -167,20 +218,24 @@ func (ii *Info) WriteTo(w io.Writer) (int64, error) {
 		}
 	}
 
-	kvf.Add("Intercepting", func() string {
-		if ii.FilterDesc != "" {
-			return ii.FilterDesc
-		}
-		if ii.Global {
-			return `using mechanism "tcp"`
+	if !ii.Ingest {
+		kvf.Add("Intercepting", func() string {
+			if ii.FilterDesc != "" {
+				return ii.FilterDesc
+			}
+			if ii.Global {
+				return `using mechanism "tcp"`
+			}
+			return fmt.Sprintf("using mechanism=%q with args=%q", "http", ii.HttpFilter)
+		}())
+		if ii.Weight > 0 && ii.Weight < 100 {
+			kvf.Add("Weight", fmt.Sprintf("%d%%", ii.Weight))
 		}
-		return fmt.Sprintf("using mechanism=%q with args=%q", "http", ii.HttpFilter)
-	}())
+	}
 
 	if ii.PreviewURL != "" {
 		previewURL := ii.PreviewURL
-		// Right now SystemA gives back domains with the leading "https://", but
-		// let's not rely on that.
+		// The traffic-manager omits the scheme when it is "https://".
 		if !strings.HasPrefix(previewURL, "https://") && !strings.HasPrefix(previewURL, "http://") {
 			previewURL = "https://" + previewURL
 		}
@@ -189,5 +244,11 @@ func (ii *Info) WriteTo(w io.Writer) (int64, error) {
 	if in := ii.Ingress; in != nil {
 		kvf.Add("Layer 5 Hostname", in.L5Host)
 	}
+	if ii.ExpiresAt != nil {
+		kvf.Add("Expires", ii.ExpiresAt.Format(time.DateTime))
+	}
+	if ii.IdleTimeout != "" {
+		kvf.Add("Idle timeout", ii.IdleTimeout)
+	}
 	return kvf.WriteTo(w)
 }
@@ -0,0 +1,34 @@
+package intercept
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+// IngestSuffix is appended to the name of the ingested workload to form the name of the ingest.
+const IngestSuffix = "-ingest"
+
+// AddIngestFlags adds the flags of the ingest command. An ingest uses the environment and mounts of a container
+// in the same way as an intercept does, but it never redirects any traffic, so it only needs a subset of the
+// intercept flags.
+func (a *Command) AddIngestFlags(cmd *cobra.Command) {
+	a.Ingest = true
+	flagSet := cmd.Flags()
+	flagSet.StringVarP(&a.ContainerName, "container", "c", "", ``+
+		`Name of the container that provides the environment and mounts. Defaults to the first container `+
+		`of the workload that the traffic-agent is configured for`)
+	a.addHandlerFlags(flagSet)
+}
+
+// ValidateIngest validates the arguments of the ingest command.
+func (a *Command) ValidateIngest(cmd *cobra.Command, positional []string) error {
+	if len(positional) > 1 && cmd.Flags().ArgsLenAtDash() != 1 {
+		return errcat.User.New("commands to be run with ingest must come after options")
+	}
+	a.AgentName = positional[0]
+	a.Name = a.AgentName + IngestSuffix
+	a.Cmdline = positional[1:]
+	a.Mechanism = "tcp"
+	return a.validateHandler(cmd)
+}
@@ -0,0 +1,95 @@
+package intercept
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cache"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
+)
+
+// validatePreview ensures that the --ingress-XXX flags are used together with --preview-url.
+func (a *Command) validatePreview(cmd *cobra.Command) error {
+	flagSet := cmd.Flags()
+	for _, f := range []string{"ingress-host", "ingress-port", "ingress-tls", "ingress-l5"} {
+		if flagSet.Changed(f) {
+			if !a.PreviewURL {
+				return errcat.User.Newf("--%s can only be used together with --preview-url", f)
+			}
+			if a.IngressHost == "" {
+				return errcat.User.Newf("--%s requires --ingress-host", f)
+			}
+		}
+	}
+	return nil
+}
+
+// previewMechanism ensures that an intercept that is created with --preview-url only receives the
+// requests that use its preview URL, unless the --http-XXX or --grpc-XXX flags select other requests.
+func (a *Command) previewMechanism(cmd *cobra.Command) error {
+	if !a.PreviewURL || a.Mechanism != "tcp" {
+		return nil
+	}
+	switch {
+	case cmd.Flags().Changed("mechanism"):
+		return errcat.User.New("--preview-url cannot be used with --mechanism=tcp, because the intercept would receive all traffic")
+	case a.Replace || a.Mirror || a.SourceSelector() != nil:
+		return errcat.User.New("--preview-url cannot be used together with --replace, --mirror, or the --source-XXX flags")
+	}
+	a.Mechanism = "http"
+	a.MechanismArgs = []string{"--" + matcher.PreviewOnlyFlag}
+	return nil
+}
+
+// previewSpec returns the spec of the preview URL for the given intercept. The ingress info is
+// cached per workload, so that it doesn't have to be repeated for each intercept of that workload.
+func (a *Command) previewSpec(ctx context.Context, spec *manager.InterceptSpec) (*manager.PreviewSpec, error) {
+	ingresses, err := cache.LoadIngressesFromUserCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := spec.Agent + "." + spec.Namespace
+	if a.IngressHost != "" {
+		port := int32(a.IngressPort)
+		if port == 0 {
+			if a.IngressTLS {
+				port = 443
+			} else {
+				port = 80
+			}
+		}
+		l5 := a.IngressL5
+		if l5 == "" {
+			l5 = a.IngressHost
+		}
+		ingresses[key] = &manager.IngressInfo{
+			Host:   a.IngressHost,
+			Port:   port,
+			UseTls: a.IngressTLS,
+			L5Host: l5,
+		}
+		if err = cache.SaveIngressesToUserCache(ctx, ingresses); err != nil {
+			// Not fatal. The ingress info will just have to be given again.
+			dlog.Errorf(ctx, "unable to save ingress info in cache: %v", err)
+		}
+	}
+	return &manager.PreviewSpec{Ingress: ingresses[key]}, nil
+}
+
+// addPreviewURL adds a preview URL to the intercept with the given spec and returns the updated
+// intercept info.
+func (s *state) addPreviewURL(ctx context.Context, spec *manager.InterceptSpec) (*manager.InterceptInfo, error) {
+	ps, err := s.previewSpec(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return daemon.GetUserClient(ctx).UpdateIntercept(ctx, &manager.UpdateInterceptRequest{
+		Name:                spec.Name,
+		PreviewDomainAction: &manager.UpdateInterceptRequest_AddPreviewDomain{AddPreviewDomain: ps},
+	})
+}
@@ -0,0 +1,83 @@
+package intercept
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func TestPreviewMechanism(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		mechanism     string
+		mechanismArgs []string
+		wantErr       string
+	}{
+		{
+			name:      "no preview URL",
+			args:      nil,
+			mechanism: "tcp",
+		},
+		{
+			name:          "preview only",
+			args:          []string{"--preview-url"},
+			mechanism:     "http",
+			mechanismArgs: []string{"--http-preview-only"},
+		},
+		{
+			name:          "preview and header",
+			args:          []string{"--preview-url", "--http-header=x-dev=alice"},
+			mechanism:     "http",
+			mechanismArgs: []string{"--http-header=x-dev=alice"},
+		},
+		{
+			name:    "explicit tcp mechanism",
+			args:    []string{"--preview-url", "--mechanism=tcp"},
+			wantErr: "--preview-url cannot be used with --mechanism=tcp, because the intercept would receive all traffic",
+		},
+		{
+			name:    "replace",
+			args:    []string{"--preview-url", "--replace"},
+			wantErr: "--preview-url cannot be used together with --replace, --mirror, or the --source-XXX flags",
+		},
+		{
+			name:    "source",
+			args:    []string{"--preview-url", "--source-namespace=testers"},
+			wantErr: "--preview-url cannot be used together with --replace, --mirror, or the --source-XXX flags",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dlog.NewTestContext(t, false)
+			ctx = client.WithConfig(ctx, client.GetDefaultConfig())
+			a := &Command{}
+			var validateErr error
+			cmd := &cobra.Command{
+				Use: "intercept",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					validateErr = a.Validate(cmd, args)
+					return nil
+				},
+			}
+			a.AddFlags(cmd)
+			cmd.SetArgs(append([]string{"echo"}, tt.args...))
+			require.NoError(t, cmd.ExecuteContext(ctx))
+			if tt.wantErr != "" {
+				require.Error(t, validateErr)
+				assert.Equal(t, tt.wantErr, validateErr.Error())
+				assert.Equal(t, errcat.User, errcat.GetCategory(validateErr))
+				return
+			}
+			require.NoError(t, validateErr)
+			assert.Equal(t, tt.mechanism, a.Mechanism)
+			assert.Equal(t, tt.mechanismArgs, a.MechanismArgs)
+		})
+	}
+}
@@ -0,0 +1,477 @@
+package intercept
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/connect"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/docker"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/scout"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
+)
+
+// SpecSchema is the JSON schema that intercept specification files are validated against.
+//
+//go:embed spec.schema.json
+var SpecSchema []byte
+
+// Spec is the contents of an intercept specification file, i.e. the file that is used with
+// telepresence intercept --file and telepresence leave --file.
+type Spec struct {
+	Intercepts []*SpecIntercept `json:"intercepts"`
+}
+
+// SpecIntercept describes an intercept. Its fields correspond to the flags of the intercept command.
+type SpecIntercept struct {
+	Name           string       `json:"name"`
+	Workload       string       `json:"workload,omitempty"`
+	Pod            string       `json:"pod,omitempty"`
+	Service        string       `json:"service,omitempty"`
+	Port           SpecPorts    `json:"port,omitempty"`
+	AllPorts       bool         `json:"allPorts,omitempty"`
+	Address        string       `json:"address,omitempty"`
+	ToPod          []SpecScalar `json:"toPod,omitempty"`
+	Mechanism      string       `json:"mechanism,omitempty"`
+	MechanismArgs  []string     `json:"mechanismArgs,omitempty"`
+	Replace        bool         `json:"replace,omitempty"`
+	Mirror         bool         `json:"mirror,omitempty"`
+	Weight         int32        `json:"weight,omitempty"`
+	Source         *SpecSource  `json:"source,omitempty"`
+	TTL            string       `json:"ttl,omitempty"`
+	IdleTimeout    string       `json:"idleTimeout,omitempty"`
+	EnvFile        string       `json:"envFile,omitempty"`
+	EnvJSON        string       `json:"envJSON,omitempty"`
+	EnvFormat      string       `json:"envFormat,omitempty"`
+	EnvOverride    SpecEnv      `json:"envOverride,omitempty"`
+	EnvExclude     []string     `json:"envExclude,omitempty"`
+	EnvTemplate    string       `json:"envTemplate,omitempty"`
+	Mount          SpecScalar   `json:"mount,omitempty"`
+	LocalMountPort uint16       `json:"localMountPort,omitempty"`
+	Record         string       `json:"record,omitempty"`
+	PreviewURL     bool         `json:"previewURL,omitempty"`
+	Handler        *SpecHandler `json:"handler,omitempty"`
+}
+
+// SpecEnv is a set of environment variables. The values can be given as strings, numbers, or booleans.
+type SpecEnv map[string]SpecScalar
+
+// SpecSource selects the pods that an intercept receives connections from.
+type SpecSource struct {
+	Labels         map[string]string `json:"labels,omitempty"`
+	Namespace      string            `json:"namespace,omitempty"`
+	ServiceAccount string            `json:"serviceAccount,omitempty"`
+}
+
+// SpecHandler is the process or container that serves an intercept.
+type SpecHandler struct {
+	Command []string    `json:"command,omitempty"`
+	Docker  *SpecDocker `json:"docker,omitempty"`
+}
+
+// SpecDocker is a container that serves an intercept.
+type SpecDocker struct {
+	Image        string   `json:"image,omitempty"`
+	Build        string   `json:"build,omitempty"`
+	Debug        bool     `json:"debug,omitempty"`
+	BuildOptions []string `json:"buildOptions,omitempty"`
+	Mount        string   `json:"mount,omitempty"`
+	Options      []string `json:"options,omitempty"`
+	Args         []string `json:"args,omitempty"`
+}
+
+// SpecScalar is a string that can be given as a string, a number, or a boolean in the specification file.
+type SpecScalar string
+
+func (s *SpecScalar) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case string:
+		*s = SpecScalar(v)
+	case float64:
+		*s = SpecScalar(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		*s = SpecScalar(strconv.FormatBool(v))
+	default:
+		return fmt.Errorf("%s is not a string, number, or boolean", data)
+	}
+	return nil
+}
+
+// SpecPorts is a list of ports. It can be given as a single scalar or as a list of scalars.
+type SpecPorts []SpecScalar
+
+func (s *SpecPorts) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		var ps []SpecScalar
+		if err := json.Unmarshal(data, &ps); err != nil {
+			return err
+		}
+		*s = ps
+		return nil
+	}
+	var p SpecScalar
+	if err := p.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*s = SpecPorts{p}
+	return nil
+}
+
+// LoadSpec reads the intercept specification file with the given path. The file can be in YAML or JSON
+// format, and it's validated against the SpecSchema.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errcat.User.New(err)
+	}
+	return ParseSpec(path, data)
+}
+
+// ParseSpec parses and validates the contents of the intercept specification file with the given path.
+func ParseSpec(path string, data []byte) (*Spec, error) {
+	js, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, errcat.User.Newf("unable to parse %s: %w", path, err)
+	}
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(SpecSchema), gojsonschema.NewBytesLoader(js))
+	if err != nil {
+		return nil, errcat.User.Newf("unable to validate %s: %w", path, err)
+	}
+	if !result.Valid() {
+		msgs := make([]string, len(result.Errors()))
+		for i, re := range result.Errors() {
+			msgs[i] = re.String()
+		}
+		sort.Strings(msgs)
+		return nil, errcat.User.Newf("%s is not a valid intercept specification:\n  %s", path, strings.Join(msgs, "\n  "))
+	}
+	var spec Spec
+	if err = json.Unmarshal(js, &spec); err != nil {
+		return nil, errcat.User.Newf("unable to parse %s: %w", path, err)
+	}
+	names := make(map[string]struct{}, len(spec.Intercepts))
+	for _, si := range spec.Intercepts {
+		if _, dup := names[si.Name]; dup {
+			return nil, errcat.User.Newf("%s: intercept %q is declared more than once", path, si.Name)
+		}
+		names[si.Name] = struct{}{}
+		if err = si.validateMechanismArgs(); err != nil {
+			return nil, errcat.User.Newf("%s: intercept %q: %w", path, si.Name, err)
+		}
+	}
+	return &spec, nil
+}
+
+// validateMechanismArgs ensures that the mechanism args are flags in the form --<flag>=<value> that the
+// intercept command accepts, and that they are valid for the mechanism of the intercept. The mechanism
+// is implied by the flags when it isn't given.
+func (si *SpecIntercept) validateMechanismArgs() error {
+	if len(si.MechanismArgs) == 0 {
+		return nil
+	}
+	mechanism := si.Mechanism
+	for _, arg := range si.MechanismArgs {
+		name, _, ok := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !ok || !strings.HasPrefix(arg, "--") || !slices.Contains(mechanismFlags, name) {
+			return fmt.Errorf("mechanismArgs: %q is not in the form --<flag>=<value>, where the flag is one of --%s",
+				arg, strings.Join(mechanismFlags, ", --"))
+		}
+		if mechanism == "" {
+			mechanism, _, _ = strings.Cut(name, "-")
+		}
+	}
+	if _, err := matcher.NewRequestFromMechanismArgs(mechanism, si.MechanismArgs); err != nil {
+		return fmt.Errorf("mechanismArgs: %w", err)
+	}
+	return nil
+}
+
+// Args returns the arguments of an intercept command that corresponds to this intercept. Relative paths of
+// files and docker-contexts are resolved using the given directory, which is the directory of the spec file.
+func (si *SpecIntercept) Args(dir string) ([]string, error) {
+	var args []string
+	flag := func(name, value string) {
+		args = append(args, "--"+name+"="+value)
+	}
+	path := func(p string) string {
+		if p != "" && !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		return p
+	}
+	if si.Workload != "" {
+		flag("workload", si.Workload)
+	}
+	if si.Pod != "" {
+		flag("pod", si.Pod)
+	}
+	if si.Service != "" {
+		flag("service", si.Service)
+	}
+	for _, p := range si.Port {
+		flag("port", string(p))
+	}
+	if si.AllPorts {
+		flag("all-ports", "true")
+	}
+	if si.Address != "" {
+		flag("address", si.Address)
+	}
+	for _, p := range si.ToPod {
+		flag("to-pod", string(p))
+	}
+	if si.Mechanism != "" {
+		flag("mechanism", si.Mechanism)
+	}
+	// The mechanism args are flags of the intercept command in the form --<flag>=<value>, as ensured by
+	// validateMechanismArgs.
+	args = append(args, si.MechanismArgs...)
+	if si.Replace {
+		flag("replace", "true")
+	}
+	if si.Mirror {
+		flag("mirror", "true")
+	}
+	if si.Weight != 0 {
+		flag("weight", strconv.Itoa(int(si.Weight)))
+	}
+	if src := si.Source; src != nil {
+		ks := make([]string, 0, len(src.Labels))
+		for k := range src.Labels {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+		for _, k := range ks {
+			flag("source-label", k+"="+src.Labels[k])
+		}
+		if src.Namespace != "" {
+			flag("source-namespace", src.Namespace)
+		}
+		if src.ServiceAccount != "" {
+			flag("source-service-account", src.ServiceAccount)
+		}
+	}
+	if si.TTL != "" {
+		flag("ttl", si.TTL)
+	}
+	if si.IdleTimeout != "" {
+		flag("idle-timeout", si.IdleTimeout)
+	}
+	if si.EnvFile != "" {
+		flag("env-file", path(si.EnvFile))
+	}
+	if si.EnvJSON != "" {
+		flag("env-json", path(si.EnvJSON))
+	}
+	if si.EnvFormat != "" {
+		flag("env-format", si.EnvFormat)
+	}
+	ks := make([]string, 0, len(si.EnvOverride))
+	for k := range si.EnvOverride {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	for _, k := range ks {
+		flag("env-override", k+"="+string(si.EnvOverride[k]))
+	}
+	for _, x := range si.EnvExclude {
+		flag("env-exclude", x)
+	}
+	if si.EnvTemplate != "" {
+		flag("env-template", path(si.EnvTemplate))
+	}
+	if si.Mount != "" {
+		m := string(si.Mount)
+		if _, err := strconv.ParseBool(m); err != nil {
+			m = path(m)
+		}
+		flag("mount", m)
+	}
+	if si.LocalMountPort != 0 {
+		flag("local-mount-port", strconv.Itoa(int(si.LocalMountPort)))
+	}
+	if si.Record != "" {
+		flag("record", path(si.Record))
+	}
+	if si.PreviewURL {
+		flag("preview-url", "true")
+	}
+
+	args = append(args, si.Name)
+	if h := si.Handler; h != nil {
+		if d := h.Docker; d != nil {
+			image := d.Image
+			switch {
+			case d.Build != "":
+				build := d.Build
+				if !strings.Contains(build, "://") {
+					build = path(build)
+				}
+				if d.Debug {
+					flag("docker-debug", build)
+				} else {
+					flag("docker-build", build)
+				}
+				for _, opt := range d.BuildOptions {
+					flag("docker-build-opt", opt)
+				}
+				image = "IMAGE"
+			case d.Debug || len(d.BuildOptions) > 0:
+				return nil, errcat.User.Newf("intercept %q: debug and buildOptions can only be used together with build", si.Name)
+			default:
+				flag("docker-run", "true")
+			}
+			if d.Mount != "" {
+				flag("docker-mount", d.Mount)
+			}
+			args = append(args, "--")
+			args = append(args, d.Options...)
+			args = append(args, image)
+			args = append(args, d.Args...)
+		} else {
+			args = append(args, "--")
+			args = append(args, h.Command...)
+		}
+	}
+	return args, nil
+}
+
+// runFile creates the intercepts of the specification file given with --file and runs their handlers. An
+// intercept that has a handler is removed when its handler exits. Other intercepts are retained, and can be
+// removed using telepresence leave --file.
+func (a *Command) runFile(cmd *cobra.Command, positional []string) error {
+	if len(positional) > 0 {
+		return errcat.User.New("--file cannot be used together with intercept names or commands")
+	}
+	var err error
+	cmd.LocalNonPersistentFlags().VisitAll(func(f *pflag.Flag) {
+		if err == nil && f.Changed && f.Name != "file" {
+			err = errcat.User.Newf("--%s cannot be used together with --file", f.Name)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	path, err := filepath.Abs(a.File)
+	if err != nil {
+		return err
+	}
+	spec, err := LoadSpec(path)
+	if err != nil {
+		return err
+	}
+
+	// Each intercept is validated just like an intercept command with the corresponding flags.
+	ctx := cmd.Context()
+	states := make([]*state, len(spec.Intercepts))
+	for i, si := range spec.Intercepts {
+		args, err := si.Args(filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		ic := &Command{}
+		sc := &cobra.Command{Use: si.Name}
+		ic.AddFlags(sc)
+		sc.SetContext(ctx)
+		sc.SetIn(cmd.InOrStdin())
+		sc.SetOut(cmd.OutOrStdout())
+		sc.SetErr(cmd.ErrOrStderr())
+		if err = sc.ParseFlags(args); err != nil {
+			return errcat.User.Newf("intercept %q: %w", si.Name, err)
+		}
+		if err = ic.Validate(sc, sc.Flags().Args()); err != nil {
+			return errcat.User.Newf("intercept %q: %w", si.Name, err)
+		}
+		states[i] = NewState(sc, ic).(*state)
+	}
+
+	if err = connect.InitCommand(cmd); err != nil {
+		return err
+	}
+	ctx = cmd.Context()
+
+	// All intercepts are created before any handler starts. If one of them fails, then the ones that
+	// were created are removed again.
+	var created []*state
+	leave := func(states []*state) error {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		errs := make([]error, len(states))
+		for i, s := range states {
+			errs[i] = s.leave(ctx)
+		}
+		return errors.Join(errs...)
+	}
+	for _, s := range states {
+		sCtx := scout.NewReporter(ctx, "cli")
+		scout.Start(sCtx)
+		if s.DockerRun {
+			err = s.prepareDockerRun(docker.EnableClient(sCtx))
+		}
+		if err == nil {
+			var acquired bool
+			acquired, err = s.create(sCtx)
+			if acquired {
+				created = append(created, s)
+			}
+		}
+		scout.Close(sCtx)
+		if err != nil {
+			if lerr := leave(created); lerr != nil {
+				err = fmt.Errorf("%w\n%v", err, lerr)
+			}
+			return err
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(states))
+	for i, s := range states {
+		if !s.RunAndLeave() {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, s *state) {
+			defer wg.Done()
+			errs[i] = client.WithEnsuredState(ctx, func(context.Context) (bool, error) {
+				return true, nil
+			}, s.runCommand, s.leave)
+		}(i, s)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// LeaveFile removes the intercepts of the given specification file using the given function.
+func LeaveFile(ctx context.Context, path string, leave func(context.Context, string) error) error {
+	spec, err := LoadSpec(path)
+	if err != nil {
+		return err
+	}
+	errs := make([]error, len(spec.Intercepts))
+	for i, si := range spec.Intercepts {
+		errs[i] = leave(ctx, si.Name)
+	}
+	return errors.Join(errs...)
+}
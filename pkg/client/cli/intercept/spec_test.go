@@ -0,0 +1,145 @@
+package intercept
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec("spec.yaml", []byte(`
+intercepts:
+  - name: orders
+    port: 8080
+    mechanism: http
+    mechanismArgs:
+      - --http-header=x-dev=alice
+    ttl: 2h
+    idleTimeout: 30m
+    envFile: orders.env
+    envFormat: dotenv
+    envOverride:
+      DB_PORT: 15432
+    envExclude: [AWS_.*]
+    envTemplate: orders.tpl
+    mount: false
+    handler:
+      command: [go, run, ./cmd/orders]
+  - name: payments
+    workload: payments-v2
+    port: ["8081:grpc", "9090:metrics"]
+    toPod: [8126/UDP]
+    source:
+      labels:
+        app: tester
+    handler:
+      docker:
+        build: ./payments
+        options: [--rm, -it]
+        args: [--verbose]
+`))
+	require.NoError(t, err)
+	require.Len(t, spec.Intercepts, 2)
+
+	args, err := spec.Intercepts[0].Args("/work")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"--port=8080",
+		"--mechanism=http",
+		"--http-header=x-dev=alice",
+		"--ttl=2h",
+		"--idle-timeout=30m",
+		"--env-file=/work/orders.env",
+		"--env-format=dotenv",
+		"--env-override=DB_PORT=15432",
+		"--env-exclude=AWS_.*",
+		"--env-template=/work/orders.tpl",
+		"--mount=false",
+		"orders", "--", "go", "run", "./cmd/orders",
+	}, args)
+
+	args, err = spec.Intercepts[1].Args("/work")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"--workload=payments-v2",
+		"--port=8081:grpc",
+		"--port=9090:metrics",
+		"--to-pod=8126/UDP",
+		"--source-label=app=tester",
+		"payments",
+		"--docker-build=/work/payments",
+		"--", "--rm", "-it", "IMAGE", "--verbose",
+	}, args)
+}
+
+func TestParseSpec_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "no intercepts",
+			data:    `intercepts: []`,
+			wantErr: "intercepts: Array must have at least 1 items",
+		},
+		{
+			name:    "unknown property",
+			data:    "intercepts:\n  - name: orders\n    ports: 8080",
+			wantErr: "Additional property ports is not allowed",
+		},
+		{
+			name:    "bad mechanism arg",
+			data:    "intercepts:\n  - name: orders\n    mechanismArgs: [--replace]",
+			wantErr: "intercepts.0.mechanismArgs.0: Does not match pattern",
+		},
+		{
+			name:    "bad ttl",
+			data:    "intercepts:\n  - name: orders\n    ttl: two hours",
+			wantErr: "intercepts.0.ttl: Does not match pattern",
+		},
+		{
+			name:    "two handlers",
+			data:    "intercepts:\n  - name: orders\n    handler:\n      command: [echo]\n      docker:\n        image: orders",
+			wantErr: "Must validate one and only one schema",
+		},
+		{
+			name:    "unknown mechanism arg",
+			data:    "intercepts:\n  - name: orders\n    mechanismArgs: [--http-method=GET]",
+			wantErr: `intercept "orders": mechanismArgs: "--http-method=GET" is not in the form --<flag>=<value>`,
+		},
+		{
+			name:    "mechanism arg without value",
+			data:    "intercepts:\n  - name: orders\n    mechanismArgs: [--http-path-equal, --http-header=x-dev=alice]",
+			wantErr: `intercept "orders": mechanismArgs: "--http-path-equal" is not in the form --<flag>=<value>`,
+		},
+		{
+			name:    "mechanism arg of other mechanism",
+			data:    "intercepts:\n  - name: orders\n    mechanism: grpc\n    mechanismArgs: [--http-header=x-dev=alice]",
+			wantErr: `intercept "orders": mechanismArgs: unknown flag: --http-header`,
+		},
+		{
+			name:    "mechanism args with tcp",
+			data:    "intercepts:\n  - name: orders\n    mechanism: tcp\n    mechanismArgs: [--http-header=x-dev=alice]",
+			wantErr: `intercept "orders": mechanismArgs: mechanism "tcp" does not match requests`,
+		},
+		{
+			name:    "invalid mechanism arg value",
+			data:    "intercepts:\n  - name: orders\n    mechanismArgs: [--http-header=x-dev]",
+			wantErr: `intercept "orders": mechanismArgs: --http-header "x-dev" is not in the form <name>=<value>`,
+		},
+		{
+			name:    "duplicate",
+			data:    "intercepts:\n  - name: orders\n  - name: orders",
+			wantErr: `intercept "orders" is declared more than once`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSpec("spec.yaml", []byte(tt.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
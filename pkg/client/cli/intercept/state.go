@@ -1,14 +1,13 @@
 package intercept
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
-	"sort"
 	"strconv"
 	"strings"
 
@@ -51,6 +50,7 @@ type state struct {
 	mountPoint    string // if non-empty, this the final mount point of a successful mount
 	localPort     uint16 // the parsed <local port>
 	dockerPort    uint16
+	extraPorts    []portMapping // the local and docker ports of the additional ports
 	status        *connector.ConnectInfo
 	info          *Info // Info from the created intercept
 
@@ -82,6 +82,12 @@ func (s *state) CreateRequest(ctx context.Context) (*connector.CreateInterceptRe
 	spec := &manager.InterceptSpec{
 		Name:    s.Name(),
 		Replace: s.Replace,
+		Mirror:  s.Mirror,
+		Weight:  s.Weight,
+		Source:  s.SourceSelector(),
+
+		Ttl:         int64(s.TTL),
+		IdleTimeout: int64(s.IdleTimeout),
 	}
 	ir := &connector.CreateInterceptRequest{
 		Spec:         spec,
@@ -101,21 +107,38 @@ func (s *state) CreateRequest(ctx context.Context) (*connector.CreateInterceptRe
 	spec.Mechanism = s.Mechanism
 	spec.MechanismArgs = s.MechanismArgs
 	spec.Agent = s.AgentName
+	if s.Pod != "" {
+		spec.WorkloadKind = "Pod"
+	}
 	spec.TargetHost = "127.0.0.1"
 
 	ud := daemon.GetUserClient(ctx)
 
-	// Parse port into spec based on how it's formatted
 	var err error
-	s.localPort, s.dockerPort, spec.ServicePortIdentifier, err = parsePort(s.Port, s.DockerRun, ud.Containerized())
-	if err != nil {
-		return nil, err
-	}
-	spec.TargetPort = int32(s.localPort)
-	if iputil.Parse(s.Address) == nil {
-		return nil, fmt.Errorf("--address %s is not a valid IP address", s.Address)
+	if s.Ingest {
+		// An ingest redirects no traffic, so there's no port to parse.
+		spec.Ingest = true
+		spec.ContainerName = s.ContainerName
+	} else {
+		if s.AllPorts {
+			// The target ports are the service ports, which aren't known until the intercept is prepared.
+			spec.AllPorts = true
+		} else {
+			// Parse port into spec based on how it's formatted
+			s.localPort, s.dockerPort, spec.ServicePortIdentifier, err = parsePort(s.Port, s.DockerRun, ud.Containerized())
+			if err != nil {
+				return nil, err
+			}
+			spec.TargetPort = int32(s.localPort)
+			if spec.AdditionalPorts, err = s.parseAdditionalPorts(spec.ServicePortIdentifier, ud.Containerized()); err != nil {
+				return nil, err
+			}
+		}
+		if iputil.Parse(s.Address) == nil {
+			return nil, fmt.Errorf("--address %s is not a valid IP address", s.Address)
+		}
+		spec.TargetHost = s.Address
 	}
-	spec.TargetHost = s.Address
 
 	mountEnabled, mountPoint := s.GetMountPoint()
 	if !mountEnabled {
@@ -156,6 +179,15 @@ func (s *state) CreateRequest(ctx context.Context) (*connector.CreateInterceptRe
 		}
 	}
 
+	if s.Record != "" {
+		if ud.Containerized() {
+			return nil, errcat.User.New("--record cannot be used with a daemon that runs in a container")
+		}
+		if ir.RecordFile, err = filepath.Abs(s.Record); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, toPod := range s.ToPod {
 		pp, err := agentconfig.NewPortAndProto(toPod)
 		if err != nil {
@@ -179,6 +211,35 @@ func (s *state) CreateRequest(ctx context.Context) (*connector.CreateInterceptRe
 	return ir, nil
 }
 
+type portMapping struct {
+	local  uint16
+	docker uint16
+}
+
+// parseAdditionalPorts parses all but the first --port flag. Each port must identify a service port when
+// --port is repeated.
+func (s *state) parseAdditionalPorts(primarySpi string, remote bool) ([]*manager.InterceptPort, error) {
+	if len(s.Ports) < 2 {
+		return nil, nil
+	}
+	if primarySpi == "" {
+		return nil, errcat.User.Newf("--port %s must include a service port identifier when --port is repeated", s.Port)
+	}
+	aps := make([]*manager.InterceptPort, len(s.Ports)-1)
+	for i, p := range s.Ports[1:] {
+		local, docker, spi, err := parsePort(p, s.DockerRun, remote)
+		if err != nil {
+			return nil, err
+		}
+		if spi == "" {
+			return nil, errcat.User.Newf("--port %s must include a service port identifier when --port is repeated", p)
+		}
+		aps[i] = &manager.InterceptPort{ServicePortIdentifier: spi, TargetPort: int32(local)}
+		s.extraPorts = append(s.extraPorts, portMapping{local: local, docker: docker})
+	}
+	return aps, nil
+}
+
 func (s *state) Name() string {
 	return s.Command.Name
 }
@@ -271,14 +332,35 @@ func (s *state) create(ctx context.Context) (acquired bool, err error) {
 	intercept = r.InterceptInfo
 	scout.SetMetadatum(ctx, "intercept_id", intercept.Id)
 
+	if s.AllPorts && s.DockerRun && !ud.Containerized() {
+		// The ports are known now, and each one is published using the same port number in the container.
+		s.localPort = uint16(intercept.Spec.TargetPort)
+		s.dockerPort = s.localPort
+		for _, ap := range intercept.Spec.AdditionalPorts {
+			p := uint16(ap.TargetPort)
+			s.extraPorts = append(s.extraPorts, portMapping{local: p, docker: p})
+		}
+	}
+
+	if s.PreviewURL {
+		if intercept, err = s.addPreviewURL(ctx, intercept.Spec); err != nil {
+			return true, fmt.Errorf("unable to create preview URL: %w", err)
+		}
+	}
+
 	s.env = intercept.Environment
 	if s.env == nil {
 		s.env = make(map[string]string)
 	}
+	if s.envEditor != nil {
+		if err = s.envEditor.edit(s.env); err != nil {
+			return true, err
+		}
+	}
 	s.env["TELEPRESENCE_INTERCEPT_ID"] = intercept.Id
 	s.env["TELEPRESENCE_ROOT"] = intercept.ClientMountPoint
 	if s.EnvFile != "" {
-		if err = s.writeEnvFile(); err != nil {
+		if err = s.writeEnvFile(ctx); err != nil {
 			return true, err
 		}
 	}
@@ -345,14 +427,15 @@ func (s *state) runCommand(ctx context.Context) error {
 	}
 
 	envFile := s.EnvFile
-	if envFile == "" {
+	if envFile == "" || s.EnvFormat != EnvFormatCompose {
+		// Docker requires an env-file in Docker Compose format.
 		file, err := os.CreateTemp("", "tel-*.env")
 		if err != nil {
 			return fmt.Errorf("failed to create temporary environment file. %w", err)
 		}
 		defer os.Remove(file.Name())
 
-		if err = s.writeEnvToFileAndClose(file); err != nil {
+		if err = s.writeEnvToFileAndClose(ctx, file, EnvFormatCompose); err != nil {
 			return err
 		}
 		envFile = file.Name()
@@ -403,41 +486,17 @@ func (s *state) checkMountCapability(ctx context.Context) error {
 	return errcat.FromResult(r)
 }
 
-func (s *state) writeEnvFile() error {
+func (s *state) writeEnvFile(ctx context.Context) error {
 	file, err := os.Create(s.EnvFile)
 	if err != nil {
 		return errcat.NoDaemonLogs.Newf("failed to create environment file %q: %w", s.EnvFile, err)
 	}
-	return s.writeEnvToFileAndClose(file)
+	return s.writeEnvToFileAndClose(ctx, file, s.EnvFormat)
 }
 
-func (s *state) writeEnvToFileAndClose(file *os.File) (err error) {
+func (s *state) writeEnvToFileAndClose(ctx context.Context, file *os.File, format string) (err error) {
 	defer file.Close()
-	w := bufio.NewWriter(file)
-
-	keys := make([]string, len(s.env))
-	i := 0
-	for k := range s.env {
-		keys[i] = k
-		i++
-	}
-	sort.Strings(keys)
-
-	for _, k := range keys {
-		if _, err = w.WriteString(k); err != nil {
-			return err
-		}
-		if err = w.WriteByte('='); err != nil {
-			return err
-		}
-		if _, err = w.WriteString(s.env[k]); err != nil {
-			return err
-		}
-		if err = w.WriteByte('\n'); err != nil {
-			return err
-		}
-	}
-	return w.Flush()
+	return writeEnv(ctx, file, format, s.Name(), s.env)
 }
 
 func (s *state) writeEnvJSON() error {
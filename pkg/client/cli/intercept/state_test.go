@@ -0,0 +1,108 @@
+package intercept
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func TestParseAdditionalPorts(t *testing.T) {
+	tests := []struct {
+		name       string
+		ports      []string
+		primarySpi string
+		dockerRun  bool
+		remote     bool
+		expected   []*manager.InterceptPort
+		extraPorts []portMapping
+		wantErr    string
+	}{
+		{
+			name:       "single port",
+			ports:      []string{"8080"},
+			primarySpi: "",
+		},
+		{
+			name:       "additional ports",
+			ports:      []string{"8080:http", "9090:grpc", "5353:53"},
+			primarySpi: "http",
+			expected: []*manager.InterceptPort{
+				{ServicePortIdentifier: "grpc", TargetPort: 9090},
+				{ServicePortIdentifier: "53", TargetPort: 5353},
+			},
+			extraPorts: []portMapping{{local: 9090}, {local: 5353}},
+		},
+		{
+			name:       "additional ports with docker run",
+			ports:      []string{"8080:80:http", "9090:90:grpc"},
+			primarySpi: "http",
+			dockerRun:  true,
+			expected:   []*manager.InterceptPort{{ServicePortIdentifier: "grpc", TargetPort: 9090}},
+			extraPorts: []portMapping{{local: 9090, docker: 90}},
+		},
+		{
+			name:       "primary port without service port identifier",
+			ports:      []string{"8080", "9090:grpc"},
+			primarySpi: "",
+			wantErr:    "--port 8080 must include a service port identifier when --port is repeated",
+		},
+		{
+			name:       "additional port without service port identifier",
+			ports:      []string{"8080:http", "9090"},
+			primarySpi: "http",
+			wantErr:    "--port 9090 must include a service port identifier when --port is repeated",
+		},
+		{
+			name:       "additional docker port without service port identifier",
+			ports:      []string{"8080:80:http", "9090:90"},
+			primarySpi: "http",
+			dockerRun:  true,
+			wantErr:    "--port 9090:90 must include a service port identifier when --port is repeated",
+		},
+		{
+			name:       "invalid local port",
+			ports:      []string{"8080:http", "x:grpc"},
+			primarySpi: "http",
+			wantErr:    "port must be of the format --port <local-port>[:<svcPortIdentifier>]",
+		},
+		{
+			name:       "invalid service port identifier",
+			ports:      []string{"8080:http", "9090:-grpc-"},
+			primarySpi: "http",
+			wantErr:    "port must be of the format --port <local-port>[:<svcPortIdentifier>]",
+		},
+		{
+			name:       "too many colons",
+			ports:      []string{"8080:http", "9090:90:grpc"},
+			primarySpi: "http",
+			wantErr:    "port must be of the format --port <local-port>[:<svcPortIdentifier>]",
+		},
+		{
+			name:       "docker port mapping when the daemon runs in a container",
+			ports:      []string{"8080:http", "9090:90:grpc"},
+			primarySpi: "http",
+			dockerRun:  true,
+			remote:     true,
+			wantErr:    "the format --port <local-port>:<container-port>:<svcPortIdentifier> cannot be used when the daemon runs in a container",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &state{Command: &Command{Port: tt.ports[0], Ports: tt.ports, DockerRun: tt.dockerRun}}
+			aps, err := s.parseAdditionalPorts(tt.primarySpi, tt.remote)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				assert.Equal(t, errcat.User, errcat.GetCategory(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, aps)
+			assert.Equal(t, tt.extraPorts, s.extraPorts)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package intercept
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/output"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
+)
+
+// updateFlags are the flags of the intercept command that can be used together with --update. Global
+// flags can always be used.
+var updateFlags = []string{ //nolint:gochecknoglobals // this is a constant
+	"update",
+	"namespace",
+	"port",
+	"to-pod",
+	"mechanism",
+	"detailed-output",
+	matcher.HeaderFlag,
+	matcher.PathEqualFlag,
+	matcher.PathPrefixFlag,
+	matcher.PathRegexFlag,
+	matcher.GRPCMethodFlag,
+	matcher.GRPCMetadataFlag,
+	"preview-url",
+	"ingress-host",
+	"ingress-port",
+	"ingress-tls",
+	"ingress-l5",
+}
+
+// validateUpdate validates the flags of an intercept command that uses --update.
+func (a *Command) validateUpdate(cmd *cobra.Command) error {
+	if len(a.Cmdline) > 0 {
+		return errcat.User.New("commands cannot be run when an intercept is updated")
+	}
+	var err error
+	// The flag set returned by LocalNonPersistentFlags is a copy that doesn't track what was set, so
+	// Visit cannot be used here.
+	cmd.LocalNonPersistentFlags().VisitAll(func(f *pflag.Flag) {
+		if err == nil && f.Changed && !slices.Contains(updateFlags, f.Name) {
+			err = errcat.User.Newf("--%s cannot be used together with --update", f.Name)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if len(a.Ports) > 1 {
+		return errcat.User.New("--port can only be given once when used together with --update")
+	}
+	if a.Port != "" {
+		if _, err = agentconfig.ParseNumericPort(a.Port); err != nil {
+			return errcat.User.New("port must be of the format --port <local-port> when used together with --update")
+		}
+	}
+	for _, toPod := range a.ToPod {
+		if _, err = agentconfig.NewPortAndProto(toPod); err != nil {
+			return errcat.User.New(err)
+		}
+	}
+	return a.validateMechanism(cmd)
+}
+
+// update updates an existing intercept using the flags that were given together with --update.
+func (a *Command) update(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	ud := daemon.GetUserClient(ctx)
+	ii, err := ud.GetIntercept(ctx, &manager.GetInterceptRequest{Name: a.Name})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return errcat.User.Newf("Intercept named %q not found", a.Name)
+		}
+		return err
+	}
+
+	ur, err := a.updateRequest(cmd, ii.Spec)
+	if err != nil {
+		return err
+	}
+	if ii, err = ud.UpdateIntercept(ctx, ur); err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition:
+				return errcat.User.New(st.Message())
+			}
+		}
+		return err
+	}
+
+	info := NewInfo(ctx, ii, "")
+	if a.DetailedOutput && output.WantsFormatted(cmd) {
+		output.Object(ctx, info, true)
+	} else {
+		out := cmd.OutOrStdout()
+		_, _ = info.WriteTo(out)
+		_, _ = fmt.Fprintln(out)
+	}
+	return nil
+}
+
+// updateRequest creates the request that updates the intercept with the given spec.
+func (a *Command) updateRequest(cmd *cobra.Command, spec *manager.InterceptSpec) (*manager.UpdateInterceptRequest, error) {
+	flagSet := cmd.Flags()
+	ur := &manager.UpdateInterceptRequest{Name: a.Name}
+	changed := false
+	if flagSet.Changed("port") {
+		port, _ := agentconfig.ParseNumericPort(a.Port)
+		ur.TargetPort = int32(port)
+		changed = true
+	}
+
+	if flagSet.Changed("to-pod") {
+		// The given ports replace the current ones.
+		current := spec.LocalPorts
+		if len(current) == 0 {
+			// Older versions use extraPorts (TCP only)
+			for _, ep := range spec.ExtraPorts {
+				current = append(current, strconv.Itoa(int(ep)))
+			}
+		}
+		wanted := make([]string, len(a.ToPod))
+		for i, toPod := range a.ToPod {
+			pp, _ := agentconfig.NewPortAndProto(toPod)
+			wanted[i] = pp.String()
+		}
+		for _, port := range current {
+			if pp, err := agentconfig.NewPortAndProto(port); err == nil && !slices.Contains(wanted, pp.String()) {
+				ur.RemoveLocalPorts = append(ur.RemoveLocalPorts, pp.String())
+			}
+		}
+		for _, port := range wanted {
+			if !slices.Contains(current, port) {
+				ur.AddLocalPorts = append(ur.AddLocalPorts, port)
+			}
+		}
+		changed = true
+	}
+
+	if len(a.MechanismArgs) > 0 || flagSet.Changed("mechanism") {
+		if a.Mechanism != spec.Mechanism {
+			return nil, errcat.User.Newf("the mechanism of intercept %s is %q and cannot be changed to %q", a.Name, spec.Mechanism, a.Mechanism)
+		}
+		ur.MechanismArgs = &manager.MechanismArgs{Args: a.MechanismArgs}
+		changed = true
+	}
+
+	if flagSet.Changed("preview-url") {
+		if a.PreviewURL {
+			ps, err := a.previewSpec(cmd.Context(), spec)
+			if err != nil {
+				return nil, err
+			}
+			ur.PreviewDomainAction = &manager.UpdateInterceptRequest_AddPreviewDomain{AddPreviewDomain: ps}
+		} else {
+			ur.PreviewDomainAction = &manager.UpdateInterceptRequest_RemovePreviewDomain{RemovePreviewDomain: true}
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil, errcat.User.New("--update requires at least one of --port, --to-pod, --mechanism, --preview-url, or the --http-XXX flags")
+	}
+	return ur, nil
+}
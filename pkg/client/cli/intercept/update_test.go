@@ -0,0 +1,78 @@
+package intercept
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/global"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func TestValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name: "port",
+			args: []string{"--update", "--port", "8081"},
+		},
+		{
+			name: "global flags",
+			args: []string{"--update", "--port", "8081", "--output", "json", "--no-report"},
+		},
+		{
+			name: "namespace",
+			args: []string{"--update", "--port", "8081", "--namespace", "default"},
+		},
+		{
+			name:    "flag that cannot be updated",
+			args:    []string{"--update", "--port", "8081", "--mount=false"},
+			wantErr: "--mount cannot be used together with --update",
+		},
+		{
+			name:    "repeated port",
+			args:    []string{"--update", "--port", "8081", "--port", "8082"},
+			wantErr: "--port can only be given once when used together with --update",
+		},
+		{
+			name:    "port with service port identifier",
+			args:    []string{"--update", "--port", "8081:http"},
+			wantErr: "port must be of the format --port <local-port> when used together with --update",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Command{}
+			var validateErr error
+			cmd := &cobra.Command{
+				Use: "intercept",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					a.Name = args[0]
+					if len(a.Ports) > 0 {
+						a.Port = a.Ports[0]
+					}
+					validateErr = a.validateUpdate(cmd)
+					return nil
+				},
+			}
+			a.AddFlags(cmd)
+			root := &cobra.Command{Use: "telepresence"}
+			root.PersistentFlags().AddFlagSet(global.Flags(false))
+			root.AddCommand(cmd)
+			root.SetArgs(append([]string{"intercept", "echo"}, tt.args...))
+			require.NoError(t, root.Execute())
+			if tt.wantErr == "" {
+				assert.NoError(t, validateErr)
+				return
+			}
+			require.Error(t, validateErr)
+			assert.Equal(t, tt.wantErr, validateErr.Error())
+			assert.Equal(t, errcat.User, errcat.GetCategory(validateErr))
+		})
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
 	"github.com/telepresenceio/telepresence/v2/pkg/filelocation"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 const ConfigFile = "config.yml"
@@ -36,6 +38,7 @@ type Config interface {
 	LogLevels() *LogLevels
 	Images() *Images
 	Grpc() *Grpc
+	Tunnel() *Tunnel
 	TelepresenceAPI() *TelepresenceAPI
 	Intercept() *Intercept
 	Cluster() *Cluster
@@ -49,6 +52,7 @@ type BaseConfig struct {
 	LogLevelsV       LogLevels       `json:"logLevels,omitempty" yaml:"logLevels,omitempty"`
 	ImagesV          Images          `json:"images,omitempty" yaml:"images,omitempty"`
 	GrpcV            Grpc            `json:"grpc,omitempty" yaml:"grpc,omitempty"`
+	TunnelV          Tunnel          `json:"tunnel,omitempty" yaml:"tunnel,omitempty"`
 	TelepresenceAPIV TelepresenceAPI `json:"telepresenceAPI,omitempty" yaml:"telepresenceAPI,omitempty"`
 	InterceptV       Intercept       `json:"intercept,omitempty" yaml:"intercept,omitempty"`
 	ClusterV         Cluster         `json:"cluster,omitempty" yaml:"cluster,omitempty"`
@@ -78,6 +82,10 @@ func (c *BaseConfig) Grpc() *Grpc {
 	return &c.GrpcV
 }
 
+func (c *BaseConfig) Tunnel() *Tunnel {
+	return &c.TunnelV
+}
+
 func (c *BaseConfig) TelepresenceAPI() *TelepresenceAPI {
 	return &c.TelepresenceAPIV
 }
@@ -105,6 +113,7 @@ func (c *BaseConfig) Merge(lc Config) {
 	c.LogLevelsV.merge(lc.LogLevels())
 	c.ImagesV.merge(lc.Images())
 	c.GrpcV.merge(lc.Grpc())
+	c.TunnelV.merge(lc.Tunnel())
 	c.TelepresenceAPIV.merge(lc.TelepresenceAPI())
 	c.InterceptV.merge(lc.Intercept())
 	c.ClusterV.merge(lc.Cluster())
@@ -750,6 +759,23 @@ func (g Grpc) MarshalYAML() (any, error) {
 	return nil, nil
 }
 
+type Tunnel struct {
+	// Compression is the compression that the tunnels to the cluster propose for the payload of connections.
+	// It is used when the traffic-manager or traffic-agent at the other end supports it.
+	Compression tunnel.Compression `json:"compression,omitempty" yaml:"compression,omitempty"`
+}
+
+func (t *Tunnel) merge(o *Tunnel) {
+	if o.Compression != tunnel.CompressionNone {
+		t.Compression = o.Compression
+	}
+}
+
+// IsZero controls whether this element will be included in marshalled output.
+func (t Tunnel) IsZero() bool {
+	return t.Compression == tunnel.CompressionNone
+}
+
 type TelepresenceAPI struct {
 	Port int `json:"port,omitempty" yaml:"port,omitempty"`
 }
@@ -830,22 +856,28 @@ func (ic Intercept) MarshalYAML() (any, error) {
 }
 
 type Cluster struct {
-	DefaultManagerNamespace string   `json:"defaultManagerNamespace,omitempty" yaml:"defaultManagerNamespace,omitempty"`
-	MappedNamespaces        []string `json:"mappedNamespaces,omitempty" yaml:"mappedNamespaces,omitempty"`
-	ConnectFromRootDaemon   bool     `json:"connectFromRootDaemon,omitempty" yaml:"connectFromRootDaemon,omitempty"`
-	AgentPortForward        bool     `json:"agentPortForward,omitempty" yaml:"agentPortForward,omitempty"`
-	VirtualIPSubnet         string   `json:"virtualIPSubnet,omitempty" yaml:"virtualIPSubnet,omitempty"`
+	DefaultManagerNamespace string                `json:"defaultManagerNamespace,omitempty" yaml:"defaultManagerNamespace,omitempty"`
+	MappedNamespaces        []string              `json:"mappedNamespaces,omitempty" yaml:"mappedNamespaces,omitempty"`
+	ConnectFromRootDaemon   bool                  `json:"connectFromRootDaemon,omitempty" yaml:"connectFromRootDaemon,omitempty"`
+	AgentPortForward        bool                  `json:"agentPortForward,omitempty" yaml:"agentPortForward,omitempty"`
+	VirtualIPSubnet         string                `json:"virtualIPSubnet,omitempty" yaml:"virtualIPSubnet,omitempty"`
+	VirtualIPv6Subnet       string                `json:"virtualIPv6Subnet,omitempty" yaml:"virtualIPv6Subnet,omitempty"`
+	CustomWorkloadKinds     []workload.CustomKind `json:"customWorkloadKinds,omitempty" yaml:"customWorkloadKinds,omitempty"`
 }
 
 // This is used by a different config -- the k8s_config, which needs to be able to tell if it's overridden at a cluster or environment variable level.
 // Hence, we don't default to "ambassador" but to empty, so that it can check that no default has been given.
 const defaultDefaultManagerNamespace = ""
 
+// defaultVirtualIPv6Subnet is a unique local address subnet used for virtual IPs that replace IPv6 addresses.
+const defaultVirtualIPv6Subnet = "fd74:656c:6570::/64"
+
 var defaultCluster = Cluster{ //nolint:gochecknoglobals // constant
 	DefaultManagerNamespace: defaultDefaultManagerNamespace,
 	ConnectFromRootDaemon:   true,
 	AgentPortForward:        true,
 	VirtualIPSubnet:         defaultVirtualIPSubnet,
+	VirtualIPv6Subnet:       defaultVirtualIPv6Subnet,
 }
 
 func (cc *Cluster) merge(o *Cluster) {
@@ -864,6 +896,12 @@ func (cc *Cluster) merge(o *Cluster) {
 	if o.VirtualIPSubnet != defaultVirtualIPSubnet {
 		cc.VirtualIPSubnet = o.VirtualIPSubnet
 	}
+	if o.VirtualIPv6Subnet != defaultVirtualIPv6Subnet {
+		cc.VirtualIPv6Subnet = o.VirtualIPv6Subnet
+	}
+	if len(o.CustomWorkloadKinds) > 0 {
+		cc.CustomWorkloadKinds = o.CustomWorkloadKinds
+	}
 }
 
 // IsZero controls whether this element will be included in marshalled output.
@@ -872,7 +910,9 @@ func (cc Cluster) IsZero() bool {
 		len(cc.MappedNamespaces) == 0 &&
 		cc.ConnectFromRootDaemon &&
 		cc.AgentPortForward &&
-		cc.VirtualIPSubnet == defaultVirtualIPSubnet
+		cc.VirtualIPSubnet == defaultVirtualIPSubnet &&
+		cc.VirtualIPv6Subnet == defaultVirtualIPv6Subnet &&
+		len(cc.CustomWorkloadKinds) == 0
 }
 
 // MarshalYAML is not using pointer receiver here, because Cluster is not pointer in the Config struct.
@@ -893,6 +933,12 @@ func (cc Cluster) MarshalYAML() (any, error) {
 	if cc.VirtualIPSubnet != defaultVirtualIPSubnet {
 		cm["virtualIPSubnet"] = cc.VirtualIPSubnet
 	}
+	if cc.VirtualIPv6Subnet != defaultVirtualIPv6Subnet {
+		cm["virtualIPv6Subnet"] = cc.VirtualIPv6Subnet
+	}
+	if len(cc.CustomWorkloadKinds) > 0 {
+		cm["customWorkloadKinds"] = cc.CustomWorkloadKinds
+	}
 	return cm, nil
 }
 
@@ -959,6 +1005,7 @@ func GetDefaultBaseConfig() BaseConfig {
 		LogLevelsV:       defaultLogLevels,
 		ImagesV:          defaultImages,
 		GrpcV:            Grpc{},
+		TunnelV:          Tunnel{},
 		TelepresenceAPIV: TelepresenceAPI{},
 		InterceptV:       defaultIntercept,
 		ClusterV:         defaultCluster,
@@ -1033,26 +1080,28 @@ type RoutingSnake struct {
 }
 
 type DNS struct {
-	Error           string        `json:"error,omitempty" yaml:"error,omitempty"`
-	LocalIP         net.IP        `json:"localIP,omitempty" yaml:"localIP,omitempty"`
-	RemoteIP        net.IP        `json:"remoteIP,omitempty" yaml:"remoteIP,omitempty"`
-	IncludeSuffixes []string      `json:"includeSuffixes,omitempty" yaml:"includeSuffixes,omitempty"`
-	ExcludeSuffixes []string      `json:"excludeSuffixes,omitempty" yaml:"excludeSuffixes,omitempty"`
-	Excludes        []string      `json:"excludes,omitempty" yaml:"excludes,omitempty"`
-	Mappings        DNSMappings   `json:"mappings,omitempty" yaml:"mappings,omitempty"`
-	LookupTimeout   time.Duration `json:"lookupTimeout,omitempty" yaml:"lookupTimeout,omitempty"`
+	Error           string          `json:"error,omitempty" yaml:"error,omitempty"`
+	LocalIP         net.IP          `json:"localIP,omitempty" yaml:"localIP,omitempty"`
+	RemoteIP        net.IP          `json:"remoteIP,omitempty" yaml:"remoteIP,omitempty"`
+	IncludeSuffixes []string        `json:"includeSuffixes,omitempty" yaml:"includeSuffixes,omitempty"`
+	ExcludeSuffixes []string        `json:"excludeSuffixes,omitempty" yaml:"excludeSuffixes,omitempty"`
+	Excludes        []string        `json:"excludes,omitempty" yaml:"excludes,omitempty"`
+	Mappings        DNSMappings     `json:"mappings,omitempty" yaml:"mappings,omitempty"`
+	ForwardRules    DNSForwardRules `json:"forwardRules,omitempty" yaml:"forwardRules,omitempty"`
+	LookupTimeout   time.Duration   `json:"lookupTimeout,omitempty" yaml:"lookupTimeout,omitempty"`
 }
 
 // DNSSnake is the same as DNS but with snake_case json/yaml names.
 type DNSSnake struct {
-	Error           string        `json:"error,omitempty" yaml:"error,omitempty"`
-	LocalIP         net.IP        `json:"local_ip,omitempty" yaml:"local_ip,omitempty"`
-	RemoteIP        net.IP        `json:"remote_ip,omitempty" yaml:"remote_ip,omitempty"`
-	IncludeSuffixes []string      `json:"include_suffixes,omitempty" yaml:"include_suffixes,omitempty"`
-	ExcludeSuffixes []string      `json:"exclude_suffixes,omitempty" yaml:"exclude_suffixes,omitempty"`
-	Excludes        []string      `json:"excludes,omitempty" yaml:"excludes,omitempty"`
-	Mappings        DNSMappings   `json:"mappings,omitempty" yaml:"mappings,omitempty"`
-	LookupTimeout   time.Duration `json:"lookup_timeout,omitempty" yaml:"lookup_timeout,omitempty"`
+	Error           string          `json:"error,omitempty" yaml:"error,omitempty"`
+	LocalIP         net.IP          `json:"local_ip,omitempty" yaml:"local_ip,omitempty"`
+	RemoteIP        net.IP          `json:"remote_ip,omitempty" yaml:"remote_ip,omitempty"`
+	IncludeSuffixes []string        `json:"include_suffixes,omitempty" yaml:"include_suffixes,omitempty"`
+	ExcludeSuffixes []string        `json:"exclude_suffixes,omitempty" yaml:"exclude_suffixes,omitempty"`
+	Excludes        []string        `json:"excludes,omitempty" yaml:"excludes,omitempty"`
+	Mappings        DNSMappings     `json:"mappings,omitempty" yaml:"mappings,omitempty"`
+	ForwardRules    DNSForwardRules `json:"forward_rules,omitempty" yaml:"forward_rules,omitempty"`
+	LookupTimeout   time.Duration   `json:"lookup_timeout,omitempty" yaml:"lookup_timeout,omitempty"`
 }
 
 type SessionConfig struct {
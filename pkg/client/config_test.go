@@ -15,6 +15,7 @@ import (
 	"github.com/datawire/dlib/dlog"
 	"github.com/datawire/k8sapi/pkg/k8sapi"
 	"github.com/telepresenceio/telepresence/v2/pkg/filelocation"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
 )
 
 func TestGetConfig(t *testing.T) {
@@ -103,10 +104,12 @@ func Test_ConfigMarshalYAML(t *testing.T) {
 	cfg.Timeouts().PrivateTrafficManagerAPI = defaultTimeoutsTrafficManagerAPI + 20*time.Second
 	cfg.LogLevels().UserDaemon = logrus.TraceLevel
 	cfg.Grpc().MaxReceiveSizeV, _ = resource.ParseQuantity("20Mi")
+	cfg.Tunnel().Compression = tunnel.CompressionZstd
 	cfg.TelepresenceAPI().Port = 4567
 	cfg.Intercept().AppProtocolStrategy = k8sapi.PortName
 	cfg.Intercept().DefaultPort = 9080
 	cfg.Cluster().DefaultManagerNamespace = "hello-there"
+	cfg.Cluster().VirtualIPv6Subnet = "fd00:abcd::/64"
 	cfgBytes, err := yaml.Marshal(cfg)
 	require.NoError(t, err)
 
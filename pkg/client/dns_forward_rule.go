@@ -0,0 +1,179 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gopkg.in/yaml.v3"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+const (
+	// DNSForwardLocal is the DNSForwardRule server that forwards queries to the local resolver.
+	DNSForwardLocal = "local"
+
+	// DNSForwardCluster is the DNSForwardRule server that forwards queries to the cluster's resolver.
+	DNSForwardCluster = "cluster"
+)
+
+// DNSForwardRule forwards queries for names in a domain to a specific resolver. The domain may be
+// written with a leading "*.", so "*.corp.internal" and "corp.internal" are equivalent. The server is
+// either DNSForwardLocal, DNSForwardCluster, or the address "ip" or "ip:port" of a DNS server that is
+// reached through the tunnel to the cluster.
+type DNSForwardRule struct {
+	Domain   string        `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Server   string        `json:"server,omitempty" yaml:"server,omitempty"`
+	Timeout  time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	CacheTTL time.Duration `json:"cacheTTL,omitempty" yaml:"cacheTTL,omitempty"`
+	NoCache  bool          `json:"noCache,omitempty" yaml:"noCache,omitempty"`
+}
+
+type DNSForwardRules []*DNSForwardRule
+
+// dnsForwardRuleJSON is the JSON representation of a DNSForwardRule, which uses strings for durations.
+type dnsForwardRuleJSON struct {
+	Domain   string `json:"domain,omitempty"`
+	Server   string `json:"server,omitempty"`
+	Timeout  string `json:"timeout,omitempty"`
+	CacheTTL string `json:"cacheTTL,omitempty"`
+	NoCache  bool   `json:"noCache,omitempty"`
+}
+
+func (r *DNSForwardRule) MarshalJSON() ([]byte, error) {
+	rj := dnsForwardRuleJSON{
+		Domain:  r.Domain,
+		Server:  r.Server,
+		NoCache: r.NoCache,
+	}
+	if r.Timeout != 0 {
+		rj.Timeout = r.Timeout.String()
+	}
+	if r.CacheTTL != 0 {
+		rj.CacheTTL = r.CacheTTL.String()
+	}
+	return json.Marshal(&rj)
+}
+
+func (r *DNSForwardRule) UnmarshalJSON(data []byte) (err error) {
+	var rj dnsForwardRuleJSON
+	if err = json.Unmarshal(data, &rj); err != nil {
+		return err
+	}
+	nr := DNSForwardRule{
+		Domain:  rj.Domain,
+		Server:  rj.Server,
+		NoCache: rj.NoCache,
+	}
+	if rj.Timeout != "" {
+		if nr.Timeout, err = time.ParseDuration(rj.Timeout); err != nil {
+			return err
+		}
+	}
+	if rj.CacheTTL != "" {
+		if nr.CacheTTL, err = time.ParseDuration(rj.CacheTTL); err != nil {
+			return err
+		}
+	}
+	if err = nr.validate(); err != nil {
+		return err
+	}
+	*r = nr
+	return nil
+}
+
+func (r *DNSForwardRule) UnmarshalYAML(node *yaml.Node) error {
+	type plain DNSForwardRule
+	var nr plain
+	if err := node.Decode(&nr); err != nil {
+		return err
+	}
+	if err := (*DNSForwardRule)(&nr).validate(); err != nil {
+		return fmt.Errorf("line %d: %w", node.Line, err)
+	}
+	*r = DNSForwardRule(nr)
+	return nil
+}
+
+func (r *DNSForwardRule) validate() error {
+	if NormalizeDNSDomain(r.Domain) == "" {
+		return fmt.Errorf("DNS forward rule for server %q has no domain", r.Server)
+	}
+	if r.Timeout < 0 {
+		return fmt.Errorf("DNS forward rule for domain %q has a negative timeout", r.Domain)
+	}
+	if r.CacheTTL < 0 {
+		return fmt.Errorf("DNS forward rule for domain %q has a negative cacheTTL", r.Domain)
+	}
+	switch r.Server {
+	case DNSForwardLocal, DNSForwardCluster:
+		return nil
+	}
+	if _, err := ParseDNSServer(r.Server); err != nil {
+		return fmt.Errorf("DNS forward rule for domain %q: %w", r.Domain, err)
+	}
+	return nil
+}
+
+// NormalizeDNSDomain returns the given domain in lower case and without leading "*." and trailing dot.
+func NormalizeDNSDomain(domain string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*."), ".")
+}
+
+// ParseDNSServer parses an address in the form "ip" or "ip:port" into an UDPAddr. The port defaults to 53.
+func ParseDNSServer(server string) (*net.UDPAddr, error) {
+	if ip := iputil.Parse(strings.Trim(server, "[]")); ip != nil {
+		return &net.UDPAddr{IP: ip, Port: 53}, nil
+	}
+	host, portStr, err := net.SplitHostPort(server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNS server %q, must be %q, %q, or an IP with an optional port", server, DNSForwardLocal, DNSForwardCluster)
+	}
+	ip := iputil.Parse(host)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid DNS server %q, %q is not an IP", server, host)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid DNS server %q, %q is not a valid port", server, portStr)
+	}
+	return &net.UDPAddr{IP: ip, Port: int(port)}, nil
+}
+
+func (d *DNSForwardRules) FromRPC(rpcRules []*rpc.DNSForwardRule) {
+	*d = make(DNSForwardRules, 0, len(rpcRules))
+	for _, rr := range rpcRules {
+		*d = append(*d, &DNSForwardRule{
+			Domain:   rr.Domain,
+			Server:   rr.Server,
+			Timeout:  rr.Timeout.AsDuration(),
+			CacheTTL: rr.CacheTtl.AsDuration(),
+			NoCache:  rr.NoCache,
+		})
+	}
+}
+
+func (d DNSForwardRules) ToRPC() []*rpc.DNSForwardRule {
+	rpcRules := make([]*rpc.DNSForwardRule, 0, len(d))
+	for _, r := range d {
+		rr := &rpc.DNSForwardRule{
+			Domain:  NormalizeDNSDomain(r.Domain),
+			Server:  r.Server,
+			NoCache: r.NoCache,
+		}
+		if r.Timeout > 0 {
+			rr.Timeout = durationpb.New(r.Timeout)
+		}
+		if r.CacheTTL > 0 {
+			rr.CacheTtl = durationpb.New(r.CacheTTL)
+		}
+		rpcRules = append(rpcRules, rr)
+	}
+	return rpcRules
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDNSForwardRule_Unmarshal(t *testing.T) {
+	var dc DnsConfig
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "forward-rules": [
+    {"domain": "*.corp.internal", "server": "10.1.2.3:53", "timeout": "2s"},
+    {"domain": "svc.example", "server": "local", "noCache": true},
+    {"domain": "cluster.example", "server": "cluster", "cacheTTL": "5m"}
+  ]
+}`), &dc))
+	assert.Equal(t, DNSForwardRules{
+		{Domain: "*.corp.internal", Server: "10.1.2.3:53", Timeout: 2 * time.Second},
+		{Domain: "svc.example", Server: DNSForwardLocal, NoCache: true},
+		{Domain: "cluster.example", Server: DNSForwardCluster, CacheTTL: 5 * time.Minute},
+	}, dc.ForwardRules)
+
+	data, err := json.Marshal(&dc)
+	require.NoError(t, err)
+	var rt DnsConfig
+	require.NoError(t, json.Unmarshal(data, &rt))
+	assert.Equal(t, dc.ForwardRules, rt.ForwardRules)
+
+	var dns DNS
+	require.NoError(t, yaml.Unmarshal([]byte(`
+forwardRules:
+  - domain: "*.corp.internal"
+    server: "[fd00::1]:5353"
+    timeout: 2s
+`), &dns))
+	assert.Equal(t, DNSForwardRules{
+		{Domain: "*.corp.internal", Server: "[fd00::1]:5353", Timeout: 2 * time.Second},
+	}, dns.ForwardRules)
+
+	for _, bad := range []string{
+		`{"server": "local"}`,
+		`{"domain": "*.", "server": "local"}`,
+		`{"domain": "corp.internal", "server": "dns.corp.internal"}`,
+		`{"domain": "corp.internal", "server": "10.1.2.3:0"}`,
+		`{"domain": "corp.internal", "server": "cluster", "timeout": "-1s"}`,
+		`{"domain": "corp.internal", "server": "cluster", "timeout": "soon"}`,
+	} {
+		var r DNSForwardRule
+		assert.Error(t, json.Unmarshal([]byte(bad), &r), bad)
+	}
+	assert.Error(t, yaml.Unmarshal([]byte("forwardRules: [{domain: corp.internal, server: nowhere}]"), &dns))
+}
+
+func TestParseDNSServer(t *testing.T) {
+	for s, expected := range map[string]*net.UDPAddr{
+		"10.1.2.3":       {IP: net.IP{10, 1, 2, 3}, Port: 53},
+		"10.1.2.3:5353":  {IP: net.IP{10, 1, 2, 3}, Port: 5353},
+		"fd00::1":        {IP: net.ParseIP("fd00::1"), Port: 53},
+		"[fd00::1]":      {IP: net.ParseIP("fd00::1"), Port: 53},
+		"[fd00::1]:5353": {IP: net.ParseIP("fd00::1"), Port: 5353},
+	} {
+		addr, err := ParseDNSServer(s)
+		require.NoError(t, err, s)
+		assert.True(t, expected.IP.Equal(addr.IP), s)
+		assert.Equal(t, expected.Port, addr.Port, s)
+	}
+	_, err := ParseDNSServer("10.1.2.3:65536")
+	assert.Error(t, err)
+}
+
+func TestDNSForwardRules_ToRPC(t *testing.T) {
+	rules := DNSForwardRules{
+		{Domain: "*.Corp.Internal.", Server: "10.1.2.3", Timeout: time.Second},
+		{Domain: "svc.example", Server: DNSForwardLocal, CacheTTL: time.Minute, NoCache: true},
+	}
+	rpcRules := rules.ToRPC()
+	require.Len(t, rpcRules, 2)
+	assert.Equal(t, "corp.internal", rpcRules[0].Domain)
+	assert.Nil(t, rpcRules[0].CacheTtl)
+
+	var rt DNSForwardRules
+	rt.FromRPC(rpcRules)
+	assert.Equal(t, DNSForwardRules{
+		{Domain: "corp.internal", Server: "10.1.2.3", Timeout: time.Second},
+		{Domain: "svc.example", Server: DNSForwardLocal, CacheTTL: time.Minute, NoCache: true},
+	}, rt)
+}
@@ -88,6 +88,10 @@ type DnsConfig struct {
 	// request is made for the name, the alias will be resolved instead.
 	Mappings DNSMappings `json:"mappings,omitempty"`
 
+	// ForwardRules contains a list of conditional forwarding rules. Each rule forwards queries for names in a domain
+	// to the local resolver, the cluster's resolver, or to a DNS server that is reached through the tunnel.
+	ForwardRules DNSForwardRules `json:"forward-rules,omitempty"`
+
 	// The maximum time to wait for a cluster side host lookup.
 	LookupTimeout v1.Duration `json:"lookup-timeout,omitempty"`
 }
@@ -600,6 +604,12 @@ func (kf *Kubeconfig) AddRemoteKubeConfigExtension(ctx context.Context, cfgYaml
 			}
 			kf.DNS.Mappings = append(kf.DNS.Mappings, dns.Mappings...)
 		}
+		if len(dns.ForwardRules) > 0 {
+			for _, r := range dns.ForwardRules {
+				dlog.Debugf(ctx, "Applying remote forward rule: Domain: %s, Server %s", r.Domain, r.Server)
+			}
+			kf.DNS.ForwardRules = append(kf.DNS.ForwardRules, dns.ForwardRules...)
+		}
 
 		if kf.DNS.LookupTimeout.Duration == 0 {
 			dlog.Debugf(ctx, "Applying remote lookupTimeout: %s", dns.LookupTimeout)
@@ -0,0 +1,48 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(rq *http.Request) (*http.Response, error) {
+	return f(rq)
+}
+
+// BearerToken returns the bearer token that the given config uses when accessing the cluster. The
+// traffic-manager authenticates the user with this token before it verifies that the user is allowed to
+// make requests that concern other clients. Tokens that are provided by an exec or auth-provider plugin
+// are obtained the same way as when the cluster is accessed.
+func BearerToken(ctx context.Context, rc *rest.Config) (string, error) {
+	var token string
+	rt, err := rest.HTTPWrappersForConfig(rc, roundTripperFunc(func(rq *http.Request) (*http.Response, error) {
+		if t, ok := strings.CutPrefix(rq.Header.Get("Authorization"), "Bearer "); ok {
+			token = t
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: rq}, nil
+	}))
+	if err != nil {
+		return "", fmt.Errorf("unable to obtain the credentials of the user: %w", err)
+	}
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	if err != nil {
+		return "", err
+	}
+	rsp, err := rt.RoundTrip(rq)
+	if err != nil {
+		return "", fmt.Errorf("unable to obtain the credentials of the user: %w", err)
+	}
+	_ = rsp.Body.Close()
+	if token == "" {
+		return "", errcat.User.New("the current kubeconfig context has no bearer token, and the traffic-manager needs one to authenticate the user")
+	}
+	return token, nil
+}
@@ -0,0 +1,54 @@
+package k8sclient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+)
+
+func TestBearerToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token"), 0o600))
+
+	tests := []struct {
+		name    string
+		config  *rest.Config
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "token",
+			config: &rest.Config{Host: "https://cluster.example.com", BearerToken: "the-token"},
+			want:   "the-token",
+		},
+		{
+			name:   "token file",
+			config: &rest.Config{Host: "https://cluster.example.com", BearerTokenFile: tokenFile},
+			want:   "file-token",
+		},
+		{
+			name:    "basic auth",
+			config:  &rest.Config{Host: "https://cluster.example.com", Username: "admin", Password: "secret"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := BearerToken(dlog.NewTestContext(t, false), tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, errcat.User, errcat.GetCategory(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, token)
+		})
+	}
+}
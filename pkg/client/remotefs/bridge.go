@@ -69,8 +69,9 @@ func (m *bridgeMounter) dispatchToTunnel(ctx context.Context, conn net.Conn, pod
 		return fmt.Errorf("failed to establish tunnel: %v", err)
 	}
 
-	tos := client2.GetConfig(ctx).Timeouts()
-	ctx, cancel := context.WithCancel(ctx)
+	cfg := client2.GetConfig(ctx)
+	tos := cfg.Timeouts()
+	ctx, cancel := context.WithCancel(tunnel.WithCompression(ctx, cfg.Tunnel().Compression))
 	s, err := tunnel.NewClientStream(ctx, ms, id, m.sessionID, tos.PrivateRoundtripLatency, tos.PrivateEndpointDial)
 	if err != nil {
 		cancel()
@@ -0,0 +1,190 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/dnsproxy"
+)
+
+// Exchanger sends a DNS message to a DNS server that is reached through the tunnel to the cluster, and
+// returns its reply.
+type Exchanger func(context.Context, *net.UDPAddr, *dns.Msg) (*dns.Msg, error)
+
+// forwardRule is the parsed form of an rpc.DNSForwardRule.
+type forwardRule struct {
+	domain   string       // lower case domain with a trailing dot
+	server   string       // client.DNSForwardLocal, client.DNSForwardCluster, or the address of a DNS server
+	addr     *net.UDPAddr // address of the DNS server, nil unless server is an address
+	timeout  time.Duration
+	cacheTTL time.Duration
+	noCache  bool
+}
+
+func (r *forwardRule) toRPC() *rpc.DNSForwardRule {
+	rr := &rpc.DNSForwardRule{
+		Domain:  strings.TrimSuffix(r.domain, "."),
+		Server:  r.server,
+		NoCache: r.noCache,
+	}
+	if r.timeout > 0 {
+		rr.Timeout = durationpb.New(r.timeout)
+	}
+	if r.cacheTTL > 0 {
+		rr.CacheTtl = durationpb.New(r.cacheTTL)
+	}
+	return rr
+}
+
+// forwardRulesList parses the given rules and returns them sorted so that the rule with the longest domain comes
+// first. The rules are validated by the client, so rules that can't be parsed are just skipped.
+func forwardRulesList(rules []*rpc.DNSForwardRule) []*forwardRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	frs := make([]*forwardRule, 0, len(rules))
+	for _, r := range rules {
+		domain := client.NormalizeDNSDomain(r.Domain)
+		if domain == "" {
+			continue
+		}
+		fr := &forwardRule{
+			domain:   domain + ".",
+			server:   r.Server,
+			timeout:  r.Timeout.AsDuration(),
+			cacheTTL: r.CacheTtl.AsDuration(),
+			noCache:  r.NoCache,
+		}
+		switch r.Server {
+		case client.DNSForwardLocal, client.DNSForwardCluster:
+		default:
+			addr, err := client.ParseDNSServer(r.Server)
+			if err != nil {
+				continue
+			}
+			fr.addr = addr
+		}
+		frs = append(frs, fr)
+	}
+	sort.SliceStable(frs, func(i, j int) bool {
+		return len(frs[i].domain) > len(frs[j].domain)
+	})
+	return frs
+}
+
+// forwardRuleFor returns the most specific forward rule that matches the given name, or nil if no rule matches.
+func (s *Server) forwardRuleFor(name string) *forwardRule {
+	for _, fr := range s.forwardRules {
+		if name == fr.domain || strings.HasSuffix(name, "."+fr.domain) {
+			return fr
+		}
+	}
+	return nil
+}
+
+// forwardDomains returns the domains of the forward rules that must be routed to this resolver, i.e. all
+// domains except those that are forwarded to the local resolver.
+func (s *Server) forwardDomains() []string {
+	var domains []string
+	for _, fr := range s.forwardRules {
+		if fr.server != client.DNSForwardLocal {
+			domains = append(domains, strings.TrimSuffix(fr.domain, "."))
+		}
+	}
+	return domains
+}
+
+// resolveForward resolves the given query using the given rule. The reply is cached according to the
+// rule's caching policy.
+func (s *Server) resolveForward(fr *forwardRule, q *dns.Question) (dnsproxy.RRs, int, error) {
+	if fr.server == client.DNSForwardLocal && s.fallbackPool == nil {
+		// The system resolver might route the query back to this server, e.g. when the name is also
+		// matched by an include-suffix. Refusing the query that comes back breaks that loop. This
+		// must be done before the cache is consulted, because the cache would await the first query.
+		key := cacheKey{name: q.Name, qType: q.Qtype}
+		if _, loaded := s.localForwards.LoadOrStore(key, struct{}{}); loaded {
+			dlog.Debugf(s.ctx, "refusing query %q: already forwarded to the local resolver", key.String())
+			return nil, dns.RcodeRefused, nil
+		}
+		defer s.localForwards.Delete(key)
+	}
+	resolve := func(c context.Context, q *dns.Question) (dnsproxy.RRs, int, error) {
+		return s.forward(c, fr, q)
+	}
+	if fr.noCache {
+		return resolve(s.ctx, q)
+	}
+	return s.resolveThruCache(q, resolve, fr.cacheTTL)
+}
+
+func (s *Server) forward(c context.Context, fr *forwardRule, q *dns.Question) (result dnsproxy.RRs, rCode int, err error) {
+	timeout := fr.timeout
+	if timeout <= 0 {
+		timeout = s.lookupTimeout
+	}
+	c, cancel := context.WithTimeout(c, timeout)
+	defer cancel()
+
+	switch fr.server {
+	case client.DNSForwardCluster:
+		dlog.Debugf(c, "Cluster DNS included by forward rule %q for name %q", fr.domain, q.Name)
+		result, rCode, err = s.clusterLookup(c, q)
+	case client.DNSForwardLocal:
+		result, rCode, err = s.forwardLocal(c, q, timeout)
+	default:
+		result, rCode, err = s.forwardExchange(c, fr.addr, q)
+	}
+	if err != nil {
+		return nil, rCode, client.CheckTimeout(c, err)
+	}
+
+	// Our cache decides how long the result lives, so keep the TTLs low.
+	for _, rr := range result {
+		if h := rr.Header(); h != nil {
+			h.Ttl = dnsTTL
+		}
+	}
+	return result, rCode, nil
+}
+
+// forwardExchange sends the given query to a DNS server that is reached through the tunnel.
+func (s *Server) forwardExchange(c context.Context, addr *net.UDPAddr, q *dns.Question) (dnsproxy.RRs, int, error) {
+	if s.clusterExchange == nil {
+		return nil, dns.RcodeServerFailure, errors.New("no tunnel is available for DNS forwarding")
+	}
+	reply, err := s.clusterExchange(c, addr, newQuery(q))
+	if err != nil {
+		return nil, dns.RcodeServerFailure, err
+	}
+	return reply.Answer, reply.Rcode, nil
+}
+
+// forwardLocal sends the given query to the local resolver. That's the fallback resolver when it exists, and
+// the system resolver otherwise.
+func (s *Server) forwardLocal(c context.Context, q *dns.Question, timeout time.Duration) (dnsproxy.RRs, int, error) {
+	if s.fallbackPool != nil {
+		reply, _, err := s.fallbackPool.Exchange(c, &dns.Client{Net: "udp", Timeout: timeout}, newQuery(q))
+		if err != nil {
+			return nil, dns.RcodeServerFailure, err
+		}
+		return reply.Answer, reply.Rcode, nil
+	}
+	return dnsproxy.Lookup(c, q.Qtype, q.Name)
+}
+
+func newQuery(q *dns.Question) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(q.Name, q.Qtype)
+	m.RecursionDesired = true
+	return m
+}
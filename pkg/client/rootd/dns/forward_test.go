@@ -0,0 +1,190 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/dnsproxy"
+)
+
+type testResponseWriter struct {
+	dns.ResponseWriter
+	msg *dns.Msg
+}
+
+func (w *testResponseWriter) WriteMsg(m *dns.Msg) error {
+	w.msg = m
+	return nil
+}
+
+func aRecord(name string, ip net.IP) dns.RR {
+	return &dns.A{Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300}, A: ip}
+}
+
+type forwardTest struct {
+	t              *testing.T
+	server         *Server
+	clusterLookups atomic.Int32
+	exchanges      atomic.Int32
+	exchangeAddr   *net.UDPAddr
+}
+
+func newForwardTest(t *testing.T, config *rpc.DNSConfig) *forwardTest {
+	ft := &forwardTest{t: t}
+	lookup := func(_ context.Context, q *dns.Question) (dnsproxy.RRs, int, error) {
+		ft.clusterLookups.Add(1)
+		if q.Name == "timeout.corp.com." {
+			return nil, dns.RcodeServerFailure, context.DeadlineExceeded
+		}
+		return dnsproxy.RRs{aRecord(q.Name, net.IP{10, 0, 0, 1})}, dns.RcodeSuccess, nil
+	}
+	exchange := func(_ context.Context, addr *net.UDPAddr, m *dns.Msg) (*dns.Msg, error) {
+		ft.exchanges.Add(1)
+		ft.exchangeAddr = addr
+		reply := new(dns.Msg)
+		q := m.Question[0]
+		if q.Name == "missing.svc.example." {
+			reply.SetRcode(m, dns.RcodeNameError)
+			return reply, nil
+		}
+		reply.SetReply(m)
+		reply.Answer = []dns.RR{aRecord(q.Name, net.IP{10, 1, 0, 1})}
+		return reply, nil
+	}
+	ft.server = NewServer(config, lookup, exchange)
+	ft.server.ctx = dlog.NewTestContext(t, false)
+	ft.server.resolve = ft.server.resolveInCluster
+	return ft
+}
+
+func (ft *forwardTest) query(name string) *dns.Msg {
+	r := new(dns.Msg)
+	r.SetQuestion(name, dns.TypeA)
+	w := &testResponseWriter{}
+	ft.server.ServeDNS(w, r)
+	require.NotNil(ft.t, w.msg)
+	return w.msg
+}
+
+func (ft *forwardTest) answerIP(m *dns.Msg) net.IP {
+	require.Equal(ft.t, dns.RcodeSuccess, m.Rcode)
+	require.Len(ft.t, m.Answer, 1)
+	a, ok := m.Answer[0].(*dns.A)
+	require.True(ft.t, ok)
+	assert.Equal(ft.t, uint32(dnsTTL), a.Hdr.Ttl)
+	return a.A
+}
+
+func TestForwardRules_Cluster(t *testing.T) {
+	ft := newForwardTest(t, &rpc.DNSConfig{
+		ForwardRules: []*rpc.DNSForwardRule{{Domain: "corp.com", Server: "cluster"}},
+	})
+
+	// The rule overrides the default exclude-suffix ".com".
+	assert.Equal(t, net.IP{10, 0, 0, 1}, ft.answerIP(ft.query("db.corp.com.")))
+	assert.Equal(t, int32(1), ft.clusterLookups.Load())
+	assert.Equal(t, int32(0), ft.exchanges.Load())
+
+	// Names that aren't matched by the rule are still excluded.
+	assert.Equal(t, dns.RcodeNameError, ft.query("db.other.com.").Rcode)
+	assert.Equal(t, int32(1), ft.clusterLookups.Load())
+
+	// Errors are not replaced with NXDOMAIN.
+	assert.Equal(t, dns.RcodeServerFailure, ft.query("timeout.corp.com.").Rcode)
+}
+
+func TestForwardRules_Address(t *testing.T) {
+	ft := newForwardTest(t, &rpc.DNSConfig{
+		IncludeSuffixes: []string{".example"},
+		ForwardRules: []*rpc.DNSForwardRule{
+			{Domain: "example", Server: "cluster"},
+			{Domain: "svc.example", Server: "10.1.2.3:5353"},
+		},
+	})
+
+	// The most specific rule wins.
+	assert.Equal(t, net.IP{10, 1, 0, 1}, ft.answerIP(ft.query("db.svc.example.")))
+	assert.Equal(t, &net.UDPAddr{IP: net.IP{10, 1, 2, 3}, Port: 5353}, ft.exchangeAddr)
+	assert.Equal(t, net.IP{10, 0, 0, 1}, ft.answerIP(ft.query("db.example.")))
+	assert.Equal(t, int32(1), ft.exchanges.Load())
+	assert.Equal(t, int32(1), ft.clusterLookups.Load())
+
+	// The reply code of the DNS server is retained.
+	assert.Equal(t, dns.RcodeNameError, ft.query("missing.svc.example.").Rcode)
+	assert.Equal(t, int32(2), ft.exchanges.Load())
+}
+
+func TestForwardRules_Mapping(t *testing.T) {
+	ft := newForwardTest(t, &rpc.DNSConfig{
+		Mappings:     []*rpc.DNSMapping{{Name: "db.svc.example", AliasFor: "10.2.0.1"}},
+		ForwardRules: []*rpc.DNSForwardRule{{Domain: "*.svc.example", Server: "10.1.2.3"}},
+	})
+
+	// Mappings take precedence over forward rules.
+	assert.Equal(t, net.IP{10, 2, 0, 1}, ft.answerIP(ft.query("db.svc.example.")).To4())
+	assert.Equal(t, int32(0), ft.exchanges.Load())
+	assert.Equal(t, net.IP{10, 1, 0, 1}, ft.answerIP(ft.query("web.svc.example.")))
+	assert.Equal(t, &net.UDPAddr{IP: net.IP{10, 1, 2, 3}, Port: 53}, ft.exchangeAddr)
+}
+
+func TestForwardRules_Cache(t *testing.T) {
+	ft := newForwardTest(t, &rpc.DNSConfig{
+		ForwardRules: []*rpc.DNSForwardRule{
+			{Domain: "cached.example", Server: "10.1.2.3"},
+			{Domain: "uncached.example", Server: "10.1.2.3", NoCache: true},
+			{Domain: "short.example", Server: "10.1.2.3", CacheTtl: durationpb.New(time.Millisecond)},
+		},
+	})
+
+	ft.query("a.cached.example.")
+	ft.query("a.cached.example.")
+	assert.Equal(t, int32(1), ft.exchanges.Load())
+
+	ft.query("a.uncached.example.")
+	ft.query("a.uncached.example.")
+	assert.Equal(t, int32(3), ft.exchanges.Load())
+
+	ft.query("a.short.example.")
+	time.Sleep(5 * time.Millisecond)
+	ft.query("a.short.example.")
+	assert.Equal(t, int32(5), ft.exchanges.Load())
+}
+
+func TestForwardRules_NoTunnel(t *testing.T) {
+	ft := newForwardTest(t, &rpc.DNSConfig{
+		ForwardRules: []*rpc.DNSForwardRule{{Domain: "svc.example", Server: "10.1.2.3"}},
+	})
+	ft.server.clusterExchange = func(context.Context, *net.UDPAddr, *dns.Msg) (*dns.Msg, error) {
+		return nil, errors.New("no tunnel")
+	}
+	assert.Equal(t, dns.RcodeServerFailure, ft.query("db.svc.example.").Rcode)
+}
+
+func TestForwardRules_GetConfig(t *testing.T) {
+	ft := newForwardTest(t, &rpc.DNSConfig{
+		ForwardRules: []*rpc.DNSForwardRule{
+			{Domain: "*.Corp.Internal.", Server: "10.1.2.3:53", Timeout: durationpb.New(2 * time.Second)},
+			{Domain: "svc.example", Server: "local", NoCache: true},
+			{Domain: "", Server: "cluster"},
+		},
+	})
+	rules := ft.server.GetConfig().ForwardRules
+	require.Len(t, rules, 2)
+	assert.Equal(t, "corp.internal", rules[0].Domain)
+	assert.Equal(t, "10.1.2.3:53", rules[0].Server)
+	assert.Equal(t, 2*time.Second, rules[0].Timeout.AsDuration())
+	assert.Equal(t, "svc.example", rules[1].Domain)
+	assert.True(t, rules[1].NoCache)
+	assert.Equal(t, []string{"corp.internal"}, ft.server.forwardDomains())
+}
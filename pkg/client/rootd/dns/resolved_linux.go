@@ -104,7 +104,8 @@ func (s *Server) tryResolveD(c context.Context, dev vif.Device, configureDNS fun
 
 func (s *Server) updateLinkDomains(c context.Context, dev vif.Device) error {
 	s.Lock()
-	paths := make([]string, len(s.search)+len(s.routes)+len(s.includeSuffixes)+1)
+	forwardDomains := s.forwardDomains()
+	paths := make([]string, len(s.search)+len(s.routes)+len(s.includeSuffixes)+len(forwardDomains)+1)
 
 	// Namespaces are copied verbatim. Entries that aren't prefixed with "~" are considered search path entries.
 	copy(paths, s.search)
@@ -121,6 +122,12 @@ func (s *Server) updateLinkDomains(c context.Context, dev vif.Device) error {
 		paths[i] = "~" + strings.TrimPrefix(sfx, ".")
 		i++
 	}
+
+	// Domains of forward rules are routes too, unless they are forwarded to the local resolver.
+	for _, domain := range forwardDomains {
+		paths[i] = "~" + domain
+		i++
+	}
 	paths[i] = "~" + s.clusterDomain
 	s.Unlock()
 
@@ -117,6 +117,15 @@ type Server struct {
 	// Function that sends a lookup request to the traffic-manager
 	clusterLookup Resolver
 
+	// Function that sends a DNS message to a DNS server that is reached through the tunnel
+	clusterExchange Exchanger
+
+	// forwardRules are sorted so that the most specific rule comes first
+	forwardRules []*forwardRule
+
+	// localForwards contains the queries that are currently forwarded to the system resolver
+	localForwards *xsync.MapOf[cacheKey, struct{}]
+
 	error string
 
 	// ready is closed when the DNS server is fully configured
@@ -125,7 +134,8 @@ type Server struct {
 
 type cacheEntry struct {
 	created      time.Time
-	currentQType int32 // will be set to the current qType during call to cluster
+	ttl          time.Duration // zero means cacheTTL
+	currentQType int32         // will be set to the current qType during call to cluster
 	answer       dnsproxy.RRs
 	rCode        int
 	wait         chan struct{}
@@ -135,7 +145,11 @@ type cacheEntry struct {
 const cacheTTL = 60 * time.Second
 
 func (dv *cacheEntry) expired() bool {
-	return time.Since(dv.created) > cacheTTL
+	ttl := dv.ttl
+	if ttl <= 0 {
+		ttl = cacheTTL
+	}
+	return time.Since(dv.created) > ttl
 }
 
 func (dv *cacheEntry) close() {
@@ -154,7 +168,7 @@ func sliceToLower(ss []string) []string {
 }
 
 // NewServer returns a new dns.Server.
-func NewServer(config *rpc.DNSConfig, clusterLookup Resolver) *Server {
+func NewServer(config *rpc.DNSConfig, clusterLookup Resolver, clusterExchange Exchanger) *Server {
 	if config == nil {
 		config = &rpc.DNSConfig{}
 	}
@@ -179,6 +193,9 @@ func NewServer(config *rpc.DNSConfig, clusterLookup Resolver) *Server {
 		searchPathCh:    make(chan []string, 5),
 		clusterDomain:   defaultClusterDomain,
 		clusterLookup:   clusterLookup,
+		clusterExchange: clusterExchange,
+		forwardRules:    forwardRulesList(config.ForwardRules),
+		localForwards:   xsync.NewMapOf[cacheKey, struct{}](),
 		ready:           make(chan struct{}),
 	}
 	if lt := config.LookupTimeout; lt != nil {
@@ -378,6 +395,12 @@ func (s *Server) GetConfig() *rpc.DNSConfig {
 			}
 		}
 	}
+	if len(s.forwardRules) > 0 {
+		c.ForwardRules = make([]*rpc.DNSForwardRule, len(s.forwardRules))
+		for i, fr := range s.forwardRules {
+			c.ForwardRules[i] = fr.toRPC()
+		}
+	}
 	s.RUnlock()
 	return &c
 }
@@ -633,10 +656,10 @@ func (s *Server) resolveWithRecursionCheck(q *dns.Question) (dnsproxy.RRs, int,
 		return localHostReply(q), dns.RcodeSuccess, nil
 	}
 
-	answer, rCode, err := s.resolveThruCache(q)
+	answer, rCode, err := s.resolveThruCache(q, s.resolve, 0)
 	if err != nil || rCode != dns.RcodeSuccess {
 		// For A and AAAA queries, we check if we have a successful counterpart in the cache. If we
-		// do, then this query must return NOERROR EMPTY
+		// do, then the name exists, and this query must return NOERROR EMPTY.
 		ck := cacheKey{name: q.Name, qType: dns.TypeNone}
 		switch q.Qtype {
 		case dns.TypeA:
@@ -647,8 +670,9 @@ func (s *Server) resolveWithRecursionCheck(q *dns.Question) (dnsproxy.RRs, int,
 		if ck.qType != dns.TypeNone {
 			if ce, ok := s.cache.Load(ck); ok {
 				<-ce.wait
-				if !ce.expired() && ce.rCode == dns.RcodeSuccess && atomic.LoadInt32(&ce.currentQType) == int32(ck.qType) {
-					dlog.Debugf(s.ctx, "found counterpart for %s %s", dns.TypeToString[uint16(ce.currentQType)], ce.answer)
+				if !ce.expired() && ce.rCode == dns.RcodeSuccess {
+					dlog.Debugf(s.ctx, "found counterpart for %s %s", dns.TypeToString[ck.qType], ce.answer)
+					answer = nil
 					err = nil
 					rCode = dns.RcodeSuccess
 				}
@@ -660,9 +684,10 @@ func (s *Server) resolveWithRecursionCheck(q *dns.Question) (dnsproxy.RRs, int,
 
 // resolveThruCache resolves the given query by first performing a cache lookup. If a cached
 // entry is found that hasn't expired, it's returned. If not, this function will call
-// resolveQuery() to resolve and store in the case.
-func (s *Server) resolveThruCache(q *dns.Question) (answer dnsproxy.RRs, rCode int, err error) {
-	dv := &cacheEntry{wait: make(chan struct{}), created: time.Now()}
+// the given resolver to resolve and store in the cache using the given ttl, where zero
+// means cacheTTL.
+func (s *Server) resolveThruCache(q *dns.Question, resolve Resolver, ttl time.Duration) (answer dnsproxy.RRs, rCode int, err error) {
+	dv := &cacheEntry{wait: make(chan struct{}), created: time.Now(), ttl: ttl}
 	key := cacheKey{name: q.Name, qType: q.Qtype}
 	if oldDv, loaded := s.cache.LoadOrStore(key, dv); loaded {
 		if atomic.LoadInt32(&s.recursive) == recursionDetected && atomic.LoadInt32(&oldDv.currentQType) == int32(q.Qtype) {
@@ -703,7 +728,7 @@ func (s *Server) resolveThruCache(q *dns.Question) (answer dnsproxy.RRs, rCode i
 		atomic.StoreInt32(&dv.currentQType, int32(dns.TypeNone))
 		dv.close()
 	}()
-	return s.resolve(s.ctx, q)
+	return resolve(s.ctx, q)
 }
 
 // dfs is a func that implements the fmt.Stringer interface. Used in log statements to ensure
@@ -887,9 +912,16 @@ func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 
 	// try and resolve any mappings before consulting the cache, so that mapping hits don't
 	// end up in the cache.
+	var rule *forwardRule
 	answer, rCode, err := s.resolveMapping(q)
 	if err == errNoMapping {
-		answer, rCode, err = s.resolveWithRecursionCheck(q)
+		// Forward rules take precedence over the include and exclude suffixes.
+		if rule = s.forwardRuleFor(q.Name); rule != nil {
+			pfx = func() string { return fmt.Sprintf("(%s) ", rule.server) }
+			answer, rCode, err = s.resolveForward(rule, q)
+		} else {
+			answer, rCode, err = s.resolveWithRecursionCheck(q)
+		}
 	}
 
 	if err == nil && rCode == dns.RcodeSuccess {
@@ -909,17 +941,20 @@ func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		return
 	}
 
-	// The recursion check query, or queries that end with the cluster domain name, are not dispatched to the
-	// fallback DNS-server.
+	// The recursion check query, queries that end with the cluster domain name, and queries that were
+	// handled by a forward rule, are not dispatched to the fallback DNS-server.
 	s.RLock()
 	cd := s.clusterDomain
 	s.RUnlock()
 	if s.fallbackPool == nil ||
+		rule != nil ||
 		strings.HasPrefix(q.Name, recursionCheck2) ||
 		strings.HasSuffix(q.Name, cd) ||
 		strings.HasSuffix(origName, tel2SubDomainDot) {
 		if err == nil {
-			rCode = dns.RcodeNameError
+			if rule == nil {
+				rCode = dns.RcodeNameError
+			}
 		} else {
 			rCode = dns.RcodeServerFailure
 			if errors.Is(err, context.DeadlineExceeded) {
@@ -122,8 +122,10 @@ func (s *Server) updateResolverFiles(c context.Context, resolverDirName string,
 		}
 	}
 
-	// All routes and include suffixes become domains
-	domains := make(map[string]*resolveFile, len(s.routes)+len(s.includeSuffixes))
+	// All routes, include suffixes, and domains of forward rules that aren't forwarded to the local resolver
+	// become domains
+	forwardDomains := s.forwardDomains()
+	domains := make(map[string]*resolveFile, len(s.routes)+len(s.includeSuffixes)+len(forwardDomains))
 	for route := range s.routes {
 		domains[route] = newDomainResolveFile(route)
 	}
@@ -131,6 +133,9 @@ func (s *Server) updateResolverFiles(c context.Context, resolverDirName string,
 		sfx = strings.TrimPrefix(sfx, ".")
 		domains[sfx] = newDomainResolveFile(sfx)
 	}
+	for _, domain := range forwardDomains {
+		domains[domain] = newDomainResolveFile(domain)
+	}
 	clusterDomain := strings.TrimSuffix(s.clusterDomain, ".")
 	domains[clusterDomain] = newDomainResolveFile(clusterDomain)
 	domains[tel2SubDomain] = newDomainResolveFile(tel2SubDomain)
@@ -1,15 +1,20 @@
 package dns
 
 import (
+	"context"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/miekg/dns"
 	"github.com/puzpuzpuz/xsync/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/datawire/dlib/dlog"
 	rpc "github.com/telepresenceio/telepresence/rpc/v2/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/dnsproxy"
 )
 
 type suiteServer struct {
@@ -130,3 +135,89 @@ func (s *suiteServer) TestIsExcluded() {
 func TestServerTestSuite(t *testing.T) {
 	suite.Run(t, new(suiteServer))
 }
+
+func TestServeDNS_DualStack(t *testing.T) {
+	ip4 := net.IP{10, 0, 0, 1}
+	ip6 := net.ParseIP("fd00::1")
+	aaaaRecord := func(name string, ip net.IP) dns.RR {
+		return &dns.AAAA{Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: 300}, AAAA: ip}
+	}
+	lookup := func(_ context.Context, q *dns.Question) (dnsproxy.RRs, int, error) {
+		switch q.Name {
+		case "dual.default.svc.cluster.local.":
+			// Both families are returned, regardless of the query type.
+			return dnsproxy.RRs{aRecord(q.Name, ip4), aaaaRecord(q.Name, ip6)}, dns.RcodeSuccess, nil
+		case "v6.default.svc.cluster.local.":
+			if q.Qtype == dns.TypeAAAA {
+				return dnsproxy.RRs{aaaaRecord(q.Name, ip6)}, dns.RcodeSuccess, nil
+			}
+			return nil, dns.RcodeSuccess, nil
+		case "v4.default.svc.cluster.local.":
+			if q.Qtype == dns.TypeA {
+				return dnsproxy.RRs{aRecord(q.Name, ip4)}, dns.RcodeSuccess, nil
+			}
+			// Some resolvers report a missing AAAA record as a name error.
+			return nil, dns.RcodeNameError, nil
+		}
+		return nil, dns.RcodeNameError, nil
+	}
+	s := NewServer(&rpc.DNSConfig{
+		Mappings: []*rpc.DNSMapping{
+			{Name: "db-alias", AliasFor: "dual.default.svc.cluster.local"},
+			{Name: "db-ip6", AliasFor: "fd00::2"},
+		},
+	}, lookup, nil)
+	s.ctx = dlog.NewTestContext(t, false)
+	s.resolve = s.resolveInCluster
+
+	query := func(name string, qType uint16) *dns.Msg {
+		r := new(dns.Msg)
+		r.SetQuestion(name, qType)
+		w := &testResponseWriter{}
+		s.ServeDNS(w, r)
+		require.NotNil(t, w.msg)
+		return w.msg
+	}
+	addrs := func(m *dns.Msg) (ips []net.IP) {
+		for _, rr := range m.Answer {
+			switch rr := rr.(type) {
+			case *dns.A:
+				ips = append(ips, rr.A)
+			case *dns.AAAA:
+				ips = append(ips, rr.AAAA)
+			}
+		}
+		return ips
+	}
+
+	tests := []struct {
+		name  string
+		qType uint16
+		rCode int
+		want  []net.IP
+	}{
+		{name: "dual.default.svc.cluster.local.", qType: dns.TypeAAAA, want: []net.IP{ip6}},
+		{name: "dual.default.svc.cluster.local.", qType: dns.TypeA, want: []net.IP{ip4}},
+		{name: "v6.default.svc.cluster.local.", qType: dns.TypeAAAA, want: []net.IP{ip6}},
+		{name: "v6.default.svc.cluster.local.", qType: dns.TypeA},
+		{name: "v4.default.svc.cluster.local.", qType: dns.TypeA, want: []net.IP{ip4}},
+		// The name exists, so the missing AAAA record is NOERROR with an empty answer.
+		{name: "v4.default.svc.cluster.local.", qType: dns.TypeAAAA},
+		{name: "db-alias.", qType: dns.TypeAAAA, want: []net.IP{ip6}},
+		{name: "db-ip6.", qType: dns.TypeAAAA, want: []net.IP{net.ParseIP("fd00::2")}},
+		{name: "db-ip6.", qType: dns.TypeA},
+		{name: "missing.default.svc.cluster.local.", qType: dns.TypeAAAA, rCode: dns.RcodeNameError},
+	}
+	for _, tt := range tests {
+		t.Run(dns.TypeToString[tt.qType]+" "+tt.name, func(t *testing.T) {
+			m := query(tt.name, tt.qType)
+			assert.Equal(t, tt.rCode, m.Rcode)
+			assert.Equal(t, tt.want, addrs(m))
+			for _, rr := range m.Answer {
+				if rt := rr.Header().Rrtype; rt != dns.TypeCNAME {
+					assert.Equal(t, tt.qType, rt)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package rootd
 
 import (
 	"context"
+	"io"
 
 	"github.com/blang/semver"
 	"google.golang.org/grpc"
@@ -114,6 +115,40 @@ func (rd *InProcSession) WaitForAgentIP(ctx context.Context, request *rpc.WaitFo
 	return rd.waitForAgentIP(ctx, request)
 }
 
+func (rd *InProcSession) WatchConnectionStats(
+	ctx context.Context,
+	request *manager.ConnectionStatsRequest,
+	opts ...grpc.CallOption,
+) (rpc.Daemon_WatchConnectionStatsClient, error) {
+	ch := make(chan *manager.ConnectionStatsSnapshot)
+	go func() {
+		defer close(ch)
+		_ = rd.connStats.Watch(ctx, request, "", func(snapshot *manager.ConnectionStatsSnapshot) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case ch <- snapshot:
+				return nil
+			}
+		})
+	}()
+	return &connStatsClient{ch: ch}, nil
+}
+
+// connStatsClient is a rpc.Daemon_WatchConnectionStatsClient that receives its snapshots from a channel. Only
+// the Recv method is implemented.
+type connStatsClient struct {
+	grpc.ClientStream
+	ch <-chan *manager.ConnectionStatsSnapshot
+}
+
+func (c *connStatsClient) Recv() (*manager.ConnectionStatsSnapshot, error) {
+	if snapshot, ok := <-c.ch; ok {
+		return snapshot, nil
+	}
+	return nil, io.EOF
+}
+
 // NewInProcSession returns a root daemon session suitable to use in-process (from the user daemon) and is primarily intended for
 // when the user daemon runs in a docker container with NET_ADMIN capabilities.
 func NewInProcSession(
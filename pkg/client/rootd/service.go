@@ -34,6 +34,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/pprof"
 	"github.com/telepresenceio/telepresence/v2/pkg/proc"
 	"github.com/telepresenceio/telepresence/v2/pkg/tracing"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
 	"github.com/telepresenceio/telepresence/v2/pkg/vif"
 )
 
@@ -282,6 +283,24 @@ func (s *Service) WaitForAgentIP(ctx context.Context, request *rpc.WaitForAgentI
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) WatchConnectionStats(request *manager.ConnectionStatsRequest, stream rpc.Daemon_WatchConnectionStatsServer) error {
+	var ctx context.Context
+	var connStats *tunnel.ConnStatsRegistry
+	err := s.WithSession(func(c context.Context, session *Session) error {
+		ctx, connStats = c, session.connStats
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// End when either the session or the call ends.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer context.AfterFunc(stream.Context(), cancel)()
+	return connStats.Watch(ctx, request, "", stream.Send)
+}
+
 func (s *Service) SetLogLevel(ctx context.Context, request *manager.LogLevelRequest) (*emptypb.Empty, error) {
 	duration := time.Duration(0)
 	if request.Duration != nil {
@@ -49,6 +49,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/dnet"
 	"github.com/telepresenceio/telepresence/v2/pkg/dnsproxy"
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
 	"github.com/telepresenceio/telepresence/v2/pkg/slice"
 	"github.com/telepresenceio/telepresence/v2/pkg/subnet"
@@ -107,6 +108,16 @@ type Session struct {
 	// are obtained using a connpool.ConnID.
 	handlers *tunnel.Pool
 
+	// connStats keeps track of the statistics of the connections that are tunneled to the cluster.
+	connStats *tunnel.ConnStatsRegistry
+
+	// tunnelStreams creates the streams of the connections that are tunneled to the cluster. It's
+	// created when the first cluster info arrives, and read concurrently by DNS exchanges.
+	tunnelStreams atomic.Pointer[tunnel.StreamCreator]
+
+	// dnsExchangePort is the last source port used when forwarding DNS messages through the tunnel.
+	dnsExchangePort uint32
+
 	// The local dns server
 	dnsServer *dns.Server
 
@@ -119,8 +130,8 @@ type Session struct {
 	// dnsLocalAddr is address of the local DNS Service.
 	dnsLocalAddr *net.UDPAddr
 
-	// serviceSubnet reported by the traffic-manager
-	serviceSubnet *net.IPNet
+	// serviceSubnets reported by the traffic-manager, one for each IP family used by the cluster
+	serviceSubnets []*net.IPNet
 
 	// podSubnets reported by the traffic-manager
 	podSubnets []*net.IPNet
@@ -147,6 +158,9 @@ type Session struct {
 	// vipGenerator generates virtual IPs for a given range.
 	vipGenerator vip.Generator
 
+	// vip6Generator generates virtual IPv6 addresses for AAAA records that must be translated.
+	vip6Generator vip.Generator
+
 	// closing is set during shutdown and can have the values:
 	//   0 = running
 	//   1 = closing
@@ -355,6 +369,7 @@ func newSession(c context.Context, mi *rpc.OutboundInfo, mc connector.ManagerPro
 
 	s := &Session{
 		handlers:           tunnel.NewPool(),
+		connStats:          tunnel.NewConnStatsRegistry(),
 		rndSource:          rand.NewSource(time.Now().UnixNano()),
 		session:            mi.Session,
 		namespace:          mi.Namespace,
@@ -385,7 +400,7 @@ func newSession(c context.Context, mi *rpc.OutboundInfo, mc connector.ManagerPro
 	}
 	dlog.Infof(c, "allow-conflicting subnets %v", s.allowConflictingSubnets)
 
-	s.dnsServer = dns.NewServer(mi.Dns, s.clusterLookup)
+	s.dnsServer = dns.NewServer(mi.Dns, s.clusterLookup, s.clusterExchange)
 	s.SetSearchPath(c, nil, nil)
 	return s, nil
 }
@@ -415,21 +430,92 @@ func (s *Session) clusterLookup(ctx context.Context, q *dns2.Question) (dnsproxy
 		s.dnsFailures++
 		return nil, dns2.RcodeServerFailure, err
 	}
-	if len(s.localTranslationSubnets) > 0 {
-		for _, rr := range answer {
-			switch rr := rr.(type) {
-			case *dns2.A:
-				rr.A, err = s.maybeGetVirtualIP(ctx, rr.A)
-			case *dns2.AAAA:
-				rr.AAAA, err = s.maybeGetVirtualIP(ctx, rr.AAAA)
+	if answer, err = s.translateAnswer(ctx, answer); err != nil {
+		return nil, dns2.RcodeServerFailure, err
+	}
+	return answer, rCode, nil
+}
+
+// translateAnswer replaces the addresses in the given answer that belong to a subnet that must be translated
+// with virtual IPs. An AAAA record that needs translation when no virtual IPv6 subnet is configured is
+// dropped, so that a dual-stack name still resolves using its A record.
+func (s *Session) translateAnswer(ctx context.Context, answer dnsproxy.RRs) (dnsproxy.RRs, error) {
+	if len(s.localTranslationSubnets) == 0 {
+		return answer, nil
+	}
+	translated := make(dnsproxy.RRs, 0, len(answer))
+	for _, rr := range answer {
+		var err error
+		switch rr := rr.(type) {
+		case *dns2.A:
+			rr.A, err = s.maybeGetVirtualIP(ctx, rr.A)
+		case *dns2.AAAA:
+			var ip net.IP
+			if ip, err = s.maybeGetVirtualIP(ctx, rr.AAAA); errors.Is(err, errNoVIP6Subnet) {
+				dlog.Debugf(ctx, "dropping AAAA %s for %s: %v", rr.AAAA, rr.Hdr.Name, err)
+				continue
 			}
-			if err != nil {
-				rCode = dns2.RcodeServerFailure
-				break
+			rr.AAAA = ip
+		}
+		if err != nil {
+			return nil, err
+		}
+		translated = append(translated, rr)
+	}
+	return translated, nil
+}
+
+// clusterExchange sends the given DNS message to the DNS server at the given address through the tunnel,
+// and returns the reply.
+func (s *Session) clusterExchange(ctx context.Context, addr *net.UDPAddr, msg *dns2.Msg) (*dns2.Msg, error) {
+	tunnelStreams := s.tunnelStreams.Load()
+	if tunnelStreams == nil {
+		return nil, errors.New("the tunnel to the cluster is not yet established")
+	}
+	data, err := msg.Pack()
+	if err != nil {
+		return nil, err
+	}
+	src := net.IPv6loopback
+	if addr.IP.To4() != nil {
+		src = net.IP{127, 0, 0, 1}
+	}
+	// Use ports in the ephemeral range, so that concurrent exchanges get unique connection IDs.
+	srcPort := uint16(0x8000 | atomic.AddUint32(&s.dnsExchangePort, 1)&0x7fff)
+	id := tunnel.NewConnID(ipproto.UDP, src, addr.IP, srcPort, uint16(addr.Port))
+	dlog.Debugf(ctx, "Exchange %s %q with %s", dns2.TypeToString[msg.Question[0].Qtype], msg.Question[0].Name, addr)
+
+	// The stream ends when its context is cancelled.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := (*tunnelStreams)(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = stream.CloseSend(ctx)
+	}()
+	if err = stream.Send(ctx, tunnel.NewMessage(tunnel.Normal, data)); err != nil {
+		return nil, err
+	}
+	for {
+		m, err := stream.Receive(ctx)
+		if err != nil {
+			return nil, client.CheckTimeout(ctx, err)
+		}
+		switch m.Code() {
+		case tunnel.Normal:
+			reply := new(dns2.Msg)
+			if err = reply.Unpack(m.Payload()); err != nil {
+				return nil, err
 			}
+			if reply.Id == msg.Id {
+				return reply, nil
+			}
+		case tunnel.DialReject, tunnel.Disconnect:
+			return nil, fmt.Errorf("DNS server %s is unreachable", addr)
 		}
 	}
-	return answer, rCode, err
 }
 
 func (s *Session) maybeGetVirtualIP(ctx context.Context, destinationIP net.IP) (net.IP, error) {
@@ -454,8 +540,19 @@ func (s *Session) maybeGetVirtualIP(ctx context.Context, destinationIP net.IP) (
 	return destinationIP, err
 }
 
+var errNoVIP6Subnet = errors.New("no virtual IPv6 subnet is configured") //nolint:gochecknoglobals // constant
+
+// nextVirtualIP returns a new virtual IP of the same IP family as the given destination IP, so that
+// an A record gets an IPv4 address and an AAAA record gets an IPv6 address.
 func (s *Session) nextVirtualIP(workload string, destinationIP net.IP) (net.IP, error) {
-	vip, err := s.vipGenerator.Next()
+	gen := s.vipGenerator
+	if destinationIP.To4() == nil {
+		if s.vip6Generator == nil {
+			return nil, fmt.Errorf("unable to translate %s: %w", destinationIP, errNoVIP6Subnet)
+		}
+		gen = s.vip6Generator
+	}
+	vip, err := gen.Next()
 	if err != nil {
 		return nil, err
 	}
@@ -633,9 +730,7 @@ func (s *Session) createSubnetForDNSOnly(ctx context.Context, mgrInfo *manager.C
 
 	// Avoid the service subnet. It might be mapped with iptables (if running bare-metal) and
 	// hence invisible when listing known routes.
-	if mgrInfo.ServiceSubnet != nil {
-		avoid = append(avoid, iputil.IPNetFromRPC(mgrInfo.ServiceSubnet))
-	}
+	avoid = append(avoid, serviceSubnets(mgrInfo)...)
 
 	// Avoid the pod subnets. They are probably visible as known routes, but we add them to
 	// the avoid table to be sure.
@@ -679,18 +774,17 @@ func (s *Session) onClusterInfo(ctx context.Context, mgrInfo *manager.ClusterInf
 		mgrInfo.Routing = &manager.Routing{}
 	}
 
-	s.serviceSubnet = nil
+	s.serviceSubnets = nil
 	s.podSubnets = nil
 
 	var subnets []*net.IPNet
 	if s.proxyClusterSvcs {
-		if mgrInfo.ServiceSubnet != nil {
-			cidr := iputil.IPNetFromRPC(mgrInfo.ServiceSubnet)
+		for _, cidr := range serviceSubnets(mgrInfo) {
 			if s.shouldProxySubnet(ctx, "service", cidr) {
 				dlog.Infof(ctx, "Adding service subnet %s", cidr)
 				subnets = append(subnets, cidr)
 			}
-			s.serviceSubnet = cidr
+			s.serviceSubnets = append(s.serviceSubnets, cidr)
 		}
 	}
 
@@ -708,6 +802,10 @@ func (s *Session) onClusterInfo(ctx context.Context, mgrInfo *manager.ClusterInf
 	if s.vipGenerator != nil {
 		subnets = append(subnets, s.vipGenerator.Subnet())
 		dlog.Debugf(ctx, "Adding VIP subnet %q to TUN-device", s.vipGenerator.Subnet().String())
+		if s.vip6Generator != nil {
+			subnets = append(subnets, s.vip6Generator.Subnet())
+			dlog.Debugf(ctx, "Adding VIP subnet %q to TUN-device", s.vip6Generator.Subnet().String())
+		}
 		s.consolidateProxyViaWorkloads(ctx)
 	}
 
@@ -750,9 +848,15 @@ func (s *Session) onClusterInfo(ctx context.Context, mgrInfo *manager.ClusterInf
 		dnsRouted = true
 	}
 
+	tunnelStreams := s.tunnelStreams.Load()
+	if tunnelStreams == nil {
+		sc := s.streamCreator(ctx)
+		tunnelStreams = &sc
+		s.tunnelStreams.Store(tunnelStreams)
+	}
 	if len(subnets) > 0 && s.tunVif == nil {
 		var err error
-		if s.tunVif, err = vif.NewTunnelingDevice(ctx, s.streamCreator()); err != nil {
+		if s.tunVif, err = vif.NewTunnelingDevice(ctx, *tunnelStreams); err != nil {
 			return fmt.Errorf("NewTunnelVIF: %w", err)
 		}
 	}
@@ -828,6 +932,18 @@ func computeNeverProxyOverrides(ctx context.Context, subnets, nvp []*net.IPNet)
 	return subnet.Unique(proxy), neverProxy, neverProxyOverrides
 }
 
+// serviceSubnets returns the service subnets of the given ClusterInfo. A traffic-manager that
+// predates dual-stack support only reports one.
+func serviceSubnets(mgrInfo *manager.ClusterInfo) []*net.IPNet {
+	if len(mgrInfo.ServiceSubnets) > 0 {
+		return iputil.ConvertSubnets(mgrInfo.ServiceSubnets)
+	}
+	if mgrInfo.ServiceSubnet != nil {
+		return []*net.IPNet{iputil.IPNetFromRPC(mgrInfo.ServiceSubnet)}
+	}
+	return nil
+}
+
 func validateSubnets(name string, sns []*manager.IPNet, allowLoopback func() bool) ([]*net.IPNet, error) {
 	ns := iputil.ConvertSubnets(sns)
 	if len(ns) == 0 {
@@ -1112,11 +1228,22 @@ func (s *Session) activateProxyViaWorkloads(ctx context.Context) error {
 	if sl == 0 {
 		return nil
 	}
-	_, vipSubnet, err := net.ParseCIDR(client.GetConfig(ctx).Cluster().VirtualIPSubnet)
+	cc := client.GetConfig(ctx).Cluster()
+	_, vipSubnet, err := net.ParseCIDR(cc.VirtualIPSubnet)
 	if err != nil {
 		return fmt.Errorf("unable to parse configuration value cluster.virtualIPSubnet: %w", err)
 	}
 	s.vipGenerator = vip.NewGenerator(vipSubnet)
+	if cc.VirtualIPv6Subnet != "" {
+		_, vip6Subnet, err := net.ParseCIDR(cc.VirtualIPv6Subnet)
+		if err != nil {
+			return fmt.Errorf("unable to parse configuration value cluster.virtualIPv6Subnet: %w", err)
+		}
+		if vip6Subnet.IP.To4() != nil {
+			return fmt.Errorf("configuration value cluster.virtualIPv6Subnet %s is not an IPv6 subnet", vip6Subnet)
+		}
+		s.vip6Generator = vip.NewGenerator(vip6Subnet)
+	}
 	s.localTranslationTable = xsync.NewMapOf[iputil.IPKey, net.IP]()
 	s.virtualIPs = xsync.NewMapOf[iputil.IPKey, agentVIP]()
 	s.localTranslationSubnets = make([]agentSubnet, sl)
@@ -1145,10 +1272,8 @@ func (s *Session) consolidateProxyViaWorkloads(ctx context.Context) []string {
 			desiredVips[pvx.Workload] = append(desiredVips[pvx.Workload], s.podSubnets...)
 			snCount += len(s.podSubnets)
 		case "service":
-			if s.serviceSubnet != nil {
-				desiredVips[pvx.Workload] = append(desiredVips[pvx.Workload], s.serviceSubnet)
-				snCount++
-			}
+			desiredVips[pvx.Workload] = append(desiredVips[pvx.Workload], s.serviceSubnets...)
+			snCount += len(s.serviceSubnets)
 		default:
 			_, sn, err := net.ParseCIDR(pvx.Subnet)
 			if err != nil {
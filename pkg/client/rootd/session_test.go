@@ -0,0 +1,138 @@
+package rootd
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	dns2 "github.com/miekg/dns"
+	"github.com/puzpuzpuz/xsync/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/rootd/vip"
+	"github.com/telepresenceio/telepresence/v2/pkg/dnsproxy"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+func TestSession_TranslateAnswer(t *testing.T) {
+	cidr := func(s string) *net.IPNet {
+		_, sn, err := net.ParseCIDR(s)
+		require.NoError(t, err)
+		return sn
+	}
+	answer := func() dnsproxy.RRs {
+		return dnsproxy.RRs{
+			&dns2.A{Hdr: dnsproxy.NewHeader("echo.", dns2.TypeA), A: net.IP{10, 0, 0, 1}},
+			&dns2.AAAA{Hdr: dnsproxy.NewHeader("echo.", dns2.TypeAAAA), AAAA: net.ParseIP("fd00::1")},
+			&dns2.AAAA{Hdr: dnsproxy.NewHeader("echo.", dns2.TypeAAAA), AAAA: net.ParseIP("fd01::1")},
+		}
+	}
+	tests := []struct {
+		name    string
+		vip6    *net.IPNet
+		want4   *net.IPNet
+		want6   []*net.IPNet
+		wantLen int
+	}{
+		{
+			name:  "dual-stack translation",
+			vip6:  cidr("fd99::/64"),
+			want4: cidr("211.55.0.0/16"),
+			want6: []*net.IPNet{cidr("fd99::/64"), cidr("fd01::1/128")},
+		},
+		{
+			// The AAAA record that needs translation is dropped, so that the name resolves using its A record.
+			name:  "no virtual IPv6 subnet",
+			want4: cidr("211.55.0.0/16"),
+			want6: []*net.IPNet{cidr("fd01::1/128")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Session{
+				localTranslationSubnets: []agentSubnet{
+					{IPNet: *cidr("10.0.0.0/16"), workload: "echo"},
+					{IPNet: *cidr("fd00::/64"), workload: "echo"},
+				},
+				localTranslationTable: xsync.NewMapOf[iputil.IPKey, net.IP](),
+				virtualIPs:            xsync.NewMapOf[iputil.IPKey, agentVIP](),
+				vipGenerator:          vip.NewGenerator(cidr("211.55.0.0/16")),
+			}
+			if tt.vip6 != nil {
+				s.vip6Generator = vip.NewGenerator(tt.vip6)
+			}
+			rrs, err := s.translateAnswer(dlog.NewTestContext(t, false), answer())
+			require.NoError(t, err)
+			require.Len(t, rrs, 1+len(tt.want6))
+			a, ok := rrs[0].(*dns2.A)
+			require.True(t, ok)
+			assert.True(t, tt.want4.Contains(a.A), a.A)
+			for i, sn := range tt.want6 {
+				aaaa, ok := rrs[i+1].(*dns2.AAAA)
+				require.True(t, ok)
+				assert.True(t, sn.Contains(aaaa.AAAA), aaaa.AAAA)
+			}
+
+			// The virtual IPs lead to the workload and the original address.
+			v, ok := s.virtualIPs.Load(iputil.IPKey(a.A))
+			require.True(t, ok)
+			assert.Equal(t, agentVIP{workload: "echo", destinationIP: net.IP{10, 0, 0, 1}}, v)
+		})
+	}
+}
+
+func TestSession_ClusterExchange(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+	s := &Session{}
+	addr := &net.UDPAddr{IP: net.IP{10, 96, 0, 10}, Port: 53}
+	query := func() (*dns2.Msg, error) {
+		q := new(dns2.Msg)
+		q.SetQuestion("echo.default.svc.cluster.local.", dns2.TypeA)
+		return s.clusterExchange(ctx, addr, q)
+	}
+
+	_, err := query()
+	require.ErrorContains(t, err, "not yet established")
+
+	// The DNS server in the cluster answers each query.
+	var sc tunnel.StreamCreator = func(ctx context.Context, id tunnel.ConnID) (tunnel.Stream, error) {
+		from, to := tunnel.NewPipe(id, "session-1")
+		go func() {
+			m, err := to.Receive(ctx)
+			if err != nil {
+				return
+			}
+			q := new(dns2.Msg)
+			if q.Unpack(m.Payload()) != nil {
+				return
+			}
+			r := new(dns2.Msg)
+			r.SetReply(q)
+			r.Answer = []dns2.RR{&dns2.A{Hdr: dnsproxy.NewHeader(q.Question[0].Name, dns2.TypeA), A: net.IP{10, 0, 0, 1}}}
+			data, _ := r.Pack()
+			_ = to.Send(ctx, tunnel.NewMessage(tunnel.Normal, data))
+		}()
+		return from, nil
+	}
+
+	// Exchanges run concurrently with the arrival of the cluster info that establishes the tunnel.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = query()
+		}()
+	}
+	s.tunnelStreams.Store(&sc)
+	wg.Wait()
+
+	r, err := query()
+	require.NoError(t, err)
+	require.Len(t, r.Answer, 1)
+	assert.Equal(t, net.IP{10, 0, 0, 1}, r.Answer[0].(*dns2.A).A.To4())
+}
@@ -20,7 +20,9 @@ func (s *Session) isForDNS(ip net.IP, port uint16) bool {
 	return s.remoteDnsIP != nil && port == 53 && s.remoteDnsIP.Equal(ip)
 }
 
-func (s *Session) streamCreator() tunnel.StreamCreator {
+func (s *Session) streamCreator(ctx context.Context) tunnel.StreamCreator {
+	mgrMux := tunnel.NewMux(ctx, tunnel.ManagerProxyProvider(s.managerClient), s.session.SessionId)
+	compression := client.GetConfig(ctx).Tunnel().Compression
 	return func(c context.Context, id tunnel.ConnID) (tunnel.Stream, error) {
 		p := id.Protocol()
 		if p == ipproto.UDP {
@@ -44,8 +46,8 @@ func (s *Session) streamCreator() tunnel.StreamCreator {
 			}
 		}
 
-		var err error
-		var tp tunnel.Provider
+		var tp tunnel.StreamOpener
+		servedBy := "traffic-manager"
 		if a, ok := s.getAgentVIP(id); ok {
 			// s.agentClients is never nil when agentVIPs are used.
 			tp = s.agentClients.GetWorkloadClient(a.workload)
@@ -55,22 +57,23 @@ func (s *Session) streamCreator() tunnel.StreamCreator {
 			// Replace the virtual IP with the original destination IP. This will ensure that the agent
 			// dials the original destination when the tunnel is established.
 			id = tunnel.NewConnID(id.Protocol(), id.Source(), a.destinationIP, id.SourcePort(), id.DestinationPort())
+			servedBy = fmt.Sprint(tp)
 			dlog.Debugf(c, "Opening proxy-via %s tunnel for id %s", a.workload, id)
 		} else {
 			if tp = s.getAgentClient(id.Destination()); tp != nil {
+				servedBy = fmt.Sprint(tp)
 				dlog.Debugf(c, "Opening traffic-agent tunnel for id %s", id)
 			} else {
-				tp = tunnel.ManagerProxyProvider(s.managerClient)
+				tp = mgrMux
 				dlog.Debugf(c, "Opening traffic-manager tunnel for id %s", id)
 			}
 		}
-		ct, err := tp.Tunnel(c)
+		tc := client.GetConfig(c).Timeouts()
+		ts, err := tp.OpenStream(tunnel.WithCompression(c, compression), id, tc.Get(client.TimeoutRoundtripLatency), tc.Get(client.TimeoutEndpointDial))
 		if err != nil {
 			return nil, err
 		}
-
-		tc := client.GetConfig(c).Timeouts()
-		return tunnel.NewClientStream(c, ct, id, s.session.SessionId, tc.Get(client.TimeoutRoundtripLatency), tc.Get(client.TimeoutEndpointDial))
+		return s.connStats.Track(c, ts, servedBy, true), nil
 	}
 }
 
@@ -81,7 +84,7 @@ func (s *Session) getAgentVIP(id tunnel.ConnID) (a agentVIP, ok bool) {
 	return
 }
 
-func (s *Session) getAgentClient(ip net.IP) (pvd tunnel.Provider) {
+func (s *Session) getAgentClient(ip net.IP) (pvd tunnel.StreamOpener) {
 	if s.agentClients != nil {
 		pvd = s.agentClients.GetClient(ip)
 	}
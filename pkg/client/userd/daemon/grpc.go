@@ -28,6 +28,7 @@ import (
 	"github.com/telepresenceio/telepresence/rpc/v2/daemon"
 	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/k8sclient"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/logging"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/scout"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/socket"
@@ -317,21 +318,35 @@ func (s *service) RemoveIntercept(c context.Context, rr *manager.RemoveIntercept
 
 func (s *service) UpdateIntercept(c context.Context, rr *manager.UpdateInterceptRequest) (result *manager.InterceptInfo, err error) {
 	err = s.WithSession(c, "UpdateIntercept", func(c context.Context, session userd.Session) error {
-		result, err = session.ManagerClient().UpdateIntercept(c, rr)
+		result, err = session.UpdateIntercept(c, rr)
 		return err
 	})
 	return
 }
 
 func (s *service) AddInterceptor(ctx context.Context, interceptor *rpc.Interceptor) (*empty.Empty, error) {
-	return &empty.Empty{}, s.WithSession(ctx, "AddInterceptor", func(_ context.Context, session userd.Session) error {
-		return session.AddInterceptor(interceptor.InterceptId, interceptor)
+	return &empty.Empty{}, s.WithSession(ctx, "AddInterceptor", func(ctx context.Context, session userd.Session) error {
+		return session.AddInterceptor(ctx, interceptor.InterceptId, interceptor)
 	})
 }
 
 func (s *service) RemoveInterceptor(ctx context.Context, interceptor *rpc.Interceptor) (*empty.Empty, error) {
-	return &empty.Empty{}, s.WithSession(ctx, "RemoveInterceptor", func(_ context.Context, session userd.Session) error {
-		return session.RemoveInterceptor(interceptor.InterceptId)
+	return &empty.Empty{}, s.WithSession(ctx, "RemoveInterceptor", func(ctx context.Context, session userd.Session) error {
+		return session.RemoveInterceptor(ctx, interceptor.InterceptId)
+	})
+}
+
+func (s *service) ListAllIntercepts(ctx context.Context, _ *empty.Empty) (result *manager.AllInterceptsResponse, err error) {
+	err = s.WithSession(ctx, "ListAllIntercepts", func(ctx context.Context, session userd.Session) error {
+		result, err = session.ManagerClient().ListAllIntercepts(ctx, session.SessionInfo())
+		return err
+	})
+	return
+}
+
+func (s *service) EvictIntercept(ctx context.Context, er *manager.EvictInterceptRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.WithSession(ctx, "EvictIntercept", func(ctx context.Context, session userd.Session) error {
+		return session.EvictIntercept(ctx, er.InterceptId)
 	})
 }
 
@@ -358,6 +373,64 @@ func (s *service) WatchWorkloads(wr *rpc.WatchWorkloadsRequest, stream rpc.Conne
 	return session.WatchWorkloads(sessionCtx, wr, stream)
 }
 
+func (s *service) WatchConnectionStats(wr *rpc.WatchConnectionStatsRequest, stream rpc.Connector_WatchConnectionStatsServer) error {
+	if wr.AllSessions && !wr.TrafficManager {
+		return status.Error(codes.InvalidArgument, "all_sessions can only be used together with traffic_manager")
+	}
+	var sessionCtx context.Context
+	var session userd.Session
+	err := s.WithSession(stream.Context(), "WatchConnectionStats", func(c context.Context, s userd.Session) error {
+		session, sessionCtx = s, c
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// End when either the session or the call ends.
+	ctx, cancel := context.WithCancel(sessionCtx)
+	defer cancel()
+	defer context.AfterFunc(stream.Context(), cancel)()
+
+	rq := &manager.ConnectionStatsRequest{
+		Session:     session.SessionInfo(),
+		Interval:    wr.Interval,
+		AllSessions: wr.AllSessions,
+	}
+	if wr.AllSessions {
+		// The traffic-manager verifies that the user is allowed to see the connections of other clients.
+		if rq.Token, err = k8sclient.BearerToken(ctx, session.GetRestConfig()); err != nil {
+			return err
+		}
+	}
+	var recv func() (*manager.ConnectionStatsSnapshot, error)
+	if wr.TrafficManager {
+		ms, err := session.ManagerClient().WatchConnectionStats(ctx, rq)
+		if err != nil {
+			return err
+		}
+		recv = ms.Recv
+	} else {
+		rs, err := session.RootDaemon().WatchConnectionStats(ctx, rq)
+		if err != nil {
+			return err
+		}
+		recv = rs.Recv
+	}
+	for {
+		snapshot, err := recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err = stream.Send(snapshot); err != nil {
+			return err
+		}
+	}
+}
+
 func (s *service) Uninstall(c context.Context, ur *rpc.UninstallRequest) (result *common.Result, err error) {
 	err = s.WithSession(c, "Uninstall", func(c context.Context, session userd.Session) error {
 		result, err = session.Uninstall(c, ur)
@@ -555,7 +628,9 @@ func (s *service) GetClusterSubnets(ctx context.Context, _ *empty.Empty) (cs *rp
 				}
 				return err
 			}
-			if mgrInfo.ServiceSubnet != nil {
+			if len(mgrInfo.ServiceSubnets) > 0 {
+				svcSubnets = append(svcSubnets, mgrInfo.ServiceSubnets...)
+			} else if mgrInfo.ServiceSubnet != nil {
 				svcSubnets = append(svcSubnets, mgrInfo.ServiceSubnet)
 			}
 			podSubnets = append(podSubnets, mgrInfo.PodSubnets...)
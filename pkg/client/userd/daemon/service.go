@@ -75,6 +75,10 @@ type service struct {
 	sessionQuitting int32 // atomic boolean. True if non-zero.
 	sessionLock     sync.RWMutex
 
+	// sessionRefreshing is an atomic boolean that is true (non-zero) while an expired session
+	// is cancelled in order to be replaced by a new one.
+	sessionRefreshing int32
+
 	// These are used to communicate between the various goroutines.
 	connectRequest  chan *rpc.ConnectRequest // server-grpc.connect() -> connectWorker
 	connectResponse chan *rpc.ConnectInfo    // connectWorker -> server-grpc.connect()
@@ -241,7 +245,8 @@ func (s *service) startSession(ctx context.Context, cr *rpc.ConnectRequest, wg *
 		}
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	cancel := func() { cancelCause(nil) }
 	ctx = userd.WithService(ctx, s.self)
 
 	daemonID, err := daemon.NewIdentifier(cr.Name, config.Context, config.Namespace, proc.RunningInContainer())
@@ -267,7 +272,12 @@ func (s *service) startSession(ctx context.Context, cr *rpc.ConnectRequest, wg *
 	s.session = session
 	s.sessionContext = userd.WithSession(ctx, session)
 	s.sessionCancel = func() {
-		cancel()
+		if atomic.LoadInt32(&s.sessionRefreshing) != 0 {
+			// The session is replaced by a new one, so clients must not consider the daemon gone.
+			cancelCause(daemon.ErrRetainInfo)
+		} else {
+			cancel()
+		}
 		<-session.Done()
 	}
 
@@ -290,7 +300,9 @@ func (s *service) startSession(ctx context.Context, cr *rpc.ConnectRequest, wg *
 			if errors.Is(err, trafficmgr.ErrSessionExpired) {
 				// Session has expired. We need to cancel the owner session and reconnect
 				dlog.Info(ctx, "refreshing session")
+				atomic.StoreInt32(&s.sessionRefreshing, 1)
 				s.cancelSession()
+				atomic.StoreInt32(&s.sessionRefreshing, 0)
 				select {
 				case <-ctx.Done():
 				case s.connectRequest <- cr:
@@ -10,6 +10,7 @@ import (
 
 	"github.com/blang/semver"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/datawire/dlib/dlog"
@@ -20,6 +21,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/client/k8sclient"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/userd"
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 const (
@@ -35,6 +37,9 @@ type Cluster struct {
 	// Main
 	ki kubernetes.Interface
 
+	// di is used for workloads of custom kinds, such as Argo Rollouts
+	di dynamic.Interface
+
 	// nsLock protects namespaceWatcherSnapshot, currentMappedNamespaces and namespaceListeners
 	nsLock sync.Mutex
 
@@ -126,11 +131,16 @@ func NewCluster(c context.Context, kubeFlags *client.Kubeconfig, namespaces []st
 	if err != nil {
 		return nil, err
 	}
+	di, err := dynamic.NewForConfig(rs)
+	if err != nil {
+		return nil, err
+	}
 	c = k8sapi.WithK8sInterface(c, cs)
 
 	ret := &Cluster{
 		Kubeconfig: kubeFlags,
 		ki:         cs,
+		di:         di,
 	}
 
 	cfg := client.GetConfig(c)
@@ -243,5 +253,5 @@ func (kc *Cluster) GetManagerInstallId(ctx context.Context) string {
 }
 
 func (kc *Cluster) WithK8sInterface(c context.Context) context.Context {
-	return k8sapi.WithK8sInterface(c, kc.ki)
+	return workload.WithDynamicInterface(k8sapi.WithK8sInterface(c, kc.ki), kc.di)
 }
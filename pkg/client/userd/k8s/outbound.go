@@ -124,7 +124,7 @@ func canAccessNS(ctx context.Context, namespace string) bool {
 			Verb:     "watch",
 		},
 	}
-	for _, r := range []string{"deployments", "replicasets", "statefulsets"} {
+	for _, r := range []string{"deployments", "replicasets", "statefulsets", "daemonsets"} {
 		for _, v := range []string{"get", "watch", "list"} {
 			ras = append(ras, &auth.ResourceAttributes{
 				Group:    "apps",
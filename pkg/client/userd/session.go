@@ -48,10 +48,12 @@ type Session interface {
 	InterceptProlog(context.Context, *manager.CreateInterceptRequest) *rpc.InterceptResult
 	InterceptEpilog(context.Context, *rpc.CreateInterceptRequest, *rpc.InterceptResult) *rpc.InterceptResult
 	RemoveIntercept(context.Context, string) error
+	UpdateIntercept(context.Context, *manager.UpdateInterceptRequest) (*manager.InterceptInfo, error)
 	NewCreateInterceptRequest(*manager.InterceptSpec) *manager.CreateInterceptRequest
 
-	AddInterceptor(string, *rpc.Interceptor) error
-	RemoveInterceptor(string) error
+	AddInterceptor(context.Context, string, *rpc.Interceptor) error
+	RemoveInterceptor(context.Context, string) error
+	EvictIntercept(context.Context, string) error
 	ClearIntercepts(context.Context) error
 
 	GetInterceptInfo(string) *manager.InterceptInfo
@@ -0,0 +1,94 @@
+package trafficmgr
+
+import (
+	"context"
+	"sync"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
+)
+
+// customWatcher watches the workloads of a custom kind, such as Argo Rollouts, in a namespace.
+type customWatcher struct {
+	kind      workload.CustomKind
+	namespace string
+	informer  cache.SharedIndexInformer
+	cancel    context.CancelFunc
+}
+
+// newCustomWatchers returns a started watcher for each custom workload kind that the cluster serves, and
+// that the user is allowed to list in the given namespace. Kinds that the cluster doesn't serve are
+// ignored so that they don't prevent the namespace watcher from syncing.
+func newCustomWatchers(c context.Context, namespace string, cond *sync.Cond) []*customWatcher {
+	di := workload.GetDynamicInterface(c)
+	if di == nil {
+		return nil
+	}
+	var cws []*customWatcher
+	for _, ck := range workload.GetCustomKinds(c) {
+		if _, err := di.Resource(ck.GroupVersionResource()).Namespace(namespace).List(c, meta.ListOptions{Limit: 1}); err != nil {
+			dlog.Debugf(c, "not watching %s in namespace %s: %v", ck, namespace, err)
+			continue
+		}
+		cws = append(cws, newCustomWatcher(c, di, ck, namespace, cond))
+	}
+	return cws
+}
+
+func newCustomWatcher(c context.Context, di dynamic.Interface, ck workload.CustomKind, namespace string, cond *sync.Cond) *customWatcher {
+	c, cancel := context.WithCancel(c)
+	inf := dynamicinformer.NewFilteredDynamicInformer(di, ck.GroupVersionResource(), namespace, 0, cache.Indexers{}, nil).Informer()
+	_, err := inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(any) { cond.Broadcast() },
+		UpdateFunc: func(oldObj, newObj any) {
+			oo, ok1 := oldObj.(runtime.Object)
+			no, ok2 := newObj.(runtime.Object)
+			if !(ok1 && ok2 && workloadEquals(oo, no)) {
+				cond.Broadcast()
+			}
+		},
+		DeleteFunc: func(any) { cond.Broadcast() },
+	})
+	if err != nil {
+		dlog.Errorf(c, "unable to add event handler for %s in namespace %s: %v", ck, namespace, err)
+	}
+	go inf.Run(c.Done())
+	return &customWatcher{kind: ck, namespace: namespace, informer: inf, cancel: cancel}
+}
+
+func (cw *customWatcher) hasSynced() bool {
+	return cw.informer.HasSynced()
+}
+
+// list returns all workloads in the current snapshot.
+func (cw *customWatcher) list() []k8sapi.Workload {
+	objs := cw.informer.GetStore().List()
+	wls := make([]k8sapi.Workload, 0, len(objs))
+	for _, o := range objs {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			wls = append(wls, workload.Custom(cw.kind, u))
+		}
+	}
+	return wls
+}
+
+// get returns the workload with the given name from the current snapshot.
+func (cw *customWatcher) get(name string) (k8sapi.Workload, bool) {
+	o, found, err := cw.informer.GetStore().GetByKey(cw.namespace + "/" + name)
+	if err != nil || !found {
+		return nil, false
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false
+	}
+	return workload.Custom(cw.kind, u), true
+}
@@ -3,6 +3,7 @@ package trafficmgr
 import (
 	"context"
 
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
 )
 
@@ -16,5 +17,6 @@ func (s *session) _dialRequestWatcher(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	ctx = tunnel.WithCompression(ctx, client.GetConfig(ctx).Tunnel().Compression)
 	return tunnel.DialWaitLoop(ctx, tunnel.ManagerProvider(s.managerClient), dialerStream, s.sessionInfo.SessionId)
 }
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +15,7 @@ import (
 
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/datawire/dlib/dgroup"
@@ -25,6 +27,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/agentconfig"
 	"github.com/telepresenceio/telepresence/v2/pkg/client"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/docker"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/k8sclient"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/remotefs"
 	"github.com/telepresenceio/telepresence/v2/pkg/client/userd"
 	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
@@ -68,6 +71,9 @@ type intercept struct {
 
 	// Use bridged ftp/sftp mount through this local port
 	localMountPort int32
+
+	// recorder records the intercepted traffic when the intercept was created with a record file.
+	recorder *recorder
 }
 
 // interceptResult is what gets written to the awaitIntercept's waitCh channel when the
@@ -89,6 +95,9 @@ type awaitIntercept struct {
 	// the mount to take place in a host
 	mountPort int32
 
+	// recorder is optional and writes the recording of the intercepted traffic
+	recorder *recorder
+
 	waitCh chan<- interceptResult
 }
 
@@ -107,6 +116,13 @@ type podInterceptKey struct {
 type podIntercept struct {
 	wg        sync.WaitGroup
 	cancelPod context.CancelFunc
+
+	// The port forwards are restarted without affecting the mounts when the local
+	// ports of the intercept are updated.
+	podCtx         context.Context
+	fwdWg          sync.WaitGroup
+	cancelForwards context.CancelFunc
+	localPorts     []string
 }
 
 // podIntercepts is what the traffic-manager is using to keep track of the chosen pods for
@@ -209,7 +225,12 @@ func (lpf *podIntercepts) start(ctx context.Context, ic *intercept, rd daemon.Da
 	lpf.snapshot[fk] = struct{}{}
 
 	// Already started?
-	if _, isLive := lpf.alivePods[fk]; isLive {
+	if lp, isLive := lpf.alivePods[fk]; isLive {
+		if !slices.Equal(lp.localPorts, ic.localPorts()) {
+			dlog.Infof(ctx, "Restarting port-forwards for %+v", fk)
+			lp.stopForwards()
+			lp.startForwards(ic)
+		}
 		return
 	}
 
@@ -233,17 +254,34 @@ func (lpf *podIntercepts) start(ctx context.Context, ic *intercept, rd daemon.Da
 	}
 
 	ctx, cancel := context.WithCancel(ic.ctx)
-	lp := &podIntercept{cancelPod: cancel}
+	lp := &podIntercept{cancelPod: cancel, podCtx: ctx}
 	if ic.shouldMount() {
 		ic.startMount(ctx, &ic.wg, &lp.wg)
 	}
-	if ic.shouldForward() {
-		ic.startForwards(ctx, &lp.wg)
-	}
+	lp.startForwards(ic)
 	dlog.Debugf(ctx, "Started mounts and port-forwards for %+v", fk)
 	lpf.alivePods[fk] = lp
 }
 
+// startForwards starts the port forwards of the given intercept and remembers its local ports.
+func (lp *podIntercept) startForwards(ic *intercept) {
+	lp.localPorts = slices.Clone(ic.localPorts())
+	if ic.shouldForward() {
+		var ctx context.Context
+		ctx, lp.cancelForwards = context.WithCancel(lp.podCtx)
+		ic.startForwards(ctx, &lp.fwdWg)
+	}
+}
+
+// stopForwards cancels the port forwards and waits for them to terminate.
+func (lp *podIntercept) stopForwards() {
+	if lp.cancelForwards != nil {
+		lp.cancelForwards()
+		lp.cancelForwards = nil
+	}
+	lp.fwdWg.Wait()
+}
+
 // initSnapshot prepares this instance for a new round of start calls followed by a cancelUnwanted.
 func (lpf *podIntercepts) initSnapshot() {
 	lpf.snapshot = make(map[podInterceptKey]struct{})
@@ -273,6 +311,7 @@ func (lpf *podIntercepts) cancelUnwanted(ctx context.Context) {
 				close(md)
 			}
 			lp.wg.Wait()
+			lp.fwdWg.Wait()
 		}
 	}
 }
@@ -305,6 +344,7 @@ func (s *session) watchInterceptsLoop(ctx context.Context) error {
 			}
 			return fmt.Errorf("manager.WatchIntercepts recv: %w", err)
 		}
+		s.forgetRemovedIntercepts(ctx, snapshot.Intercepts)
 		s.handleInterceptSnapshot(ctx, podIcepts, snapshot.Intercepts)
 	}
 	return nil
@@ -404,6 +444,13 @@ func (s *session) setCurrentIntercepts(ctx context.Context, iis []*manager.Inter
 			if aw, ok := s.interceptWaiters[ii.Spec.Name]; ok {
 				ic.ClientMountPoint = aw.mountPoint
 				ic.localMountPort = aw.mountPort
+				if rec := aw.recorder; rec != nil {
+					ic.recorder = rec
+					go func() {
+						<-ic.ctx.Done()
+						rec.close()
+					}()
+				}
 			}
 		}
 		intercepts[ii.Id] = ic
@@ -471,7 +518,8 @@ func (s *session) ensureNoInterceptConflict(ir *rpc.CreateInterceptRequest) *rpc
 		switch {
 		case iCept.Spec.Name == spec.Name:
 			return InterceptError(common.InterceptError_ALREADY_EXISTS, errcat.User.New(spec.Name))
-		case iCept.Spec.TargetPort == spec.TargetPort && iCept.Spec.TargetHost == spec.TargetHost:
+		// Ingests don't receive any traffic, so they never conflict with the local target of another intercept.
+		case !(iCept.Spec.Ingest || spec.Ingest) && iCept.Spec.TargetHost == spec.TargetHost && targetPortsOverlap(iCept.Spec, spec):
 			return &rpc.InterceptResult{
 				Error:         common.InterceptError_LOCAL_TARGET_IN_USE,
 				ErrorText:     spec.Name,
@@ -490,6 +538,28 @@ func (s *session) ensureNoInterceptConflict(ir *rpc.CreateInterceptRequest) *rpc
 	return nil
 }
 
+// targetPorts returns the primary target port of the given spec followed by the target ports of its additional ports.
+func targetPorts(spec *manager.InterceptSpec) []int32 {
+	tps := make([]int32, 1, 1+len(spec.AdditionalPorts))
+	tps[0] = spec.TargetPort
+	for _, ap := range spec.AdditionalPorts {
+		tps = append(tps, ap.TargetPort)
+	}
+	return tps
+}
+
+// targetPortsOverlap returns true if the two specs have at least one target port in common. A zero port is
+// ignored, because it is a port of an intercept that uses all ports and hasn't been prepared yet.
+func targetPortsOverlap(a, b *manager.InterceptSpec) bool {
+	bps := targetPorts(b)
+	for _, ap := range targetPorts(a) {
+		if ap != 0 && slices.Contains(bps, ap) {
+			return true
+		}
+	}
+	return false
+}
+
 // CanIntercept checks if it is possible to create an intercept for the given request. The intercept can proceed
 // only if the returned rpc.InterceptResult is nil. The returned runtime.Object is either nil, indicating a local
 // intercept, or the workload for the intercept.
@@ -548,6 +618,8 @@ func (s *session) AddIntercept(c context.Context, ir *rpc.CreateInterceptRequest
 	if iInfo == nil {
 		return &rpc.InterceptResult{Error: common.InterceptError_UNSPECIFIED}
 	}
+	// Retain the request as the user made it, so that it can be used when the intercept is restored.
+	saved := proto.Clone(ir).(*rpc.CreateInterceptRequest)
 
 	spec.Client = s.userAndHost
 	if spec.Mechanism == "" {
@@ -559,16 +631,35 @@ func (s *session) AddIntercept(c context.Context, ir *rpc.CreateInterceptRequest
 	// iInfo.preparedIntercept == nil means that we're using an older traffic-manager, incapable
 	// of using PrepareIntercept.
 	pi := iInfo.PreparedIntercept()
-	// Make spec port identifier unambiguous.
-	spec.ServiceName = pi.ServiceName
-	spec.ServicePortName = pi.ServicePortName
-	spec.ServicePort = pi.ServicePort
-	spec.Protocol = pi.Protocol
-	pti, err := iInfo.PortIdentifier()
-	if err != nil {
-		return InterceptError(common.InterceptError_MISCONFIGURED_WORKLOAD, err)
+	if pi.AgentName != "" {
+		// An intercept of a pod might be served by the agent of that pod's owner workload.
+		spec.Agent = pi.AgentName
+		spec.PodName = pi.PodName
+	}
+	if spec.Ingest {
+		// An ingest doesn't involve any service ports. It just needs to know what container it ingests.
+		spec.ContainerName = pi.ContainerName
+	} else {
+		// Make spec port identifier unambiguous.
+		spec.ServiceName = pi.ServiceName
+		spec.ServicePortName = pi.ServicePortName
+		spec.ServicePort = pi.ServicePort
+		spec.Protocol = pi.Protocol
+		pti, err := iInfo.PortIdentifier()
+		if err != nil {
+			return InterceptError(common.InterceptError_MISCONFIGURED_WORKLOAD, err)
+		}
+		spec.ServicePortIdentifier = pti.String()
+		spec.AdditionalPorts = pi.AdditionalPorts
+		if spec.AllPorts {
+			// Each service port is sent to the same port number on this workstation. The target ports weren't
+			// known when CanIntercept checked for conflicts, so check again.
+			spec.TargetPort = pi.ServicePort
+			if er := s.ensureNoInterceptConflict(ir); er != nil {
+				return er
+			}
+		}
 	}
-	spec.ServicePortIdentifier = pti.String()
 	result = iInfo.InterceptResult()
 
 	spec.ServiceUid = result.ServiceUid
@@ -583,11 +674,22 @@ func (s *session) AddIntercept(c context.Context, ir *rpc.CreateInterceptRequest
 
 	// The agent is in place and the traffic-manager has acknowledged the creation of the intercept. It
 	// should become active within a few seconds.
+	var rec *recorder
+	var err error
+	if ir.RecordFile != "" {
+		// The traffic-agent records the intercepted streams and sends the events to the recorder.
+		if rec, err = newRecorder(context.WithoutCancel(c), ir.RecordFile, spec.Name); err != nil {
+			return InterceptError(common.InterceptError_INTERNAL, err)
+		}
+		spec.RecordPort = int32(rec.port())
+	}
+
 	waitCh := make(chan interceptResult, 2) // Need a buffer because reply can come before we're reading the channel,
 	s.currentInterceptsLock.Lock()
 	s.interceptWaiters[spec.Name] = &awaitIntercept{
 		mountPoint: ir.MountPoint,
 		mountPort:  ir.LocalMountPort,
+		recorder:   rec,
 		waitCh:     waitCh,
 	}
 	s.currentInterceptsLock.Unlock()
@@ -603,6 +705,9 @@ func (s *session) AddIntercept(c context.Context, ir *rpc.CreateInterceptRequest
 	ii, err := mgrClient.CreateIntercept(c, self.NewCreateInterceptRequest(spec))
 	if err != nil {
 		dlog.Debugf(c, "manager responded to CreateIntercept with error %v", err)
+		if rec != nil {
+			rec.close()
+		}
 		return InterceptError(common.InterceptError_TRAFFIC_MANAGER_ERROR, err)
 	}
 
@@ -612,6 +717,9 @@ func (s *session) AddIntercept(c context.Context, ir *rpc.CreateInterceptRequest
 	defer func() {
 		if !success {
 			dlog.Debugf(c, "intercept %s failed to create, will remove...", ii.Spec.Name)
+			if rec != nil {
+				defer rec.close()
+			}
 
 			// Make an attempt to remove the created intercept using a time limited Context. Our
 			// context is already done.
@@ -647,6 +755,7 @@ func (s *session) AddIntercept(c context.Context, ir *rpc.CreateInterceptRequest
 			if er := self.InterceptEpilog(c, ir, result); er != nil {
 				return er
 			}
+			s.saveIntercept(c, saved, ic)
 			success = true // Prevent removal in deferred function
 			return result
 		}
@@ -664,6 +773,7 @@ func (s *session) InterceptEpilog(context.Context, *rpc.CreateInterceptRequest,
 // RemoveIntercept removes one intercept by name.
 func (s *session) RemoveIntercept(c context.Context, name string) error {
 	dlog.Debugf(c, "Removing intercept %s", name)
+	s.forgetIntercept(c, name)
 	ii := s.getInterceptByName(name)
 	if ii == nil {
 		dlog.Debugf(c, "Intercept %s was already removed", name)
@@ -672,6 +782,51 @@ func (s *session) RemoveIntercept(c context.Context, name string) error {
 	return s.removeIntercept(c, ii)
 }
 
+// EvictIntercept removes an intercept that is owned by another client. The traffic-manager verifies that
+// the user is allowed to update the intercepted workload.
+func (s *session) EvictIntercept(c context.Context, interceptID string) error {
+	dlog.Debugf(c, "Evicting intercept %s", interceptID)
+	token, err := k8sclient.BearerToken(c, s.GetRestConfig())
+	if err != nil {
+		return err
+	}
+	_, err = s.managerClient.EvictIntercept(c, &manager.EvictInterceptRequest{
+		Session:     s.SessionInfo(),
+		InterceptId: interceptID,
+		Token:       token,
+	})
+	return err
+}
+
+// UpdateIntercept updates the intercept with the given name in place. Mounts and environment are retained
+// and port forwards are restarted when the local ports change.
+func (s *session) UpdateIntercept(c context.Context, ur *manager.UpdateInterceptRequest) (*manager.InterceptInfo, error) {
+	dlog.Debugf(c, "Updating intercept %s", ur.Name)
+	ic := s.getInterceptByName(ur.Name)
+	if ic == nil {
+		return nil, grpcStatus.Errorf(grpcCodes.NotFound, "found no intercept named %s", ur.Name)
+	}
+	if ur.TargetPort != 0 && ur.TargetPort != ic.Spec.TargetPort {
+		s.currentInterceptsLock.Lock()
+		for _, oc := range s.currentIntercepts {
+			if oc.Id != ic.Id && oc.Spec.TargetPort == ur.TargetPort && oc.Spec.TargetHost == ic.Spec.TargetHost {
+				s.currentInterceptsLock.Unlock()
+				return nil, errcat.User.Newf("port %s is already in use by intercept %s",
+					iputil.JoinHostPort(ic.Spec.TargetHost, uint16(ur.TargetPort)), oc.Spec.Name)
+			}
+		}
+		s.currentInterceptsLock.Unlock()
+	}
+	ur.Session = s.SessionInfo()
+	ii, err := s.managerClient.UpdateIntercept(c, ur)
+	if err != nil {
+		return nil, err
+	}
+	// The ClientMountPoint is assigned by the client and never passed from the traffic-manager
+	ii.ClientMountPoint = ic.ClientMountPoint
+	return ii, nil
+}
+
 func (s *session) removeIntercept(c context.Context, ic *intercept) error {
 	name := ic.Spec.Name
 
@@ -711,24 +866,28 @@ func (s *session) removeIntercept(c context.Context, ic *intercept) error {
 
 // AddInterceptor associates the given intercept with a running process. This ensures that
 // the running process will be signalled when the intercept is removed.
-func (s *session) AddInterceptor(id string, ih *rpc.Interceptor) error {
-	s.currentInterceptsLock.Lock()
-	if ci, ok := s.currentIntercepts[id]; ok {
-		ci.pid = int(ih.Pid)
-		ci.containerName = ih.ContainerName
-	}
-	s.currentInterceptsLock.Unlock()
+func (s *session) AddInterceptor(ctx context.Context, id string, ih *rpc.Interceptor) error {
+	s.setInterceptor(ctx, id, int(ih.Pid), ih.ContainerName)
+	return nil
+}
+
+func (s *session) RemoveInterceptor(ctx context.Context, id string) error {
+	s.setInterceptor(ctx, id, 0, "")
 	return nil
 }
 
-func (s *session) RemoveInterceptor(id string) error {
+func (s *session) setInterceptor(ctx context.Context, id string, pid int, containerName string) {
+	name := ""
 	s.currentInterceptsLock.Lock()
 	if ci, ok := s.currentIntercepts[id]; ok {
-		ci.pid = 0
-		ci.containerName = ""
+		ci.pid = pid
+		ci.containerName = containerName
+		name = ci.Spec.Name
 	}
 	s.currentInterceptsLock.Unlock()
-	return nil
+	if name != "" {
+		s.setSavedHandler(ctx, name, pid, containerName)
+	}
 }
 
 // GetInterceptSpec returns the InterceptSpec for the given name, or nil if no such spec exists.
@@ -780,6 +939,18 @@ func (s *session) InterceptsForWorkload(workloadName, namespace string) []*manag
 
 // ClearIntercepts removes all intercepts.
 func (s *session) ClearIntercepts(c context.Context) error {
+	if s.expired.Load() {
+		// The traffic-manager has already dropped the intercepts of this session. They will be restored by the
+		// session that replaces this one, so their handlers are kept alive and only their mounts are removed.
+		for _, ic := range s.getCurrentIntercepts() {
+			ic.cancel()
+			ic.wg.Wait()
+		}
+		return nil
+	}
+	s.updateSavedIntercepts(c, func(sis map[string]*SavedIntercept) {
+		clear(sis)
+	})
 	for _, ic := range s.getCurrentIntercepts() {
 		dlog.Debugf(c, "Clearing intercept %s", ic.Spec.Name)
 		err := s.removeIntercept(c, ic)
@@ -0,0 +1,78 @@
+package trafficmgr
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/connector"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cache"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+)
+
+func interceptsFile(daemonID *daemon.Identifier) string {
+	return filepath.Join("intercepts", daemonID.InfoFileName())
+}
+
+// SavedIntercept is an intercept that is restored when a new session is established.
+type SavedIntercept struct {
+	// The request that created the intercept
+	Request *rpc.CreateInterceptRequest `json:"request"`
+
+	// The kind, UID and generation of the intercepted workload when the intercept was created
+	WorkloadKind       string `json:"workloadKind,omitempty"`
+	WorkloadUID        string `json:"workloadUID,omitempty"`
+	WorkloadGeneration int64  `json:"workloadGeneration,omitempty"`
+
+	// The time when the traffic-manager removes the intercept, if it has a time to live
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// The intercept handler, when the intercept command started one. The Pid is only valid
+	// in the daemon process identified by DaemonPid.
+	Pid           int    `json:"pid,omitempty"`
+	DaemonPid     int    `json:"daemonPid,omitempty"`
+	ContainerName string `json:"containerName,omitempty"`
+
+	// waitCh receives the intercept when it is still known to the traffic-manager and arrives in a snapshot
+	waitCh chan interceptResult
+}
+
+type SavedIntercepts struct {
+	KubeContext string                     `json:"kubeContext"`
+	Namespace   string                     `json:"namespace"`
+	Intercepts  map[string]*SavedIntercept `json:"intercepts"`
+}
+
+// SaveInterceptsToUserCache saves the provided intercepts to user cache and returns an error if
+// something goes wrong while marshalling or persisting. The cache is removed when there are no intercepts.
+func SaveInterceptsToUserCache(ctx context.Context, daemonID *daemon.Identifier, intercepts map[string]*SavedIntercept) error {
+	if len(intercepts) == 0 {
+		return DeleteInterceptsFromUserCache(ctx, daemonID)
+	}
+	return cache.SaveToUserCache(ctx, &SavedIntercepts{
+		KubeContext: daemonID.KubeContext,
+		Namespace:   daemonID.Namespace,
+		Intercepts:  intercepts,
+	}, interceptsFile(daemonID), cache.Private)
+}
+
+// LoadInterceptsFromUserCache gets the intercepts from cache. A nil map is returned if the file does
+// not exist. An error is returned if something goes wrong while loading or unmarshalling.
+func LoadInterceptsFromUserCache(ctx context.Context, daemonID *daemon.Identifier) (map[string]*SavedIntercept, error) {
+	var si *SavedIntercepts
+	err := cache.LoadFromUserCache(ctx, &si, interceptsFile(daemonID))
+	if err == nil && si.KubeContext == daemonID.KubeContext && si.Namespace == daemonID.Namespace {
+		return si.Intercepts, nil
+	}
+	if err != nil && os.IsNotExist(err) {
+		err = nil
+	}
+	return nil, err
+}
+
+// DeleteInterceptsFromUserCache removes the intercepts cache if existing or returns an error. An attempt
+// to remove a non-existing cache is a no-op and the function returns nil.
+func DeleteInterceptsFromUserCache(ctx context.Context, daemonID *daemon.Identifier) error {
+	return cache.DeleteFromUserCache(ctx, interceptsFile(daemonID))
+}
@@ -0,0 +1,109 @@
+package trafficmgr
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/recording"
+)
+
+// recorder writes the recording of an intercept that was created with a record file. The
+// traffic-agent records the intercepted streams, and sends the events of each stream using a
+// stream of its own to the recording port that is declared in the intercept spec. The recorder
+// listens to that port on the loopback interface and adds the events to the record file.
+type recorder struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	file      *os.File
+	writer    *recording.Writer
+	listener  *net.TCPListener
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// newRecorder creates the given file and starts listening for the recorded streams of the given intercept.
+func newRecorder(ctx context.Context, file, interceptName string) (*recorder, error) {
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IP{127, 0, 0, 1}})
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		_ = l.Close()
+		return nil, err
+	}
+	w, err := recording.NewWriter(f, interceptName)
+	if err != nil {
+		_ = f.Close()
+		_ = l.Close()
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	r := &recorder{
+		ctx:      ctx,
+		cancel:   cancel,
+		file:     f,
+		writer:   w,
+		listener: l,
+	}
+	r.wg.Add(1)
+	go r.serve()
+	dlog.Infof(ctx, "Recording traffic of intercept %s to %s", interceptName, file)
+	return r, nil
+}
+
+// port returns the recording port.
+func (r *recorder) port() uint16 {
+	return uint16(r.listener.Addr().(*net.TCPAddr).Port)
+}
+
+// close stops listening, waits for the recorded streams that are in progress, and closes the record
+// file. It is safe to call more than once.
+func (r *recorder) close() {
+	r.closeOnce.Do(func() {
+		r.cancel()
+		_ = r.listener.Close()
+		r.wg.Wait()
+		if err := r.file.Close(); err != nil {
+			dlog.Errorf(r.ctx, "failed to close record file %s: %v", r.file.Name(), err)
+		}
+	})
+}
+
+func (r *recorder) serve() {
+	defer r.wg.Done()
+	for {
+		conn, err := r.listener.AcceptTCP()
+		if err != nil {
+			if r.ctx.Err() == nil {
+				dlog.Errorf(r.ctx, "recorder failed to accept: %v", err)
+			}
+			return
+		}
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.copyStream(conn)
+		}()
+	}
+}
+
+// copyStream adds the events of one recorded stream to the record file.
+func (r *recorder) copyStream(conn *net.TCPConn) {
+	defer conn.Close()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go func() {
+		select {
+		case <-r.ctx.Done():
+			_ = conn.Close()
+		case <-stopCh:
+		}
+	}()
+	if err := r.writer.Copy(conn); err != nil && r.ctx.Err() == nil {
+		dlog.Errorf(r.ctx, "failed to record stream: %v", err)
+	}
+}
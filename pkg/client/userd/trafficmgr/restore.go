@@ -0,0 +1,275 @@
+package trafficmgr
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/common"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/connector"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
+)
+
+// loadSavedIntercepts loads the intercepts saved by an earlier session. A waiter is registered for each
+// one of them, so that an intercept that is still known to the traffic-manager gets its mount point
+// when it arrives in the first snapshot. This must be called before the session's services are started.
+func (s *session) loadSavedIntercepts(ctx context.Context) {
+	sis, err := LoadInterceptsFromUserCache(ctx, s.daemonID)
+	if err != nil {
+		dlog.Errorf(ctx, "failed to load saved intercepts: %v", err)
+	}
+	if sis == nil {
+		sis = make(map[string]*SavedIntercept)
+	}
+	s.currentInterceptsLock.Lock()
+	for name, si := range sis {
+		if si.Request.GetSpec().GetName() != name {
+			delete(sis, name)
+			continue
+		}
+		si.waitCh = make(chan interceptResult, 2)
+		s.interceptWaiters[name] = &awaitIntercept{
+			mountPoint: si.Request.MountPoint,
+			mountPort:  si.Request.LocalMountPort,
+			waitCh:     si.waitCh,
+		}
+	}
+	s.currentInterceptsLock.Unlock()
+
+	s.savedInterceptsLock.Lock()
+	s.savedIntercepts = sis
+	s.savedInterceptsLock.Unlock()
+}
+
+// dropWaiter removes the waiter that loadSavedIntercepts registered for the given intercept, unless
+// it has been consumed or replaced already.
+func (s *session) dropWaiter(si *SavedIntercept) {
+	name := si.Request.Spec.Name
+	s.currentInterceptsLock.Lock()
+	if aw, ok := s.interceptWaiters[name]; ok && aw.waitCh == si.waitCh {
+		delete(s.interceptWaiters, name)
+	}
+	s.currentInterceptsLock.Unlock()
+}
+
+// saveIntercept saves the request that created the given intercept, so that the intercept can be
+// restored by a new session.
+func (s *session) saveIntercept(ctx context.Context, ir *rpc.CreateInterceptRequest, ic *intercept) {
+	// A restored intercept doesn't record its traffic, because that would truncate the recording.
+	ir.RecordFile = ""
+	spec := ic.Spec
+	si := &SavedIntercept{Request: ir, WorkloadKind: spec.WorkloadKind}
+	if ea := ic.ExpiresAt; ea != nil {
+		t := ea.AsTime()
+		si.ExpiresAt = &t
+	}
+	if wl, err := workload.GetWorkload(ctx, spec.Agent, spec.Namespace, spec.WorkloadKind); err == nil {
+		si.WorkloadUID = string(wl.GetUID())
+		si.WorkloadGeneration = wl.GetGeneration()
+	} else {
+		dlog.Warnf(ctx, "unable to determine the generation of %s %s.%s: %v", spec.WorkloadKind, spec.Agent, spec.Namespace, err)
+	}
+	s.updateSavedIntercepts(ctx, func(sis map[string]*SavedIntercept) {
+		sis[spec.Name] = si
+	})
+}
+
+// forgetIntercept ensures that the intercept with the given name isn't restored by a new session.
+func (s *session) forgetIntercept(ctx context.Context, name string) {
+	s.updateSavedIntercepts(ctx, func(sis map[string]*SavedIntercept) {
+		delete(sis, name)
+	})
+}
+
+// forgetRemovedIntercepts ensures that saved intercepts with a time to live or idle timeout aren't restored
+// once the traffic-manager has removed them from the given snapshot.
+func (s *session) forgetRemovedIntercepts(ctx context.Context, iis []*manager.InterceptInfo) {
+	if s.expired.Load() {
+		// The intercepts were removed because the session expired.
+		return
+	}
+	present := make(map[string]struct{}, len(iis))
+	for _, ii := range iis {
+		present[ii.Id] = struct{}{}
+	}
+	var removed []string
+	s.currentInterceptsLock.Lock()
+	for id, ic := range s.currentIntercepts {
+		if _, ok := present[id]; !ok && (ic.ExpiresAt != nil || ic.Spec.IdleTimeout > 0) {
+			removed = append(removed, ic.Spec.Name)
+		}
+	}
+	s.currentInterceptsLock.Unlock()
+	if len(removed) == 0 {
+		return
+	}
+	s.updateSavedIntercepts(ctx, func(sis map[string]*SavedIntercept) {
+		for _, name := range removed {
+			if _, ok := sis[name]; ok {
+				dlog.Infof(ctx, "intercept %s was removed by the traffic-manager", name)
+				delete(sis, name)
+			}
+		}
+	})
+}
+
+// setSavedHandler assigns the handler of the saved intercept with the given name. The pid of a handler
+// process is only retained while this daemon is running, because it is meaningless to another process.
+func (s *session) setSavedHandler(ctx context.Context, name string, pid int, containerName string) {
+	s.updateSavedIntercepts(ctx, func(sis map[string]*SavedIntercept) {
+		if si, ok := sis[name]; ok {
+			si.Pid = pid
+			si.DaemonPid = os.Getpid()
+			si.ContainerName = containerName
+		}
+	})
+}
+
+// updateSavedIntercepts calls the given function with the saved intercepts, and then writes them to the user cache.
+func (s *session) updateSavedIntercepts(ctx context.Context, update func(map[string]*SavedIntercept)) {
+	s.savedInterceptsLock.Lock()
+	defer s.savedInterceptsLock.Unlock()
+	if s.savedIntercepts == nil {
+		return
+	}
+	update(s.savedIntercepts)
+	if err := SaveInterceptsToUserCache(ctx, s.daemonID, s.savedIntercepts); err != nil {
+		dlog.Errorf(ctx, "failed to save intercepts: %v", err)
+	}
+}
+
+// restoreIntercepts restores the intercepts that were saved by an earlier session. The outcome is reported by Status.
+func (s *session) restoreIntercepts(ctx context.Context) error {
+	s.savedInterceptsLock.Lock()
+	sis := make([]*SavedIntercept, 0, len(s.savedIntercepts))
+	for _, si := range s.savedIntercepts {
+		sis = append(sis, si)
+	}
+	s.savedInterceptsLock.Unlock()
+	if len(sis) == 0 {
+		return nil
+	}
+	slices.SortFunc(sis, func(a, b *SavedIntercept) int {
+		return strings.Compare(a.Request.Spec.Name, b.Request.Spec.Name)
+	})
+
+	dlog.Infof(ctx, "Restoring %d intercepts", len(sis))
+	rs := make([]*rpc.RestoredIntercept, len(sis))
+	for i, si := range sis {
+		r := &rpc.RestoredIntercept{Name: si.Request.Spec.Name}
+		changed, err := si.workloadChanged(ctx)
+		if err == nil {
+			r.WorkloadChanged = changed
+			r.HandlerError, err = s.restoreIntercept(ctx, si)
+		}
+		switch {
+		case err != nil:
+			dlog.Errorf(ctx, "failed to restore intercept %s: %v", r.Name, err)
+			r.Error = err.Error()
+			s.dropWaiter(si)
+			s.forgetIntercept(ctx, r.Name)
+		case r.HandlerError != "":
+			dlog.Warnf(ctx, "restored intercept %s, but not its handler: %s", r.Name, r.HandlerError)
+		case changed:
+			dlog.Warnf(ctx, "restored intercept %s, but its workload has changed since the intercept was created", r.Name)
+		default:
+			dlog.Infof(ctx, "restored intercept %s", r.Name)
+		}
+		rs[i] = r
+	}
+	s.currentInterceptsLock.Lock()
+	s.restoredIntercepts = rs
+	s.currentInterceptsLock.Unlock()
+	return nil
+}
+
+// restoreIntercept adopts the saved intercept if the traffic-manager still knows about it, or creates it anew.
+// The handler of the intercept is then reattached if it was started using this daemon process. A handler that was
+// started using an earlier daemon process ended along with the intercept command that started it, and it cannot be
+// started again by the daemon. A description of why the handler wasn't restored is returned in that case.
+func (s *session) restoreIntercept(ctx context.Context, si *SavedIntercept) (string, error) {
+	name := si.Request.Spec.Name
+	_, err := s.managerClient.GetIntercept(ctx, &manager.GetInterceptRequest{Session: s.SessionInfo(), Name: name})
+	if err == nil {
+		// The intercept was retained by the traffic-manager, so it's adopted when it arrives in a snapshot.
+		tc, cancel := client.GetConfig(ctx).Timeouts().TimeoutContext(ctx, client.TimeoutIntercept)
+		defer cancel()
+		select {
+		case <-tc.Done():
+			return "", client.CheckTimeout(tc, tc.Err())
+		case wr := <-si.waitCh:
+			if wr.err != nil {
+				return "", wr.err
+			}
+		}
+	} else {
+		if grpcStatus.Code(err) != grpcCodes.NotFound {
+			return "", err
+		}
+		s.dropWaiter(si)
+		ir := proto.Clone(si.Request).(*rpc.CreateInterceptRequest)
+		if si.ExpiresAt != nil {
+			// The restored intercept must not outlive the original one.
+			ttl := time.Until(*si.ExpiresAt)
+			if ttl <= 0 {
+				return "", errcat.User.Newf("intercept %s has expired", name)
+			}
+			ir.Spec.Ttl = int64(ttl)
+		}
+		result := s.self.AddIntercept(ctx, ir)
+		if result.Error != common.InterceptError_UNSPECIFIED {
+			return "", errcat.Category(result.ErrorCategory).Newf("%s: %s", result.Error, result.ErrorText)
+		}
+	}
+
+	if si.Pid == 0 && si.ContainerName == "" {
+		return "", nil
+	}
+	if si.DaemonPid != os.Getpid() {
+		handler := "process"
+		if si.ContainerName != "" {
+			handler = "container " + si.ContainerName
+		}
+		s.setSavedHandler(ctx, name, 0, "")
+		return fmt.Sprintf("the handler %s was started by an earlier daemon process and is not running; "+
+			"use telepresence leave %s and then intercept again to start it", handler, name), nil
+	}
+	ic := s.getInterceptByName(name)
+	if ic == nil {
+		return "", errcat.User.Newf("intercept %s vanished while being restored", name)
+	}
+	s.currentInterceptsLock.Lock()
+	ic.pid = si.Pid
+	ic.containerName = si.ContainerName
+	s.currentInterceptsLock.Unlock()
+	s.setSavedHandler(ctx, name, si.Pid, si.ContainerName)
+	return "", nil
+}
+
+// workloadChanged returns true if the intercepted workload was modified or replaced after the intercept was saved.
+func (si *SavedIntercept) workloadChanged(ctx context.Context) (bool, error) {
+	spec := si.Request.Spec
+	wl, err := workload.GetWorkload(ctx, spec.Agent, spec.Namespace, si.WorkloadKind)
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			err = errcat.User.Newf("workload %s.%s no longer exists", spec.Agent, spec.Namespace)
+		}
+		return false, err
+	}
+	if si.WorkloadUID == "" {
+		return false, nil
+	}
+	return string(wl.GetUID()) != si.WorkloadUID || wl.GetGeneration() != si.WorkloadGeneration, nil
+}
@@ -0,0 +1,334 @@
+package trafficmgr
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apps "k8s.io/api/apps/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/connector"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/client"
+	"github.com/telepresenceio/telepresence/v2/pkg/client/cli/daemon"
+	"github.com/telepresenceio/telepresence/v2/pkg/errcat"
+	"github.com/telepresenceio/telepresence/v2/pkg/filelocation"
+)
+
+// retainingManager is a traffic-manager client that retains the intercepts with the given names.
+type retainingManager struct {
+	manager.ManagerClient
+	retained map[string]struct{}
+}
+
+func (m *retainingManager) GetIntercept(_ context.Context, in *manager.GetInterceptRequest, _ ...grpc.CallOption) (*manager.InterceptInfo, error) {
+	if _, ok := m.retained[in.Name]; ok {
+		return &manager.InterceptInfo{Spec: &manager.InterceptSpec{Name: in.Name}}, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "intercept named %q not found", in.Name)
+}
+
+// addingSession is a session that creates intercepts without involving the traffic-manager.
+type addingSession struct {
+	*session
+	added []*rpc.CreateInterceptRequest
+}
+
+func (s *addingSession) AddIntercept(_ context.Context, ir *rpc.CreateInterceptRequest) *rpc.InterceptResult {
+	s.added = append(s.added, ir)
+	s.currentInterceptsLock.Lock()
+	s.currentIntercepts[ir.Spec.Name] = &intercept{InterceptInfo: &manager.InterceptInfo{Id: ir.Spec.Name, Spec: ir.Spec}}
+	s.currentInterceptsLock.Unlock()
+	return &rpc.InterceptResult{}
+}
+
+func testDeployment(name string, uid types.UID, generation int64) *apps.Deployment {
+	return &apps.Deployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			UID:        uid,
+			Generation: generation,
+		},
+	}
+}
+
+func testRestoreContext(t *testing.T, objects ...*apps.Deployment) context.Context {
+	ctx := dlog.NewTestContext(t, false)
+	ctx = filelocation.WithAppUserCacheDir(ctx, t.TempDir())
+	ctx = client.WithConfig(ctx, client.GetDefaultConfig())
+	cs := fake.NewSimpleClientset()
+	for _, obj := range objects {
+		require.NoError(t, cs.Tracker().Add(obj))
+	}
+	return k8sapi.WithK8sInterface(ctx, cs)
+}
+
+func testSession(t *testing.T, retained ...string) *addingSession {
+	daemonID, err := daemon.NewIdentifier("", "test-context", "default", false)
+	require.NoError(t, err)
+	rm := &retainingManager{retained: make(map[string]struct{})}
+	for _, name := range retained {
+		rm.retained[name] = struct{}{}
+	}
+	s := &addingSession{session: &session{
+		managerClient:     rm,
+		daemonID:          daemonID,
+		currentIntercepts: make(map[string]*intercept),
+		interceptWaiters:  make(map[string]*awaitIntercept),
+	}}
+	s.self = s
+	return s
+}
+
+func interceptRequest(name, workload string) *rpc.CreateInterceptRequest {
+	return &rpc.CreateInterceptRequest{
+		Spec: &manager.InterceptSpec{
+			Name:         name,
+			Agent:        workload,
+			Namespace:    "default",
+			WorkloadKind: "Deployment",
+			TargetPort:   8080,
+		},
+		MountPoint: "/tmp/" + name,
+	}
+}
+
+func TestSavedInterceptsRoundTrip(t *testing.T) {
+	ctx := testRestoreContext(t, testDeployment("echo", "echo-uid", 3))
+	s := testSession(t)
+	s.loadSavedIntercepts(ctx)
+	assert.Empty(t, s.savedIntercepts)
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	ir := interceptRequest("echo", "echo")
+	ir.RecordFile = "/tmp/echo.tprec"
+	s.saveIntercept(ctx, ir, &intercept{InterceptInfo: &manager.InterceptInfo{
+		Spec:      ir.Spec,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}})
+	s.setSavedHandler(ctx, "echo", 4711, "echo-handler")
+
+	// A new session loads the saved intercept and awaits it.
+	ns := testSession(t)
+	ns.loadSavedIntercepts(ctx)
+	require.Len(t, ns.savedIntercepts, 1)
+	si := ns.savedIntercepts["echo"]
+	require.NotNil(t, si)
+	assert.True(t, proto.Equal(ir, si.Request))
+	assert.Empty(t, si.Request.RecordFile, "a restored intercept must not truncate the recording")
+	assert.Equal(t, "Deployment", si.WorkloadKind)
+	assert.Equal(t, "echo-uid", si.WorkloadUID)
+	assert.Equal(t, int64(3), si.WorkloadGeneration)
+	require.NotNil(t, si.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*si.ExpiresAt))
+	assert.Equal(t, 4711, si.Pid)
+	assert.Equal(t, os.Getpid(), si.DaemonPid)
+	assert.Equal(t, "echo-handler", si.ContainerName)
+	aw := ns.interceptWaiters["echo"]
+	require.NotNil(t, aw)
+	assert.Equal(t, "/tmp/echo", aw.mountPoint)
+
+	// A daemon connected to another namespace doesn't see the intercepts.
+	otherID, err := daemon.NewIdentifier("", "test-context", "other", false)
+	require.NoError(t, err)
+	sis, err := LoadInterceptsFromUserCache(ctx, otherID)
+	require.NoError(t, err)
+	assert.Nil(t, sis)
+
+	// The cache is removed along with the last intercept.
+	ns.forgetIntercept(ctx, "echo")
+	sis, err = LoadInterceptsFromUserCache(ctx, ns.daemonID)
+	require.NoError(t, err)
+	assert.Nil(t, sis)
+}
+
+func TestRestoreIntercepts(t *testing.T) {
+	ctx := testRestoreContext(t,
+		testDeployment("adopted", "adopted-uid", 1),
+		testDeployment("recreated", "recreated-uid", 1),
+		testDeployment("reattached", "reattached-uid", 1),
+		testDeployment("expired", "expired-uid", 1),
+		testDeployment("changed", "changed-uid", 2),
+	)
+	daemonID, err := daemon.NewIdentifier("", "test-context", "default", false)
+	require.NoError(t, err)
+
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Minute)
+	require.NoError(t, SaveInterceptsToUserCache(ctx, daemonID, map[string]*SavedIntercept{
+		"adopted": {
+			Request:            interceptRequest("adopted", "adopted"),
+			WorkloadKind:       "Deployment",
+			WorkloadUID:        "adopted-uid",
+			WorkloadGeneration: 1,
+		},
+		"recreated": {
+			Request:            interceptRequest("recreated", "recreated"),
+			WorkloadKind:       "Deployment",
+			WorkloadUID:        "recreated-uid",
+			WorkloadGeneration: 1,
+			ExpiresAt:          &future,
+			ContainerName:      "recreated-handler",
+		},
+		"reattached": {
+			Request:            interceptRequest("reattached", "reattached"),
+			WorkloadKind:       "Deployment",
+			WorkloadUID:        "reattached-uid",
+			WorkloadGeneration: 1,
+			Pid:                4711,
+			DaemonPid:          os.Getpid(),
+		},
+		"expired": {
+			Request:            interceptRequest("expired", "expired"),
+			WorkloadKind:       "Deployment",
+			WorkloadUID:        "expired-uid",
+			WorkloadGeneration: 1,
+			ExpiresAt:          &past,
+		},
+		"changed": {
+			Request:            interceptRequest("changed", "changed"),
+			WorkloadKind:       "Deployment",
+			WorkloadUID:        "changed-uid",
+			WorkloadGeneration: 1,
+		},
+		"misnamed": {
+			Request:      interceptRequest("other", "adopted"),
+			WorkloadKind: "Deployment",
+		},
+	}))
+
+	s := testSession(t, "adopted")
+	s.loadSavedIntercepts(ctx)
+	require.Len(t, s.savedIntercepts, 5, "an intercept saved under another name must be dropped")
+	aw := s.interceptWaiters["adopted"]
+	require.NotNil(t, aw)
+
+	// The retained intercept arrives in the first snapshot.
+	aw.waitCh <- interceptResult{intercept: &intercept{InterceptInfo: &manager.InterceptInfo{Spec: interceptRequest("adopted", "adopted").Spec}}}
+	require.NoError(t, s.restoreIntercepts(ctx))
+
+	rs := make(map[string]*rpc.RestoredIntercept, len(s.restoredIntercepts))
+	for _, r := range s.restoredIntercepts {
+		rs[r.Name] = r
+	}
+	require.Len(t, rs, 5)
+	assert.Empty(t, rs["adopted"].Error)
+	assert.False(t, rs["adopted"].WorkloadChanged)
+	assert.Empty(t, rs["adopted"].HandlerError)
+	assert.Empty(t, rs["recreated"].Error)
+	assert.False(t, rs["recreated"].WorkloadChanged)
+	assert.Equal(t, "the handler container recreated-handler was started by an earlier daemon process and is not running; "+
+		"use telepresence leave recreated and then intercept again to start it", rs["recreated"].HandlerError)
+	assert.Empty(t, rs["reattached"].Error)
+	assert.Empty(t, rs["reattached"].HandlerError)
+	assert.Equal(t, "intercept expired has expired", rs["expired"].Error)
+	assert.Empty(t, rs["changed"].Error)
+	assert.True(t, rs["changed"].WorkloadChanged)
+
+	// Only the intercepts that the traffic-manager no longer knows about are created anew.
+	require.Len(t, s.added, 3)
+	assert.Equal(t, "changed", s.added[0].Spec.Name)
+	assert.Zero(t, s.added[0].Spec.Ttl)
+	assert.Equal(t, "reattached", s.added[1].Spec.Name)
+	assert.Equal(t, "recreated", s.added[2].Spec.Name)
+	assert.Greater(t, s.added[2].Spec.Ttl, int64(0))
+	assert.LessOrEqual(t, s.added[2].Spec.Ttl, int64(time.Hour))
+	assert.Zero(t, s.savedIntercepts["recreated"].Request.Spec.Ttl, "the saved request must not be modified")
+
+	// A handler that was started using this daemon process is reattached. Other handlers are not.
+	ic := s.getInterceptByName("reattached")
+	require.NotNil(t, ic)
+	assert.Equal(t, 4711, ic.pid)
+	ic = s.getInterceptByName("recreated")
+	require.NotNil(t, ic)
+	assert.Empty(t, ic.containerName)
+
+	// Waiters are dropped for intercepts that are created anew or that failed.
+	assert.NotContains(t, s.interceptWaiters, "recreated")
+	assert.NotContains(t, s.interceptWaiters, "expired")
+	assert.NotContains(t, s.interceptWaiters, "changed")
+
+	// An intercept that failed to restore is forgotten.
+	sis, err := LoadInterceptsFromUserCache(ctx, daemonID)
+	require.NoError(t, err)
+	assert.NotContains(t, sis, "expired")
+	require.Contains(t, sis, "recreated")
+	assert.Empty(t, sis["recreated"].ContainerName, "a handler that wasn't restored must not be reported again")
+}
+
+func TestWorkloadChanged(t *testing.T) {
+	ctx := testRestoreContext(t, testDeployment("echo", "echo-uid", 2))
+	tests := []struct {
+		name       string
+		workload   string
+		uid        string
+		generation int64
+		expected   bool
+		wantErr    string
+	}{
+		{
+			name:       "unchanged",
+			workload:   "echo",
+			uid:        "echo-uid",
+			generation: 2,
+		},
+		{
+			name:       "modified",
+			workload:   "echo",
+			uid:        "echo-uid",
+			generation: 1,
+			expected:   true,
+		},
+		{
+			name:       "replaced",
+			workload:   "echo",
+			uid:        "old-echo-uid",
+			generation: 2,
+			expected:   true,
+		},
+		{
+			name:       "unknown UID",
+			workload:   "echo",
+			generation: 1,
+		},
+		{
+			name:     "removed",
+			workload: "gone",
+			uid:      "gone-uid",
+			wantErr:  "workload gone.default no longer exists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			si := &SavedIntercept{
+				Request:            interceptRequest("echo", tt.workload),
+				WorkloadKind:       "Deployment",
+				WorkloadUID:        tt.uid,
+				WorkloadGeneration: tt.generation,
+			}
+			changed, err := si.workloadChanged(ctx)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				assert.Equal(t, errcat.User, errcat.GetCategory(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, changed)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/blang/semver"
@@ -57,6 +58,7 @@ import (
 	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
 	"github.com/telepresenceio/telepresence/v2/pkg/proc"
 	"github.com/telepresenceio/telepresence/v2/pkg/restapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 type apiServer struct {
@@ -123,6 +125,19 @@ type session struct {
 
 	ingressInfo []*manager.IngressInfo
 
+	// restoredIntercepts describes the outcome of restoring the intercepts that were saved by an earlier session.
+	restoredIntercepts []*rpc.RestoredIntercept
+
+	// savedInterceptsLock ensures that all accesses to savedIntercepts and its user cache file are synchronized
+	savedInterceptsLock sync.Mutex
+
+	// savedIntercepts are the intercepts that will be restored if this session is replaced by a new one,
+	// keyed by intercept name.
+	savedIntercepts map[string]*SavedIntercept
+
+	// expired is set when the traffic-manager no longer knows about this session.
+	expired atomic.Bool
+
 	isPodDaemon bool
 
 	sessionConfig client.Config
@@ -193,6 +208,7 @@ func NewSession(
 
 	// store session in ctx for reporting
 	ctx = scout.WithSession(ctx, tmgr)
+	tmgr.loadSavedIntercepts(ctx)
 
 	tmgr.sessionConfig = client.GetDefaultConfig()
 	cliCfg, err := tmgr.managerClient.GetClientConfig(ctx, &empty.Empty{})
@@ -211,6 +227,7 @@ func NewSession(
 			dlog.Warnf(ctx, "Failed to set remote kubeconfig values: %v", err)
 		}
 	}
+	ctx = workload.WithCustomKinds(ctx, client.GetConfig(ctx).Cluster().CustomWorkloadKinds)
 	ctx = dnet.WithPortForwardDialer(ctx, tmgr.pfDialer)
 
 	oi := tmgr.getOutboundInfo(ctx, cr)
@@ -566,6 +583,7 @@ func (s *session) StartServices(g *dgroup.Group) {
 	g.Go("remain", s.remainLoop)
 	g.Go("intercept-port-forward", s.watchInterceptsHandler)
 	g.Go("dial-request-watcher", s.dialRequestWatcher)
+	g.Go("restore-intercepts", s.restoreIntercepts)
 }
 
 func runWithRetry(ctx context.Context, f func(context.Context) error) error {
@@ -835,6 +853,9 @@ func (s *session) remainLoop(c context.Context) error {
 			return nil
 		case <-ticker.C:
 			if err := s.Remain(c); err != nil {
+				if errors.Is(err, ErrSessionExpired) {
+					s.expired.Store(true)
+				}
 				return err
 			}
 		}
@@ -915,6 +936,9 @@ func (s *session) Status(c context.Context) *rpc.ConnectInfo {
 		},
 		ManagerNamespace: cfg.GetManagerNamespace(),
 	}
+	s.currentInterceptsLock.Lock()
+	ret.RestoredIntercepts = s.restoredIntercepts
+	s.currentInterceptsLock.Unlock()
 	if len(s.MappedNamespaces) > 0 || len(s.sessionConfig.Cluster().MappedNamespaces) > 0 {
 		ret.MappedNamespaces = s.GetCurrentNamespaces(true)
 	}
@@ -1091,6 +1115,7 @@ func (s *session) getOutboundInfo(ctx context.Context, cr *rpc.ConnectRequest) *
 			IncludeSuffixes: s.DNS.IncludeSuffixes,
 			Excludes:        s.DNS.Excludes,
 			Mappings:        s.DNS.Mappings.ToRPC(),
+			ForwardRules:    s.DNS.ForwardRules.ToRPC(),
 			LookupTimeout:   durationpb.New(s.DNS.LookupTimeout.Duration),
 		}
 		if len(s.DNS.LocalIP) > 0 {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/datawire/dlib/dlog"
 	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 type workloadsAndServicesWatcher struct {
@@ -30,12 +31,14 @@ const (
 	deployments  = 0
 	replicasets  = 1
 	statefulsets = 2
+	daemonsets   = 3
 )
 
 // namespacedWASWatcher is watches Workloads And Services (WAS) for a namespace.
 type namespacedWASWatcher struct {
-	svcWatcher *k8sapi.Watcher[*core.Service]
-	wlWatchers [3]*k8sapi.Watcher[runtime.Object]
+	svcWatcher     *k8sapi.Watcher[*core.Service]
+	wlWatchers     [4]*k8sapi.Watcher[runtime.Object]
+	customWatchers []*customWatcher
 }
 
 // svcEquals compare only the Service fields that are of interest to Telepresence. They are
@@ -67,7 +70,7 @@ nextMP:
 	return true
 }
 
-// workloadEquals compare only the workload (Deployment, ResourceSet, or StatefulSet) fields that are of interest to Telepresence. They are
+// workloadEquals compare only the workload (Deployment, ResourceSet, StatefulSet, DaemonSet, or custom kind) fields that are of interest to Telepresence. They are
 //
 //   - UID
 //   - Name
@@ -76,12 +79,12 @@ nextMP:
 //   - Labels
 //   - Containers (must contain an equal number of equally named containers with equal ports)
 func workloadEquals(oa, ob runtime.Object) bool {
-	a, err := k8sapi.WrapWorkload(oa)
+	a, err := workload.WrapWorkload(oa)
 	if err != nil {
 		// This should definitely never happen
 		panic(err)
 	}
-	b, err := k8sapi.WrapWorkload(ob)
+	b, err := workload.WrapWorkload(ob)
 	if err != nil {
 		// This should definitely never happen
 		panic(err)
@@ -145,11 +148,13 @@ func newNamespaceWatcher(c context.Context, namespace string, cond *sync.Cond) *
 	appsGetter := ki.AppsV1().RESTClient()
 	w := &namespacedWASWatcher{
 		svcWatcher: k8sapi.NewWatcher("services", ki.CoreV1().RESTClient(), cond, k8sapi.WithEquals(svcEquals), k8sapi.WithNamespace[*core.Service](namespace)),
-		wlWatchers: [3]*k8sapi.Watcher[runtime.Object]{
+		wlWatchers: [4]*k8sapi.Watcher[runtime.Object]{
 			k8sapi.NewWatcher("deployments", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
 			k8sapi.NewWatcher("replicasets", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
 			k8sapi.NewWatcher("statefulsets", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
+			k8sapi.NewWatcher("daemonsets", appsGetter, cond, k8sapi.WithEquals(workloadEquals), k8sapi.WithNamespace[runtime.Object](namespace)),
 		},
+		customWatchers: newCustomWatchers(c, namespace, cond),
 	}
 	return w
 }
@@ -159,13 +164,25 @@ func (nw *namespacedWASWatcher) cancel() {
 	for _, w := range nw.wlWatchers {
 		w.Cancel()
 	}
+	for _, cw := range nw.customWatchers {
+		cw.cancel()
+	}
 }
 
 func (nw *namespacedWASWatcher) hasSynced() bool {
-	return nw.svcWatcher.HasSynced() &&
+	if !(nw.svcWatcher.HasSynced() &&
 		nw.wlWatchers[0].HasSynced() &&
 		nw.wlWatchers[1].HasSynced() &&
-		nw.wlWatchers[2].HasSynced()
+		nw.wlWatchers[2].HasSynced() &&
+		nw.wlWatchers[3].HasSynced()) {
+		return false
+	}
+	for _, cw := range nw.customWatchers {
+		if !cw.hasSynced() {
+			return false
+		}
+	}
+	return true
 }
 
 func newWASWatcher() *workloadsAndServicesWatcher {
@@ -345,6 +362,8 @@ func (nw *namespacedWASWatcher) findMatchingWorkloads(c context.Context, svc *co
 				wl = k8sapi.ReplicaSet(o.(*apps.ReplicaSet))
 			case statefulsets:
 				wl = k8sapi.StatefulSet(o.(*apps.StatefulSet))
+			case daemonsets:
+				wl = workload.DaemonSet(o.(*apps.DaemonSet))
 			}
 			if selector.Matches(labels.Set(wl.GetPodTemplate().Labels)) {
 				owl, err := nw.maybeReplaceWithOwner(c, wl)
@@ -355,6 +374,13 @@ func (nw *namespacedWASWatcher) findMatchingWorkloads(c context.Context, svc *co
 			}
 		}
 	}
+	for _, cw := range nw.customWatchers {
+		for _, wl := range cw.list() {
+			if selector.Matches(labels.Set(wl.GetPodTemplate().Labels)) {
+				allWls = append(allWls, wl)
+			}
+		}
+	}
 
 	// Prefer entries with matching ports. I.e. strip all non-matching if matching entries
 	// are found.
@@ -367,15 +393,45 @@ func (nw *namespacedWASWatcher) findMatchingWorkloads(c context.Context, svc *co
 func (nw *namespacedWASWatcher) maybeReplaceWithOwner(c context.Context, wl k8sapi.Workload) (k8sapi.Workload, error) {
 	var err error
 	for _, or := range wl.GetOwnerReferences() {
-		if or.Controller != nil && *or.Controller && or.Kind == "Deployment" {
+		if or.Controller == nil || !*or.Controller {
+			continue
+		}
+		if or.Kind == "Deployment" {
 			// Chances are that the owner's labels doesn't match, but we really want the owner anyway.
 			wl, err = nw.replaceWithOwner(c, wl, or.Kind, or.Name)
 			break
 		}
+		if cw := nw.customWatcherFor(c, or.APIVersion, or.Kind); cw != nil {
+			wl, err = nw.replaceWithCustomOwner(c, cw, wl, or.Name)
+			break
+		}
 	}
 	return wl, err
 }
 
+// customWatcherFor returns the watcher for the custom kind with the given apiVersion and kind, or nil
+// if no such kind is watched.
+func (nw *namespacedWASWatcher) customWatcherFor(c context.Context, apiVersion, kind string) *customWatcher {
+	ck, ok := workload.FindCustomKindFor(c, apiVersion, kind)
+	if !ok {
+		return nil
+	}
+	for _, cw := range nw.customWatchers {
+		if cw.kind == ck {
+			return cw
+		}
+	}
+	return nil
+}
+
+func (nw *namespacedWASWatcher) replaceWithCustomOwner(c context.Context, cw *customWatcher, wl k8sapi.Workload, name string) (k8sapi.Workload, error) {
+	if owl, found := cw.get(name); found {
+		dlog.Debugf(c, "replacing %s %s.%s, with owner %s %s", wl.GetKind(), wl.GetName(), wl.GetNamespace(), cw.kind.Kind, name)
+		return owl, nil
+	}
+	return nil, fmt.Errorf("get %s owner %s for %s %s.%s: not found", cw.kind.Kind, name, wl.GetKind(), wl.GetName(), wl.GetNamespace())
+}
+
 func (nw *namespacedWASWatcher) replaceWithOwner(c context.Context, wl k8sapi.Workload, kind, name string) (k8sapi.Workload, error) {
 	od, found, err := nw.wlWatchers[deployments].Get(c, &apps.Deployment{
 		ObjectMeta: meta.ObjectMeta{
@@ -0,0 +1,300 @@
+package forwarder
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+
+	"golang.org/x/net/http2"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+// maxMethodLength is the maximum number of bytes that are peeked at when trying to determine if a
+// connection carries HTTP requests.
+const maxMethodLength = 16
+
+// forwardHTTP serves the HTTP/1.x or HTTP/2 (cleartext) requests that arrive on the given connection. Requests
+// that are matched by an HTTP intercept are sent to the client of that intercept and all other requests are sent
+// to the targetAddr. A connection that doesn't carry HTTP is forwarded to the targetAddr unchanged.
+func (f *tcp) forwardHTTP(ctx context.Context, conn *net.TCPConn, targetAddr *net.TCPAddr) error {
+	br := bufio.NewReader(conn)
+	pc := &peekedConn{Conn: conn, r: br}
+	isH2, isHTTP := sniffHTTP(br)
+	if !isHTTP {
+		dlog.Debug(ctx, "Connection doesn't carry HTTP, forwarding it unchanged")
+		return forwardRaw(ctx, br, conn, targetAddr)
+	}
+
+	hh := &httpHandler{
+		ctx:        ctx,
+		f:          f,
+		clientAddr: conn.RemoteAddr(),
+		isH2:       isH2,
+		proxies:    make(map[string]*httputil.ReverseProxy),
+	}
+	defer hh.close()
+	hh.proxies[""] = hh.newProxy(targetAddr.String(), func(ctx context.Context) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "tcp", targetAddr.String())
+	})
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	if isH2 {
+		dlog.Debug(ctx, "Serving HTTP/2 connection")
+		(&http2.Server{}).ServeConn(pc, &http2.ServeConnOpts{Context: ctx, Handler: hh})
+		return nil
+	}
+
+	dlog.Debug(ctx, "Serving HTTP/1 connection")
+	l := &oneConnListener{conn: pc, done: make(chan struct{})}
+	srv := &http.Server{
+		Handler: hh,
+		ConnState: func(_ net.Conn, s http.ConnState) {
+			if s == http.StateClosed || s == http.StateHijacked {
+				l.close()
+			}
+		},
+	}
+	err := srv.Serve(l)
+
+	// A hijacked connection, such as a websocket, is still served by its handler.
+	hh.wg.Wait()
+	if err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
+}
+
+// sniffHTTP peeks at the first bytes of a connection and determines if it carries HTTP/2 using
+// prior knowledge, HTTP/1.x, or something else.
+func sniffHTTP(br *bufio.Reader) (isH2, isHTTP bool) {
+	for n := 1; n <= maxMethodLength; n++ {
+		b, err := br.Peek(n)
+		if err != nil {
+			return false, false
+		}
+		c := b[n-1]
+		if c == ' ' {
+			if n == 1 {
+				return false, false
+			}
+			// The HTTP/2 connection preface starts with "PRI * HTTP/2.0"
+			return string(b[:n-1]) == "PRI", true
+		}
+		if c < 'A' || c > 'Z' {
+			return false, false
+		}
+	}
+	return false, false
+}
+
+// forwardRaw forwards everything that is read from the given reader to the targetAddr and writes
+// the response to the given connection.
+func forwardRaw(ctx context.Context, r io.Reader, conn *net.TCPConn, targetAddr *net.TCPAddr) error {
+	targetConn, err := net.DialTCP("tcp", nil, targetAddr)
+	if err != nil {
+		return fmt.Errorf("error on dial: %w", err)
+	}
+	defer targetConn.Close()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := io.Copy(targetConn, r); err != nil {
+			dlog.Debugf(ctx, "Error clientConn->targetConn: %+v", err)
+		}
+		_ = targetConn.CloseWrite()
+	}()
+	go func() {
+		defer wg.Done()
+		if _, err := io.Copy(conn, targetConn); err != nil {
+			dlog.Debugf(ctx, "Error targetConn->clientConn: %+v", err)
+		}
+		_ = conn.CloseWrite()
+	}()
+	wg.Wait()
+	return nil
+}
+
+// httpHandler dispatches the requests of one connection to either the intercepting clients or the
+// original target.
+type httpHandler struct {
+	ctx        context.Context
+	f          *tcp
+	clientAddr net.Addr
+	isH2       bool
+	wg         sync.WaitGroup
+
+	mu sync.Mutex
+	// proxies keyed by intercept ID and target. The empty key is used for the original target.
+	proxies map[string]*httputil.ReverseProxy
+}
+
+func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.wg.Add(1)
+	defer h.wg.Done()
+
+	h.f.mu.Lock()
+	hics := h.f.httpIntercepts
+	h.f.mu.Unlock()
+
+	var found *HTTPIntercept
+	for _, hic := range hics {
+		if hic.Matches(r.URL.Path, r.Header) {
+			if hic.Selected(r.Header) {
+				found = hic
+			}
+			break
+		}
+	}
+
+	h.mu.Lock()
+	var p *httputil.ReverseProxy
+	if found == nil {
+		dlog.Tracef(h.ctx, "Forwarding %s %s", r.Method, r.URL.Path)
+		p = h.proxies[""]
+	} else {
+		dlog.Debugf(h.ctx, "Intercepting %s %s using intercept %s", r.Method, r.URL.Path, found.Id)
+		spec := found.Spec
+		addr := iputil.JoinHostPort(spec.TargetHost, uint16(spec.TargetPort))
+
+		// The target of an intercept can be updated, so the proxy is keyed by both the ID and the target.
+		key := found.Id + "/" + addr
+		var ok bool
+		if p, ok = h.proxies[key]; !ok {
+			p = h.newProxy(addr, func(context.Context) (net.Conn, error) {
+				// The stream to the client is bound to the lifetime of the served connection, not the request.
+				return h.f.dialIntercept(h.ctx, h.clientAddr, found.InterceptInfo)
+			})
+			h.proxies[key] = p
+		}
+	}
+	h.mu.Unlock()
+	p.ServeHTTP(w, r)
+}
+
+// newProxy creates a reverse proxy that uses the given dial function to establish its connections to the
+// given address. The proxy will use HTTP/2 if the connection that it serves uses HTTP/2.
+func (h *httpHandler) newProxy(addr string, dial func(context.Context) (net.Conn, error)) *httputil.ReverseProxy {
+	var rt http.RoundTripper
+	if h.isH2 {
+		rt = &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, _, _ string, _ *tls.Config) (net.Conn, error) {
+				return dial(ctx)
+			},
+		}
+	} else {
+		rt = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dial(ctx)
+			},
+			MaxConnsPerHost: 1,
+		}
+	}
+	return &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.Out.URL.Scheme = "http"
+			pr.Out.URL.Host = addr
+		},
+		Transport:     rt,
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			dlog.Errorf(h.ctx, "%s %s to %s failed: %v", r.Method, r.URL.Path, addr, err)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+}
+
+func (h *httpHandler) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, p := range h.proxies {
+		if ci, ok := p.Transport.(interface{ CloseIdleConnections() }); ok {
+			ci.CloseIdleConnections()
+		}
+	}
+}
+
+// dialIntercept creates a stream to the client of the given intercept and returns a connection that
+// is bridged to that stream.
+func (f *tcp) dialIntercept(ctx context.Context, addr net.Addr, iCept *manager.InterceptInfo) (net.Conn, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s, err := f.createClientStream(ctx, addr, iCept)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	local, remote := net.Pipe()
+	go f.serveStream(ctx, cancel, s, remote, iCept)
+	return local, nil
+}
+
+// peekedConn is a net.Conn that reads from a bufio.Reader that has been used to peek at the
+// beginning of the connection.
+type peekedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// oneConnListener is a net.Listener that returns one connection and then blocks until it is closed.
+type oneConnListener struct {
+	sync.Mutex
+	conn net.Conn
+	done chan struct{}
+}
+
+func (l *oneConnListener) Accept() (net.Conn, error) {
+	l.Lock()
+	c := l.conn
+	l.conn = nil
+	l.Unlock()
+	if c != nil {
+		return c, nil
+	}
+	<-l.done
+	return nil, net.ErrClosed
+}
+
+func (l *oneConnListener) close() {
+	l.Lock()
+	defer l.Unlock()
+	select {
+	case <-l.done:
+	default:
+		close(l.done)
+	}
+}
+
+func (l *oneConnListener) Close() error {
+	l.close()
+	return nil
+}
+
+func (l *oneConnListener) Addr() net.Addr {
+	l.Lock()
+	defer l.Unlock()
+	if l.conn != nil {
+		return l.conn.LocalAddr()
+	}
+	return &net.TCPAddr{}
+}
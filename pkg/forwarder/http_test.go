@@ -0,0 +1,106 @@
+package forwarder
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
+	"github.com/telepresenceio/telepresence/v2/pkg/restapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+func TestHTTPPreviewOnly(t *testing.T) {
+	// Streams outlive the test, so a test logger cannot be used.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The application container answers all requests.
+	app, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	defer app.Close()
+	go func() {
+		_ = http.Serve(app, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, "workload")
+		}))
+	}()
+
+	f := NewInterceptor(&net.TCPAddr{IP: net.IP{127, 0, 0, 1}}, "127.0.0.1", uint16(app.Addr().(*net.TCPAddr).Port))
+	initCh := make(chan net.Addr, 1)
+	go func() {
+		_ = f.Serve(ctx, initCh)
+	}()
+	lAddr := <-initCh
+
+	pp := &pipeProvider{peers: make(chan tunnel.Stream, 1)}
+	f.SetStreamProvider(pp)
+	f.SetHTTPIntercepts([]*HTTPIntercept{{
+		InterceptInfo: &manager.InterceptInfo{
+			Id: "session-01:preview",
+			Spec: &manager.InterceptSpec{
+				Name:          "preview",
+				Client:        "alice@host1",
+				Mechanism:     "http",
+				MechanismArgs: []string{"--" + matcher.PreviewOnlyFlag},
+				TargetHost:    "127.0.0.1",
+				TargetPort:    8080,
+			},
+			ClientSession: &manager.SessionInfo{SessionId: "session-01"},
+		},
+		Matcher: matcher.NewPreviewOnlyRequest(),
+	}})
+
+	url := "http://" + lAddr.String() + "/api"
+	hc := &http.Client{Timeout: 5 * time.Second, Transport: &http.Transport{DisableKeepAlives: true}}
+	get := func(headers http.Header) (*http.Response, error) {
+		rq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		require.NoError(t, err)
+		for k, vs := range headers {
+			rq.Header[k] = vs
+		}
+		return hc.Do(rq)
+	}
+
+	// Requests that don't use the preview URL are served by the workload.
+	for _, h := range []http.Header{
+		nil,
+		{"X-Dev": {"alice"}},
+		{http.CanonicalHeaderKey(restapi.HeaderInterceptID): {"session-02:preview"}},
+	} {
+		rsp, err := get(h)
+		require.NoError(t, err)
+		body, err := io.ReadAll(rsp.Body)
+		_ = rsp.Body.Close()
+		require.NoError(t, err)
+		assert.Equal(t, "workload", string(body))
+	}
+	select {
+	case <-pp.peers:
+		t.Fatal("a request that didn't use the preview URL was intercepted")
+	default:
+	}
+
+	// Requests that use the preview URL are sent to the client.
+	go func() {
+		if rsp, err := get(http.Header{http.CanonicalHeaderKey(restapi.HeaderInterceptID): {"session-01:preview"}}); err == nil {
+			_ = rsp.Body.Close()
+		}
+	}()
+	var peer tunnel.Stream
+	select {
+	case peer = <-pp.peers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no intercept stream was created")
+	}
+	assert.Equal(t, uint16(8080), peer.ID().DestinationPort())
+	m, err := peer.Receive(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, string(m.Payload()), "GET /api")
+}
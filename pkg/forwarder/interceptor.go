@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math/rand"
 	"net"
+	"net/http"
 	"sync"
 
 	"github.com/datawire/dlib/dlog"
 	"github.com/telepresenceio/telepresence/rpc/v2/manager"
 	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/matcher"
 	"github.com/telepresenceio/telepresence/v2/pkg/restapi"
 	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
 )
@@ -20,6 +23,8 @@ type Interceptor interface {
 	InterceptInfo() *restapi.InterceptInfo
 	Serve(context.Context, chan<- net.Addr) error
 	SetIntercepting(*manager.InterceptInfo)
+	SetHTTPIntercepts([]*HTTPIntercept)
+	SetSourceIntercepts([]*SourceIntercept, SourceLookup)
 	SetStreamProvider(tunnel.ClientStreamProvider)
 	Target() (string, uint16)
 }
@@ -37,7 +42,37 @@ type interceptor struct {
 	targetPort     uint16
 	streamProvider tunnel.ClientStreamProvider
 
-	intercept *manager.InterceptInfo
+	intercept        *manager.InterceptInfo
+	httpIntercepts   []*HTTPIntercept
+	sourceIntercepts []*SourceIntercept
+	sourceLookup     SourceLookup
+}
+
+// HTTPIntercept is an intercept that only receives the HTTP requests that are matched by its Matcher.
+type HTTPIntercept struct {
+	*manager.InterceptInfo
+	Matcher matcher.Request
+}
+
+// Matches returns true if the given path and headers are matched by the Matcher, or if the headers
+// contain an x-telepresence-intercept-id that is equal to the ID of the intercept. The latter is the
+// case for requests that arrive using the intercept's preview URL.
+func (hi *HTTPIntercept) Matches(path string, headers http.Header) bool {
+	return headers.Get(restapi.HeaderInterceptID) == hi.Id || hi.Matcher.Matches(path, headers)
+}
+
+// Selected returns true if a request with the given headers is sent to the client of this intercept,
+// given that it matches. Matching requests are sampled according to the intercept's weight, except
+// for those that arrive using the intercept's preview URL.
+func (hi *HTTPIntercept) Selected(headers http.Header) bool {
+	return headers.Get(restapi.HeaderInterceptID) == hi.Id || sampled(hi.InterceptInfo)
+}
+
+// sampled returns true if a new connection or request is sent to the client of the given intercept
+// according to the intercept's weight.
+func sampled(ii *manager.InterceptInfo) bool {
+	w := ii.Spec.Weight
+	return w <= 0 || w >= 100 || rand.Int31n(100) < w //nolint:gosec // not used for security
 }
 
 func NewInterceptor(addr net.Addr, targetHost string, targetPort uint16) Interceptor {
@@ -109,6 +144,9 @@ func (f *interceptor) SetIntercepting(intercept *manager.InterceptInfo) {
 				iputil.JoinHostPort(f.targetHost, f.targetPort), iceptInfo(intercept))
 		} else {
 			if f.intercept.Id == intercept.Id {
+				// Same intercept, but its spec might have been updated. Existing connections are
+				// retained and new connections will use the updated spec.
+				f.intercept = intercept
 				return
 			}
 			dlog.Debugf(f.lCtx, "Forward target changed from intercept %s to intercept %q", iceptInfo(f.intercept), iceptInfo(intercept))
@@ -122,3 +160,17 @@ func (f *interceptor) SetIntercepting(intercept *manager.InterceptInfo) {
 	f.tCtx, f.tCancel = context.WithCancel(f.lCtx)
 	f.intercept = intercept
 }
+
+func (f *interceptor) SetHTTPIntercepts(intercepts []*HTTPIntercept) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.tCancel != nil && (len(f.httpIntercepts) == 0) != (len(intercepts) == 0) {
+		// Connections that are established using a plain TCP forward must be dropped when
+		// HTTP intercepts appear, and vice versa.
+		dlog.Debugf(f.lCtx, "Forward target changed from %d to %d HTTP intercepts", len(f.httpIntercepts), len(intercepts))
+		f.tCancel()
+		f.tCtx, f.tCancel = context.WithCancel(f.lCtx)
+	}
+	f.httpIntercepts = intercepts
+}
@@ -0,0 +1,37 @@
+package forwarder
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/restapi"
+)
+
+func TestSampled(t *testing.T) {
+	count := func(weight int32) int {
+		ii := &manager.InterceptInfo{Spec: &manager.InterceptSpec{Weight: weight}}
+		n := 0
+		for i := 0; i < 10000; i++ {
+			if sampled(ii) {
+				n++
+			}
+		}
+		return n
+	}
+	assert.Equal(t, 10000, count(0))
+	assert.Equal(t, 10000, count(100))
+	n := count(30)
+	assert.Greater(t, n, 2500)
+	assert.Less(t, n, 3500)
+
+	// Requests using the preview URL are always selected
+	hi := &HTTPIntercept{InterceptInfo: &manager.InterceptInfo{Id: "s1:hello", Spec: &manager.InterceptSpec{Weight: 1}}}
+	h := http.Header{}
+	h.Set(restapi.HeaderInterceptID, "s1:hello")
+	for i := 0; i < 100; i++ {
+		assert.True(t, hi.Selected(h))
+	}
+}
@@ -0,0 +1,206 @@
+package forwarder
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// mirrorBufferSize is the number of chunks of data that a mirror buffers while the stream to the
+// client is established or busy. The mirror is dropped when the buffer is full, so that a slow
+// client never slows down the caller of the mirrored connection.
+const mirrorBufferSize = 128
+
+// mirrorIdleTimeout is the time after which the mirror of a UDP source address that hasn't sent
+// anything is closed.
+var mirrorIdleTimeout = time.Minute //nolint:gochecknoglobals // changed by tests
+
+// mirror is an io.Writer that sends a copy of the data that is written to it to the client of a
+// mirrored intercept. Writes never block and never fail.
+type mirror struct {
+	ctx       context.Context
+	mu        sync.Mutex
+	ch        chan []byte
+	stopped   bool
+	lastWrite time.Time
+}
+
+// startMirror returns a mirror that sends what's written to it to the client of the given intercept,
+// using a stream that appears to originate from the given address. The client's responses are discarded.
+func (f *tcp) startMirror(ctx context.Context, addr net.Addr, iCept *manager.InterceptInfo) *mirror {
+	m := &mirror{ctx: ctx, ch: make(chan []byte, mirrorBufferSize)}
+	go func() {
+		ctx, cancel := context.WithCancel(ctx)
+		s, err := f.createClientStream(ctx, addr, iCept)
+		if err != nil {
+			cancel()
+			dlog.Errorf(ctx, "unable to mirror connection from %s: %v", addr, err)
+			m.close()
+			for range m.ch {
+			}
+			return
+		}
+
+		// The stream is served using one end of a pipe. The data is written to, and the responses
+		// discarded from, the other end.
+		local, remote := net.Pipe()
+		go func() {
+			_, _ = io.Copy(io.Discard, local)
+		}()
+		go f.serveStream(ctx, cancel, s, remote, iCept)
+		for data := range m.ch {
+			if _, err := local.Write(data); err != nil {
+				dlog.Debugf(ctx, "mirror of connection from %s ended: %v", addr, err)
+				m.close()
+				for range m.ch {
+				}
+				break
+			}
+		}
+		_ = local.Close()
+	}()
+	return m
+}
+
+// Write sends a copy of the given data to the mirror, unless the mirror has been stopped.
+func (m *mirror) Write(data []byte) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastWrite = time.Now()
+	if !m.stopped {
+		select {
+		case m.ch <- append([]byte(nil), data...):
+		default:
+			dlog.Warn(m.ctx, "dropping mirror because the client cannot keep up")
+			m.stopLocked()
+		}
+	}
+	return len(data), nil
+}
+
+// close ends the mirror once the buffered data has been sent. It's safe to call more than once.
+func (m *mirror) close() {
+	m.mu.Lock()
+	m.stopLocked()
+	m.mu.Unlock()
+}
+
+// expired returns true if the mirror has been stopped, or if nothing has been written to it since
+// the given time.
+func (m *mirror) expired(since time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stopped || m.lastWrite.Before(since)
+}
+
+func (m *mirror) stopLocked() {
+	if !m.stopped {
+		m.stopped = true
+		close(m.ch)
+	}
+}
+
+// mirrorConn forwards the packets that arrive on the given connection to the target of this forwarder,
+// just like when nothing is intercepted, and sends a copy of them to the client of the given intercept.
+// Each source address gets a stream of its own, which is closed when the source address has been idle
+// for mirrorIdleTimeout, or when the stream ends. The client's responses are discarded.
+func (f *udp) mirrorConn(ctx context.Context, conn *net.UDPConn, iCept *manager.InterceptInfo) error {
+	targetAddr, err := net.ResolveUDPAddr("udp", iputil.JoinHostPort(f.targetHost, f.targetPort))
+	if err != nil {
+		return err
+	}
+	spec := iCept.Spec
+	dest := &net.UDPAddr{IP: iputil.Parse(spec.TargetHost), Port: int(spec.TargetPort)}
+	var mu sync.Mutex
+	mirrors := make(map[string]*mirror)
+	defer func() {
+		mu.Lock()
+		for _, m := range mirrors {
+			m.close()
+		}
+		mu.Unlock()
+	}()
+
+	go func() {
+		ticker := time.NewTicker(mirrorIdleTimeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				mu.Lock()
+				for key, m := range mirrors {
+					if m.expired(now.Add(-mirrorIdleTimeout)) {
+						m.close()
+						delete(mirrors, key)
+					}
+				}
+				mu.Unlock()
+			}
+		}
+	}()
+
+	dlog.Infof(ctx, "Mirroring udp from %s to %s %s", conn.LocalAddr(), spec.Client, dest)
+	return forwardUDP(ctx, conn, targetAddr, func(src *net.UDPAddr, payload []byte) {
+		key := src.String()
+		mu.Lock()
+		m, ok := mirrors[key]
+		if !ok {
+			m = f.startMirror(ctx, tunnel.ConnIDFromUDP(src, dest), iCept)
+			mirrors[key] = m
+		}
+		mu.Unlock()
+		_, _ = m.Write(payload)
+	})
+}
+
+// startMirror returns a mirror that sends each packet that is written to it to the client of the given
+// intercept, using a stream with the given ID. The client's responses are discarded.
+func (f *udp) startMirror(ctx context.Context, id tunnel.ConnID, iCept *manager.InterceptInfo) *mirror {
+	m := &mirror{ctx: ctx, ch: make(chan []byte, mirrorBufferSize), lastWrite: time.Now()}
+	go func() {
+		ctx, cancel := context.WithCancel(ctx)
+		spec := iCept.Spec
+		f.mu.Lock()
+		sp := f.streamProvider
+		f.mu.Unlock()
+		s, err := sp.CreateClientStream(ctx, iCept.ClientSession.SessionId, id, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
+		if err != nil {
+			cancel()
+			dlog.Errorf(ctx, "unable to mirror udp %s: %v", id, err)
+			m.close()
+			for range m.ch {
+			}
+			return
+		}
+
+		// The stream ends when the client has closed its side of it.
+		go func() {
+			defer cancel()
+			for {
+				if _, err := s.Receive(ctx); err != nil {
+					return
+				}
+			}
+		}()
+		for data := range m.ch {
+			if err := s.Send(ctx, tunnel.NewMessage(tunnel.Normal, data)); err != nil {
+				dlog.Debugf(ctx, "mirror of udp %s ended: %v", id, err)
+				m.close()
+				for range m.ch {
+				}
+				break
+			}
+		}
+		_ = s.CloseSend(ctx)
+	}()
+	return m
+}
@@ -0,0 +1,199 @@
+package forwarder
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+type pipeProvider struct {
+	peers chan tunnel.Stream
+}
+
+func (p *pipeProvider) CreateClientStream(_ context.Context, sessionID string, id tunnel.ConnID, _, _ time.Duration) (tunnel.Stream, error) {
+	s, peer := tunnel.NewPipe(id, sessionID)
+	p.peers <- peer
+	return s, nil
+}
+
+func (p *pipeProvider) ReportMetrics(context.Context, *manager.TunnelMetrics) {}
+
+func TestTCPMirror(t *testing.T) {
+	// Streams outlive the test, so a test logger cannot be used.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The application container answers each connection with a pong.
+	app, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	defer app.Close()
+	go func() {
+		for {
+			conn, err := app.Accept()
+			if err != nil {
+				return
+			}
+			data, _ := io.ReadAll(conn)
+			_, _ = conn.Write(append([]byte("pong:"), data...))
+			_ = conn.Close()
+		}
+	}()
+
+	f := NewInterceptor(&net.TCPAddr{IP: net.IP{127, 0, 0, 1}}, "127.0.0.1", uint16(app.Addr().(*net.TCPAddr).Port))
+	initCh := make(chan net.Addr, 1)
+	go func() {
+		_ = f.Serve(ctx, initCh)
+	}()
+	lAddr := <-initCh
+
+	pp := &pipeProvider{peers: make(chan tunnel.Stream, 1)}
+	f.SetStreamProvider(pp)
+	f.SetIntercepting(&manager.InterceptInfo{
+		Id: "mirror-01",
+		Spec: &manager.InterceptSpec{
+			Name:       "mirror",
+			Client:     "alice@host1",
+			TargetHost: "127.0.0.1",
+			TargetPort: 8080,
+			Mirror:     true,
+		},
+		ClientSession: &manager.SessionInfo{SessionId: "session-01"},
+	})
+
+	// The caller is served by the application container.
+	conn, err := net.DialTCP("tcp", nil, lAddr.(*net.TCPAddr))
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	require.NoError(t, conn.CloseWrite())
+	rsp, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "pong:ping", string(rsp))
+	_ = conn.Close()
+
+	// The client receives a copy of the inbound data.
+	var peer tunnel.Stream
+	select {
+	case peer = <-pp.peers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no mirror stream was created")
+	}
+	assert.Equal(t, uint16(8080), peer.ID().DestinationPort())
+	var mirrored []byte
+	for {
+		m, err := peer.Receive(ctx)
+		if err != nil || m.Code() != tunnel.Normal {
+			break
+		}
+		mirrored = append(mirrored, m.Payload()...)
+		if len(mirrored) >= 4 {
+			break
+		}
+	}
+	assert.Equal(t, "ping", string(mirrored))
+}
+
+func TestUDPMirror(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func(d time.Duration) { mirrorIdleTimeout = d }(mirrorIdleTimeout)
+	mirrorIdleTimeout = 200 * time.Millisecond
+
+	// The application container answers each packet with a pong.
+	app, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	defer app.Close()
+	go func() {
+		buf := make([]byte, 0x100)
+		for {
+			n, addr, err := app.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			_, _ = app.WriteToUDP(append([]byte("pong:"), buf[:n]...), addr)
+		}
+	}()
+
+	f := NewInterceptor(&net.UDPAddr{IP: net.IP{127, 0, 0, 1}}, "127.0.0.1", uint16(app.LocalAddr().(*net.UDPAddr).Port))
+	initCh := make(chan net.Addr, 1)
+	go func() {
+		_ = f.Serve(ctx, initCh)
+	}()
+	lAddr := <-initCh
+
+	pp := &pipeProvider{peers: make(chan tunnel.Stream, 1)}
+	f.SetStreamProvider(pp)
+	f.SetIntercepting(&manager.InterceptInfo{
+		Id: "mirror-01",
+		Spec: &manager.InterceptSpec{
+			Name:       "mirror",
+			Client:     "alice@host1",
+			TargetHost: "127.0.0.1",
+			TargetPort: 5353,
+			Mirror:     true,
+		},
+		ClientSession: &manager.SessionInfo{SessionId: "session-01"},
+	})
+
+	conn, err := net.DialUDP("udp", nil, lAddr.(*net.UDPAddr))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The packet is resent until it's mirrored, because the forwarder listens anew when the intercept is set.
+	var peer tunnel.Stream
+	require.Eventually(t, func() bool {
+		if _, err := conn.Write([]byte("ping")); err != nil {
+			return false
+		}
+		select {
+		case peer = <-pp.peers:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, time.Millisecond)
+
+	// The caller is served by the application container, and the client receives a copy of the
+	// packet, using a stream from the address of the caller.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 0x100)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "pong:ping", string(buf[:n]))
+	assert.Equal(t, conn.LocalAddr().String(), peer.ID().SourceAddr().String())
+	assert.Equal(t, "127.0.0.1:5353", peer.ID().DestinationAddr().String())
+	m, err := peer.Receive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(m.Payload()))
+
+	// The stream is closed when the caller has been idle for a while.
+	rCtx, rCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer rCancel()
+	for {
+		m, err = peer.Receive(rCtx)
+		if err != nil || m.Code() != tunnel.Normal {
+			break
+		}
+	}
+	require.NoError(t, rCtx.Err(), "the idle mirror was not closed")
+
+	// A new stream is created when the caller sends again.
+	_, err = conn.Write([]byte("ping again"))
+	require.NoError(t, err)
+	select {
+	case peer = <-pp.peers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no new mirror stream was created")
+	}
+	m, err = peer.Receive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ping again", string(m.Payload()))
+}
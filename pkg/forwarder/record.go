@@ -0,0 +1,135 @@
+package forwarder
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/recording"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// recordedStream is a tunnel.Stream to the client of an intercept that records the data that passes
+// through it. Data that is sent is on its way to the intercept target, and is recorded as
+// recording.EventIn. Data that is received comes from the intercept target, and is recorded as
+// recording.EventOut. The events are sent to the client using a stream of their own.
+type recordedStream struct {
+	tunnel.Stream
+	ctx       context.Context
+	sink      tunnel.Stream
+	rec       *recording.StreamRecorder
+	closeOnce sync.Once
+}
+
+// recordStream returns the given stream unless the given intercept is recorded, in which case a stream
+// to the recording port of the client is created, and a stream that records to it is returned. The
+// recording stream ends when the given context is done, or when the returned stream is closed.
+func (f *interceptor) recordStream(ctx context.Context, s tunnel.Stream, iCept *manager.InterceptInfo) tunnel.Stream {
+	spec := iCept.Spec
+	if spec.RecordPort == 0 {
+		return s
+	}
+	id := s.ID()
+	sinkID := tunnel.NewConnID(ipproto.TCP, id.Source(), net.IP{127, 0, 0, 1}, id.SourcePort(), uint16(spec.RecordPort))
+	f.mu.Lock()
+	sp := f.streamProvider
+	f.mu.Unlock()
+	ctx, cancel := context.WithCancel(ctx)
+	sink, err := sp.CreateClientStream(ctx, iCept.ClientSession.SessionId, sinkID, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
+	if err != nil {
+		cancel()
+		dlog.Errorf(ctx, "unable to record stream %s: %v", id, err)
+		return s
+	}
+	rs := &recordedStream{Stream: s, ctx: ctx, sink: sink}
+	if rs.rec, err = recording.NewStreamRecorder(rs, id); err != nil {
+		cancel()
+		dlog.Errorf(ctx, "unable to record stream %s: %v", id, err)
+		return s
+	}
+
+	// The client never sends anything but the closing of its side of the stream, which is when the
+	// stream ends.
+	go func() {
+		defer cancel()
+		for {
+			if _, err := sink.Receive(ctx); err != nil {
+				return
+			}
+		}
+	}()
+	return rs
+}
+
+// unrecorded returns the stream that the given stream records, or the given stream if it's not recorded.
+func unrecorded(s tunnel.Stream) tunnel.Stream {
+	if rs, ok := s.(*recordedStream); ok {
+		return rs.Stream
+	}
+	return s
+}
+
+// endRecording records the close event of the given stream, unless it's not recorded or the event has
+// been recorded already.
+func endRecording(s tunnel.Stream) {
+	if rs, ok := s.(*recordedStream); ok {
+		rs.close()
+	}
+}
+
+func (rs *recordedStream) Send(ctx context.Context, m tunnel.Message) error {
+	if err := rs.Stream.Send(ctx, m); err != nil {
+		return err
+	}
+	rs.record(recording.EventIn, m)
+	return nil
+}
+
+func (rs *recordedStream) Receive(ctx context.Context) (tunnel.Message, error) {
+	m, err := rs.Stream.Receive(ctx)
+	if err != nil {
+		rs.close()
+		return m, err
+	}
+	rs.record(recording.EventOut, m)
+	return m, nil
+}
+
+func (rs *recordedStream) CloseSend(ctx context.Context) error {
+	err := rs.Stream.CloseSend(ctx)
+	if rs.ID().Protocol() == ipproto.UDP {
+		// A UDP stream has no half-closed state.
+		rs.close()
+	}
+	return err
+}
+
+// Write sends the given data, which is written by the recording.StreamRecorder, to the client.
+func (rs *recordedStream) Write(data []byte) (int, error) {
+	if err := rs.sink.Send(rs.ctx, tunnel.NewMessage(tunnel.Normal, data)); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (rs *recordedStream) record(tp recording.EventType, m tunnel.Message) {
+	if m.Code() != tunnel.Normal || len(m.Payload()) == 0 {
+		return
+	}
+	if err := rs.rec.Record(tp, m.Payload()); err != nil {
+		dlog.Errorf(rs.ctx, "unable to record %s event of stream %s: %v", tp, rs.ID(), err)
+	}
+}
+
+func (rs *recordedStream) close() {
+	rs.closeOnce.Do(func() {
+		if err := rs.rec.Record(recording.EventClose, nil); err != nil {
+			dlog.Errorf(rs.ctx, "unable to record close event of stream %s: %v", rs.ID(), err)
+		}
+		_ = rs.sink.CloseSend(rs.ctx)
+	})
+}
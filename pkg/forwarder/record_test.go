@@ -0,0 +1,123 @@
+package forwarder
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/recording"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+func TestTCPRecord(t *testing.T) {
+	// Streams outlive the test, so a test logger cannot be used.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f := NewInterceptor(&net.TCPAddr{IP: net.IP{127, 0, 0, 1}}, "127.0.0.1", 1)
+	initCh := make(chan net.Addr, 1)
+	go func() {
+		_ = f.Serve(ctx, initCh)
+	}()
+	lAddr := <-initCh
+
+	pp := &pipeProvider{peers: make(chan tunnel.Stream, 2)}
+	f.SetStreamProvider(pp)
+	f.SetIntercepting(&manager.InterceptInfo{
+		Id: "record-01",
+		Spec: &manager.InterceptSpec{
+			Name:       "record",
+			Client:     "alice@host1",
+			TargetHost: "127.0.0.1",
+			TargetPort: 8080,
+			RecordPort: 4711,
+		},
+		ClientSession: &manager.SessionInfo{SessionId: "session-01"},
+	})
+
+	conn, err := net.DialTCP("tcp", nil, lAddr.(*net.TCPAddr))
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	nextPeer := func() tunnel.Stream {
+		select {
+		case peer := <-pp.peers:
+			return peer
+		case <-time.After(5 * time.Second):
+			t.Fatal("no stream was created")
+			return nil
+		}
+	}
+
+	// The intercept target gets the stream of the intercepted connection, and the recording port
+	// gets the recorded events of that stream.
+	peer := nextPeer()
+	sink := nextPeer()
+	id := peer.ID()
+	assert.Equal(t, conn.LocalAddr().String(), id.SourceAddr().String())
+	assert.Equal(t, "127.0.0.1:8080", id.DestinationAddr().String())
+	assert.Equal(t, id.SourceAddr().String(), sink.ID().SourceAddr().String())
+	assert.Equal(t, uint16(4711), sink.ID().DestinationPort())
+
+	sb := &bytes.Buffer{}
+	sinkDone := make(chan struct{})
+	go func() {
+		defer close(sinkDone)
+		for {
+			m, err := sink.Receive(ctx)
+			if err != nil || m.Code() != tunnel.Normal {
+				return
+			}
+			sb.Write(m.Payload())
+		}
+	}()
+
+	m, err := peer.Receive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(m.Payload()))
+	require.NoError(t, peer.Send(ctx, tunnel.NewMessage(tunnel.Normal, []byte("pong"))))
+	rsp := make([]byte, 4)
+	_, err = io.ReadFull(conn, rsp)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(rsp))
+	_ = conn.Close()
+
+	// The client closes its side of the stream when the caller has closed its side.
+	for {
+		if m, err := peer.Receive(ctx); err != nil || m.Code() != tunnel.Normal {
+			break
+		}
+	}
+	_ = peer.CloseSend(ctx)
+
+	select {
+	case <-sinkDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the recording stream was not closed")
+	}
+	buf := &bytes.Buffer{}
+	w, err := recording.NewWriter(buf, "record")
+	require.NoError(t, err)
+	require.NoError(t, w.Copy(sb))
+	_, events, err := recording.Read(buf)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	for _, ev := range events {
+		assert.Equal(t, id.String(), ev.ConnID)
+	}
+	assert.Equal(t, recording.EventOpen, events[0].Type)
+	assert.Equal(t, recording.EventIn, events[1].Type)
+	assert.Equal(t, "ping", string(events[1].Payload))
+	assert.Equal(t, recording.EventOut, events[2].Type)
+	assert.Equal(t, "pong", string(events[2].Payload))
+	assert.Equal(t, recording.EventClose, events[3].Type)
+}
@@ -0,0 +1,90 @@
+package forwarder
+
+import (
+	"context"
+	"net"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+// SourceLookup returns information about the pod that has the given IP.
+type SourceLookup func(context.Context, net.IP) (*manager.SourceInfo, error)
+
+// SourceIntercept is an intercept that only receives the connections that originate from the pods
+// that are selected by the source selector of its spec.
+type SourceIntercept struct {
+	*manager.InterceptInfo
+}
+
+// Selects returns true if the given source is a pod that is selected by this intercept.
+func (si *SourceIntercept) Selects(src *manager.SourceInfo) bool {
+	sel := si.Spec.Source
+	if sel == nil || src.GetName() == "" {
+		return false
+	}
+	if sel.Namespace != "" && sel.Namespace != src.Namespace {
+		return false
+	}
+	if sel.ServiceAccount != "" && sel.ServiceAccount != src.ServiceAccount {
+		return false
+	}
+	for k, v := range sel.Labels {
+		if lv, ok := src.Labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *interceptor) SetSourceIntercepts(intercepts []*SourceIntercept, lookup SourceLookup) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.tCancel != nil {
+		// Connections that are sent to a source intercept that is removed must be dropped. Other
+		// connections are retained.
+		for _, old := range f.sourceIntercepts {
+			found := false
+			for _, si := range intercepts {
+				if si.Id == old.Id {
+					found = true
+					break
+				}
+			}
+			if !found {
+				dlog.Debugf(f.lCtx, "Source intercept %s removed", old.Spec.Name)
+				f.tCancel()
+				f.tCtx, f.tCancel = context.WithCancel(f.lCtx)
+				break
+			}
+		}
+	}
+	f.sourceIntercepts = intercepts
+	f.sourceLookup = lookup
+}
+
+// selectSource returns the first of the given intercepts that selects the pod that the given address
+// belongs to, or nil if no intercept selects it.
+func selectSource(ctx context.Context, addr net.Addr, intercepts []*SourceIntercept, lookup SourceLookup) *manager.InterceptInfo {
+	if len(intercepts) == 0 || lookup == nil {
+		return nil
+	}
+	ip, _, err := iputil.SplitToIPPort(addr)
+	if err != nil {
+		return nil
+	}
+	src, err := lookup(ctx, ip)
+	if err != nil {
+		dlog.Errorf(ctx, "unable to lookup source %s: %v", ip, err)
+		return nil
+	}
+	for _, si := range intercepts {
+		if si.Selects(src) {
+			dlog.Debugf(ctx, "Connection from %s.%s is selected by intercept %s", src.Name, src.Namespace, si.Spec.Name)
+			return si.InterceptInfo
+		}
+	}
+	return nil
+}
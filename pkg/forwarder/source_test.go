@@ -0,0 +1,41 @@
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+func TestSourceIntercept_Selects(t *testing.T) {
+	src := &manager.SourceInfo{
+		Name:           "tester-7d9f",
+		Namespace:      "alice",
+		Labels:         map[string]string{"app": "tester", "tier": "test"},
+		ServiceAccount: "tester",
+	}
+	tests := []struct {
+		name string
+		sel  *manager.SourceSelector
+		src  *manager.SourceInfo
+		want bool
+	}{
+		{"no selector", nil, src, false},
+		{"unknown source", &manager.SourceSelector{Namespace: "alice"}, &manager.SourceInfo{}, false},
+		{"namespace", &manager.SourceSelector{Namespace: "alice"}, src, true},
+		{"other namespace", &manager.SourceSelector{Namespace: "bob"}, src, false},
+		{"service account", &manager.SourceSelector{ServiceAccount: "tester"}, src, true},
+		{"other service account", &manager.SourceSelector{ServiceAccount: "default"}, src, false},
+		{"labels", &manager.SourceSelector{Labels: map[string]string{"app": "tester"}}, src, true},
+		{"other label value", &manager.SourceSelector{Labels: map[string]string{"app": "web"}}, src, false},
+		{"missing label", &manager.SourceSelector{Labels: map[string]string{"app": "tester", "dev": "alice"}}, src, false},
+		{"all", &manager.SourceSelector{Labels: map[string]string{"tier": "test"}, Namespace: "alice", ServiceAccount: "tester"}, src, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			si := &SourceIntercept{InterceptInfo: &manager.InterceptInfo{Spec: &manager.InterceptSpec{Source: tt.sel}}}
+			assert.Equal(t, tt.want, si.Selects(tt.src))
+		})
+	}
+}
@@ -98,8 +98,18 @@ func (f *tcp) forwardConn(clientConn *net.TCPConn) error {
 	targetHost := f.targetHost
 	targetPort := f.targetPort
 	intercept := f.intercept
+	httpIntercepts := len(f.httpIntercepts) > 0
+	sourceIntercepts := f.sourceIntercepts
+	sourceLookup := f.sourceLookup
 	f.mu.Unlock()
-	if intercept != nil {
+	if intercept == nil {
+		intercept = selectSource(ctx, clientConn.RemoteAddr(), sourceIntercepts, sourceLookup)
+	}
+	if intercept != nil && !sampled(intercept) {
+		// The intercept has a weight, and this connection is served by the target.
+		intercept = nil
+	}
+	if intercept != nil && !intercept.Spec.Mirror {
 		return f.interceptConn(ctx, clientConn, intercept)
 	}
 
@@ -120,19 +130,35 @@ func (f *tcp) forwardConn(clientConn *net.TCPConn) error {
 
 	defer clientConn.Close()
 
+	if httpIntercepts {
+		return f.forwardHTTP(ctx, clientConn, targetAddr)
+	}
+
 	targetConn, err := net.DialTCP("tcp", nil, targetAddr)
 	if err != nil {
 		return fmt.Errorf("error on dial: %w", err)
 	}
 	defer targetConn.Close()
 
+	var inbound io.Writer = targetConn
+	var m *mirror
+	if intercept != nil {
+		// The connection is mirrored. The caller is served by the target and the client gets a copy.
+		m = f.startMirror(ctx, clientConn.RemoteAddr(), intercept)
+		defer m.close()
+		inbound = io.MultiWriter(targetConn, m)
+	}
+
 	done := make(chan struct{})
 
 	go func() {
-		if _, err := io.Copy(targetConn, clientConn); err != nil {
+		if _, err := io.Copy(inbound, clientConn); err != nil {
 			dlog.Debugf(ctx, "Error clientConn->targetConn: %+v", err)
 		}
 		_ = targetConn.CloseWrite()
+		if m != nil {
+			m.close()
+		}
 		done <- struct{}{}
 	}()
 	go func() {
@@ -163,26 +189,39 @@ func (f *tcp) interceptConn(ctx context.Context, conn net.Conn, iCept *manager.I
 	dlog.Debugf(ctx, "Accept got connection from %s", addr)
 	defer dlog.Debugf(ctx, "Done serving connection from %s", addr)
 
+	sCtx, cancel := context.WithCancel(ctx)
+	s, err := f.createClientStream(sCtx, addr, iCept)
+	if err != nil {
+		cancel()
+		return err
+	}
+	s.ID().SpanRecord(span)
+
+	// The recording must outlive the stream so that its close event reaches the client.
+	f.serveStream(sCtx, cancel, f.recordStream(ctx, s, iCept), conn, iCept)
+	return nil
+}
+
+// createClientStream creates a stream to the client of the given intercept. The stream's ID is based
+// on the given source address and the intercept's target host and port.
+func (f *tcp) createClientStream(ctx context.Context, addr net.Addr, iCept *manager.InterceptInfo) (tunnel.Stream, error) {
 	srcIp, srcPort, err := iputil.SplitToIPPort(addr)
 	if err != nil {
-		return fmt.Errorf("failed to parse intercept source address %s: %w", addr, err)
+		return nil, fmt.Errorf("failed to parse intercept source address %s: %w", addr, err)
 	}
 
 	spec := iCept.Spec
 	destIp := iputil.Parse(spec.TargetHost)
-	clientSession := iCept.ClientSession.SessionId
 	id := tunnel.NewConnID(ipproto.Parse(addr.Network()), srcIp, destIp, srcPort, uint16(spec.TargetPort))
-	id.SpanRecord(span)
-	ctx, cancel := context.WithCancel(ctx)
 	f.mu.Lock()
 	sp := f.streamProvider
 	f.mu.Unlock()
-	s, err := sp.CreateClientStream(ctx, clientSession, id, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
-	if err != nil {
-		cancel()
-		return err
-	}
+	return sp.CreateClientStream(ctx, iCept.ClientSession.SessionId, id, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
+}
 
+// serveStream connects the given stream with the given connection and waits until they are done. Metrics
+// for the transferred bytes are reported when that happens.
+func (f *tcp) serveStream(ctx context.Context, cancel context.CancelFunc, s tunnel.Stream, conn net.Conn, iCept *manager.InterceptInfo) {
 	ingressBytes := tunnel.NewCounterProbe("FromClientBytes")
 	egressBytes := tunnel.NewCounterProbe("ToClientBytes")
 
@@ -191,11 +230,17 @@ func (f *tcp) interceptConn(ctx context.Context, conn net.Conn, iCept *manager.I
 	d := tunnel.NewConnEndpoint(s, conn, cancel, egressBytes, ingressBytes)
 	d.Start(ctx)
 	<-d.Done()
+	endRecording(s)
 
+	f.mu.Lock()
+	sp := f.streamProvider
+	f.mu.Unlock()
+	ingressWireBytes, egressWireBytes, _ := tunnel.WireBytes(unrecorded(s))
 	sp.ReportMetrics(ctx, &manager.TunnelMetrics{
-		ClientSessionId: clientSession,
-		IngressBytes:    ingressBytes.GetValue(),
-		EgressBytes:     egressBytes.GetValue(),
+		ClientSessionId:  iCept.ClientSession.SessionId,
+		IngressBytes:     ingressBytes.GetValue(),
+		EgressBytes:      egressBytes.GetValue(),
+		IngressWireBytes: ingressWireBytes,
+		EgressWireBytes:  egressWireBytes,
 	})
-	return nil
 }
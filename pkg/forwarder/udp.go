@@ -83,6 +83,9 @@ func (f *udp) Serve(ctx context.Context, initCh chan<- net.Addr) error {
 func (f *udp) forward(ctx context.Context, conn *net.UDPConn, intercept *manager.InterceptInfo) error {
 	defer conn.Close()
 	if intercept != nil {
+		if intercept.Spec.Mirror {
+			return f.mirrorConn(ctx, conn, intercept)
+		}
 		f.interceptConn(ctx, conn, intercept)
 		return nil
 	}
@@ -101,6 +104,12 @@ func (f *udp) forwardConn(ctx context.Context, conn *net.UDPConn) error {
 }
 
 func ForwardUDP(ctx context.Context, conn *net.UDPConn, targetAddr *net.UDPAddr) error {
+	return forwardUDP(ctx, conn, targetAddr, nil)
+}
+
+// forwardUDP is like ForwardUDP but also calls the given tee function, unless it's nil, with each
+// packet that arrives on the given connection.
+func forwardUDP(ctx context.Context, conn *net.UDPConn, targetAddr *net.UDPAddr, tee func(*net.UDPAddr, []byte)) error {
 	ctx, span := otel.Tracer("").Start(ctx, "forwardConn")
 	defer span.End()
 
@@ -140,6 +149,9 @@ func ForwardUDP(ctx context.Context, conn *net.UDPConn, targetAddr *net.UDPAddr)
 			if err != nil {
 				return err
 			}
+			if tee != nil {
+				tee(rr.Addr, rr.Payload)
+			}
 			uh := h.(*udpHandler)
 			pn := len(rr.Payload)
 			for n := 0; n < pn; {
@@ -212,7 +224,14 @@ func (f *udp) interceptConn(ctx context.Context, conn *net.UDPConn, iCept *manag
 	dlog.Infof(ctx, "Forwarding udp from %s to %s %s", conn.LocalAddr(), spec.Client, dest)
 	defer dlog.Infof(ctx, "Done forwarding udp from %s to %s %s", conn.LocalAddr(), spec.Client, dest)
 	d := tunnel.NewUDPListener(conn, dest, func(ctx context.Context, id tunnel.ConnID) (tunnel.Stream, error) {
-		return f.streamProvider.CreateClientStream(ctx, iCept.ClientSession.SessionId, id, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
+		f.mu.Lock()
+		sp := f.streamProvider
+		f.mu.Unlock()
+		s, err := sp.CreateClientStream(ctx, iCept.ClientSession.SessionId, id, time.Duration(spec.RoundtripLatency), time.Duration(spec.DialTimeout))
+		if err != nil {
+			return nil, err
+		}
+		return f.recordStream(ctx, s, iCept), nil
 	})
 	d.Start(ctx)
 	<-d.Done()
@@ -0,0 +1,78 @@
+package matcher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Flags used in the mechanism args of an intercept that uses the "http" mechanism.
+const (
+	HeaderFlag     = "http-header"
+	PathEqualFlag  = "http-path-equal"
+	PathPrefixFlag = "http-path-prefix"
+	PathRegexFlag  = "http-path-regex"
+
+	// PreviewOnlyFlag makes the intercept receive only the requests that arrive using its preview URL.
+	PreviewOnlyFlag = "http-preview-only"
+)
+
+// NewRequestFromArgs creates a new Request from the mechanism args of an intercept. The args are
+// flags in the form --<flag>=<value> where the flag is one of:
+//
+//	--http-header: a <name>=<value> pair where the value is an exact or regexp Value. Can be repeated.
+//	--http-path-equal: path will match if equal to the value
+//	--http-path-prefix: path will match prefixed by the value
+//	--http-path-regex: path will match it matches the regexp value
+//	--http-preview-only: nothing will match, so only requests using the preview URL are intercepted
+//
+// At most one of the path flags can be given, and --http-preview-only cannot be combined with other flags.
+func NewRequestFromArgs(args []string) (Request, error) {
+	var headers []string
+	var pathEqual, pathPrefix, pathRegex string
+	var previewOnly bool
+	flags := pflag.NewFlagSet("http", pflag.ContinueOnError)
+	flags.StringArrayVar(&headers, HeaderFlag, nil, "")
+	flags.StringVar(&pathEqual, PathEqualFlag, "", "")
+	flags.StringVar(&pathPrefix, PathPrefixFlag, "", "")
+	flags.StringVar(&pathRegex, PathRegexFlag, "", "")
+	flags.BoolVar(&previewOnly, PreviewOnlyFlag, false, "")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected mechanism argument %q", flags.Arg(0))
+	}
+	if previewOnly {
+		if flags.NFlag() > 1 {
+			return nil, fmt.Errorf("--%s cannot be combined with other flags", PreviewOnlyFlag)
+		}
+		return NewPreviewOnlyRequest(), nil
+	}
+
+	m := make(map[string]string, len(headers)+1)
+	for _, h := range headers {
+		k, v, ok := strings.Cut(h, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("--%s %q is not in the form <name>=<value>", HeaderFlag, h)
+		}
+		m[k] = v
+	}
+
+	pathFlags := 0
+	for _, pf := range []struct{ flag, key, value string }{
+		{PathEqualFlag, ":path-equal:", pathEqual},
+		{PathPrefixFlag, ":path-prefix:", pathPrefix},
+		{PathRegexFlag, ":path-regex:", pathRegex},
+	} {
+		if flags.Changed(pf.flag) {
+			pathFlags++
+			m[pf.key] = pf.value
+		}
+	}
+	if pathFlags > 1 {
+		return nil, fmt.Errorf("only one of --%s, --%s, and --%s can be used", PathEqualFlag, PathPrefixFlag, PathRegexFlag)
+	}
+	return NewRequestFromMap(m)
+}
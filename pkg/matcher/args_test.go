@@ -0,0 +1,85 @@
+package matcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequestFromArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "empty",
+			args: nil,
+			want: nil,
+		},
+		{
+			name: "headers",
+			args: []string{"--http-header=x-dev=alice", "--http-header", "x-color=blue|green"},
+			want: map[string]string{"X-Dev": "alice", "X-Color": "blue|green"},
+		},
+		{
+			name: "header value with equal sign",
+			args: []string{"--http-header=x-query=a=b"},
+			want: map[string]string{"X-Query": "a=b"},
+		},
+		{
+			name: "path prefix and header",
+			args: []string{"--http-path-prefix=/api", "--http-header=x-dev=alice"},
+			want: map[string]string{":path-prefix:": "/api", "X-Dev": "alice"},
+		},
+		{
+			name: "preview only",
+			args: []string{"--http-preview-only"},
+			want: map[string]string{":preview-only:": "true"},
+		},
+		{
+			name:    "preview only and header",
+			args:    []string{"--http-preview-only", "--http-header=x-dev=alice"},
+			wantErr: "--http-preview-only cannot be combined with other flags",
+		},
+		{
+			name:    "header without value",
+			args:    []string{"--http-header=x-dev"},
+			wantErr: `--http-header "x-dev" is not in the form <name>=<value>`,
+		},
+		{
+			name:    "multiple paths",
+			args:    []string{"--http-path-prefix=/api", "--http-path-equal=/api/v1"},
+			wantErr: "only one of --http-path-equal, --http-path-prefix, and --http-path-regex can be used",
+		},
+		{
+			name:    "bad regex",
+			args:    []string{"--http-path-regex=/api/(v1"},
+			wantErr: "error parsing regexp",
+		},
+		{
+			name:    "unknown flag",
+			args:    []string{"--http-method=GET"},
+			wantErr: "unknown flag: --http-method",
+		},
+		{
+			name:    "positional",
+			args:    []string{"x-dev=alice"},
+			wantErr: `unexpected mechanism argument "x-dev=alice"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRequestFromArgs(tt.args)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Map())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package matcher
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Flags used in the mechanism args of an intercept that uses the "grpc" mechanism.
+const (
+	GRPCMethodFlag   = "grpc-method"
+	GRPCMetadataFlag = "grpc-metadata"
+)
+
+// grpcRequest is a Request that only matches gRPC calls.
+type grpcRequest struct {
+	*request
+	method   string
+	metadata []string
+}
+
+// NewGRPCRequestFromArgs creates a new Request that matches gRPC calls from the mechanism args of an
+// intercept. The args are flags in the form --<flag>=<value> where the flag is one of:
+//
+//	--grpc-method: a fully qualified method name, such as /orders.v1.OrderService/Create, or a pattern
+//	               that matches all methods of a service, such as /orders.v1.OrderService/*
+//	--grpc-metadata: a <name>=<value> pair where the value is an exact or regexp Value. Can be repeated.
+//
+// All gRPC calls are matched when no method is given.
+func NewGRPCRequestFromArgs(args []string) (Request, error) {
+	var method string
+	var metadata []string
+	flags := pflag.NewFlagSet("grpc", pflag.ContinueOnError)
+	flags.StringVar(&method, GRPCMethodFlag, "", "")
+	flags.StringArrayVar(&metadata, GRPCMetadataFlag, nil, "")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected mechanism argument %q", flags.Arg(0))
+	}
+
+	var path Value
+	if method != "" && method != "*" && method != "/*" {
+		if !strings.HasPrefix(method, "/") {
+			method = "/" + method
+		}
+		svc, mth, ok := strings.Cut(method[1:], "/")
+		if !ok || svc == "" || mth == "" || strings.Contains(svc, "*") || strings.Contains(mth, "/") {
+			return nil, fmt.Errorf("--%s %q is not in the form /<service>/<method> or /<service>/*", GRPCMethodFlag, method)
+		}
+		switch {
+		case mth == "*":
+			path = NewPrefix("/" + svc + "/")
+		case strings.Contains(mth, "*"):
+			return nil, fmt.Errorf("--%s %q can only use * in place of the method name", GRPCMethodFlag, method)
+		default:
+			path = NewEqual(method)
+		}
+	} else {
+		method = ""
+	}
+
+	hm := make(HeaderMap, len(metadata))
+	for _, md := range metadata {
+		k, v, ok := strings.Cut(md, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("--%s %q is not in the form <name>=<value>", GRPCMetadataFlag, md)
+		}
+		vm, err := NewValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("the value of match %s=%s is invalid: %w", k, v, err)
+		}
+		hm[http.CanonicalHeaderKey(k)] = vm
+	}
+	sort.Strings(metadata)
+	return &grpcRequest{request: NewRequest(path, hm).(*request), method: method, metadata: metadata}, nil
+}
+
+// NewRequestFromMechanismArgs creates a new Request from the mechanism and the mechanism args of an intercept
+// that uses either the "http" or the "grpc" mechanism.
+func NewRequestFromMechanismArgs(mechanism string, args []string) (Request, error) {
+	switch mechanism {
+	case "http":
+		return NewRequestFromArgs(args)
+	case "grpc":
+		return NewGRPCRequestFromArgs(args)
+	default:
+		return nil, fmt.Errorf("mechanism %q does not match requests", mechanism)
+	}
+}
+
+// IsGRPC returns true if the given headers are the headers of a gRPC call.
+func IsGRPC(headers http.Header) bool {
+	ct := headers.Get("Content-Type")
+	return ct == "application/grpc" || strings.HasPrefix(ct, "application/grpc+") || strings.HasPrefix(ct, "application/grpc;")
+}
+
+// Matches returns true if the given path and headers are those of a gRPC call that is matched by the
+// method and metadata of this instance.
+func (g *grpcRequest) Matches(path string, headers http.Header) bool {
+	return IsGRPC(headers) && g.request.Matches(path, headers)
+}
+
+func (g *grpcRequest) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("gRPC calls")
+	if g.method != "" {
+		sb.WriteString(" to ")
+		sb.WriteString(g.method)
+	}
+	if len(g.metadata) > 0 {
+		sb.WriteString(" with metadata ")
+		sb.WriteString(strings.Join(g.metadata, ", "))
+	}
+	return sb.String()
+}
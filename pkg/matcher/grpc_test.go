@@ -0,0 +1,82 @@
+package matcher
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGRPCRequestFromArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		desc    string
+		wantErr string
+	}{
+		{
+			name: "empty",
+			desc: "gRPC calls",
+		},
+		{
+			name: "method",
+			args: []string{"--grpc-method=/orders.v1.OrderService/Create"},
+			desc: "gRPC calls to /orders.v1.OrderService/Create",
+		},
+		{
+			name: "service pattern and metadata",
+			args: []string{"--grpc-method", "orders.v1.OrderService/*", "--grpc-metadata=x-dev=alice"},
+			desc: "gRPC calls to /orders.v1.OrderService/* with metadata x-dev=alice",
+		},
+		{
+			name:    "no method",
+			args:    []string{"--grpc-method=/orders.v1.OrderService"},
+			wantErr: `is not in the form /<service>/<method> or /<service>/*`,
+		},
+		{
+			name:    "partial method pattern",
+			args:    []string{"--grpc-method=/orders.v1.OrderService/Get*"},
+			wantErr: `can only use * in place of the method name`,
+		},
+		{
+			name:    "metadata without value",
+			args:    []string{"--grpc-metadata=x-dev"},
+			wantErr: `--grpc-metadata "x-dev" is not in the form <name>=<value>`,
+		},
+		{
+			name:    "http flag",
+			args:    []string{"--http-header=x-dev=alice"},
+			wantErr: "unknown flag: --http-header",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewGRPCRequestFromArgs(tt.args)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.desc, got.String())
+		})
+	}
+}
+
+func TestGRPCRequest_Matches(t *testing.T) {
+	m, err := NewGRPCRequestFromArgs([]string{"--grpc-method=/orders.v1.OrderService/*", "--grpc-metadata=x-dev=alice"})
+	require.NoError(t, err)
+
+	headers := func(contentType, dev string) http.Header {
+		h := http.Header{}
+		h.Set("Content-Type", contentType)
+		h.Set("X-Dev", dev)
+		return h
+	}
+	assert.True(t, m.Matches("/orders.v1.OrderService/Create", headers("application/grpc", "alice")))
+	assert.True(t, m.Matches("/orders.v1.OrderService/Get", headers("application/grpc+proto", "alice")))
+	assert.False(t, m.Matches("/orders.v1.OrderService/Create", headers("application/grpc", "bob")))
+	assert.False(t, m.Matches("/orders.v1.PaymentService/Create", headers("application/grpc", "alice")))
+	assert.False(t, m.Matches("/orders.v1.OrderService/Create", headers("application/json", "alice")))
+}
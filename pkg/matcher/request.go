@@ -31,15 +31,27 @@ type Request interface {
 type request struct {
 	path    Value
 	headers HeaderMap
+
+	// previewOnly is true when the request matches nothing, so that only the requests that arrive
+	// using the preview URL of an intercept are intercepted.
+	previewOnly bool
 }
 
+// previewOnlyKey is the map key of a Request that is created by NewPreviewOnlyRequest.
+const previewOnlyKey = ":preview-only:"
+
 // NewRequestFromMap creates a new Request based on the values of the given map. Aside from http headers,
 // the map may contain one of three special keys.
 //
 //	:path-equal: path will match if equal to the value
 //	:path-prefix: path will match prefixed by the value
 //	:path-regex: path will match it matches the regexp value
+//
+// A map that contains the key :preview-only: creates a Request that is equal to NewPreviewOnlyRequest.
 func NewRequestFromMap(m map[string]string) (Request, error) {
+	if _, ok := m[previewOnlyKey]; ok {
+		return NewPreviewOnlyRequest(), nil
+	}
 	var pm Value
 	hm := make(HeaderMap, len(m))
 
@@ -72,9 +84,18 @@ func NewRequest(path Value, hm HeaderMap) Request {
 	return &request{path: path, headers: hm}
 }
 
+// NewPreviewOnlyRequest creates a Request that doesn't match any request. An intercept that uses it
+// will only receive the requests that arrive using its preview URL.
+func NewPreviewOnlyRequest() Request {
+	return &request{previewOnly: true}
+}
+
 // Map returns the map correspondence of this instance. The returned value can be
 // used as an argument to NewRequest to create an identical Request.
 func (r *request) Map() map[string]string {
+	if r.previewOnly {
+		return map[string]string{previewOnlyKey: "true"}
+	}
 	var m map[string]string
 	if r.headers != nil {
 		m = r.headers.Map()
@@ -103,7 +124,7 @@ func (r *request) Headers() Headers {
 // Matches returns true if both the path Value matcher and the Headers matcher in this instance are
 // matched by the given http.Request.
 func (r *request) Matches(path string, headers http.Header) bool {
-	return r == nil || (r.path == nil || r.path.Matches(path)) && (r.headers == nil || r.headers.Matches(headers))
+	return r == nil || !r.previewOnly && (r.path == nil || r.path.Matches(path)) && (r.headers == nil || r.headers.Matches(headers))
 }
 
 // Path returns the path.
@@ -114,6 +135,9 @@ func (r *request) Path() Value {
 func (r *request) String() string {
 	sb := strings.Builder{}
 	if r == nil || r.path == nil && len(r.headers) == 0 {
+		if r != nil && r.previewOnly {
+			return "requests using the preview URL"
+		}
 		return "all requests"
 	}
 	sb.WriteString("requests with")
@@ -163,6 +163,13 @@ func Test_request_Matches(t *testing.T) {
 			path:    "/some/road",
 			want:    false,
 		},
+		{
+			name:    "preview only",
+			request: request{previewOnly: true},
+			path:    "/some/path",
+			headers: http.Header(map[string][]string{"A": {"b"}}),
+			want:    false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -181,6 +188,11 @@ func Test_request_String(t *testing.T) {
 			name: "empty",
 			want: "all requests",
 		},
+		{
+			name:    "preview only",
+			request: request{previewOnly: true},
+			want:    "requests using the preview URL",
+		},
 		{
 			name:    "path-equal",
 			request: request{path: NewEqual("/some/path")},
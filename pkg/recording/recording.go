@@ -0,0 +1,192 @@
+// Package recording contains the file format that is used when recording the traffic of an intercept,
+// together with functions that write and replay such recordings.
+//
+// A recording is a sequence of JSON objects, each on a line of its own. The first object is a Header.
+// It is followed by one Event for each stream that is opened or closed, and for each chunk of data that
+// is sent in either direction of a stream. The events are written in the order they occur. Example:
+//
+//	{"version":1,"intercept":"hello","start":"2024-03-04T10:15:00.123456Z"}
+//	{"time":1503000,"connId":"tcp 10.42.0.17:51234 -> 127.0.0.1:8080","protocol":"tcp","type":"open"}
+//	{"time":1510000,"connId":"tcp 10.42.0.17:51234 -> 127.0.0.1:8080","type":"in","payload":"R0VUIC8gSFRUUC8xLjENCg0K"}
+//	{"time":2301000,"connId":"tcp 10.42.0.17:51234 -> 127.0.0.1:8080","type":"out","payload":"SFRUUC8xLjEgMjAwIE9LDQoNCg=="}
+//	{"time":2305000,"connId":"tcp 10.42.0.17:51234 -> 127.0.0.1:8080","type":"close"}
+//
+// The connId is the tunnel.ConnID of the stream that the traffic-agent created for the intercepted
+// connection, so its source is the address of the caller in the cluster and its destination is the
+// intercept's target host and port. The time of an event is given in nanoseconds since the start of
+// the recording, and the payload is base64 encoded.
+//
+// The streams are recorded by the traffic-agent, which uses a StreamRecorder to send the events of each
+// stream to the client. The client adds them to the recording file using Writer.Copy.
+package recording
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+// Version is the version of the recording file format.
+const Version = 1
+
+// Header is the first object of a recording.
+type Header struct {
+	Version   int       `json:"version"`
+	Intercept string    `json:"intercept"`
+	Start     time.Time `json:"start"`
+}
+
+// EventType is the type of Event.
+type EventType string
+
+const (
+	// EventOpen is the type of the event that opens a stream.
+	EventOpen EventType = "open"
+
+	// EventIn is the type of the event that carries data that was sent to the intercept target.
+	EventIn EventType = "in"
+
+	// EventOut is the type of the event that carries data that was received from the intercept target.
+	EventOut EventType = "out"
+
+	// EventClose is the type of the event that closes a stream.
+	EventClose EventType = "close"
+)
+
+// Event is a recorded event of a stream.
+type Event struct {
+	// Time is the time of the event, relative to the start of the recording.
+	Time time.Duration `json:"time"`
+
+	// ConnID is the string form of the tunnel.ConnID of the stream.
+	ConnID string `json:"connId"`
+
+	// Protocol is the protocol of the stream, "tcp" or "udp". Only set for EventOpen.
+	Protocol string `json:"protocol,omitempty"`
+
+	Type    EventType `json:"type"`
+	Payload []byte    `json:"payload,omitempty"`
+}
+
+// Writer writes a recording. It is safe for concurrent use.
+type Writer struct {
+	sync.Mutex
+	enc   *json.Encoder
+	start time.Time
+}
+
+// NewWriter writes the header of a recording of the given intercept to w, and returns a Writer that
+// writes the events of that recording.
+func NewWriter(w io.Writer, intercept string) (*Writer, error) {
+	rw := &Writer{enc: json.NewEncoder(w), start: time.Now()}
+	if err := rw.enc.Encode(&Header{Version: Version, Intercept: intercept, Start: rw.start}); err != nil {
+		return nil, err
+	}
+	return rw, nil
+}
+
+// Write writes an event of the given type for the stream with the given ID.
+func (w *Writer) Write(id tunnel.ConnID, tp EventType, payload []byte) error {
+	ev := Event{
+		Time:    time.Since(w.start),
+		ConnID:  id.String(),
+		Type:    tp,
+		Payload: payload,
+	}
+	if tp == EventOpen {
+		ev.Protocol = ipproto.String(id.Protocol())
+	}
+	w.Lock()
+	defer w.Unlock()
+	return w.enc.Encode(&ev)
+}
+
+// Copy reads the events that a StreamRecorder wrote to r and writes them to the recording until r is
+// exhausted. The stream is considered opened when its open event is read, and the times of all its
+// events are adjusted accordingly, so the timing within the stream is retained.
+func (w *Writer) Copy(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	var offset time.Duration
+	for first := true; ; first = false {
+		var ev Event
+		if err := dec.Decode(&ev); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("unable to read recorded event: %w", err)
+		}
+		if first {
+			if ev.Type != EventOpen {
+				return fmt.Errorf("recorded stream %s doesn't start with an open event", ev.ConnID)
+			}
+			offset = time.Since(w.start) - ev.Time
+		}
+		ev.Time += offset
+		w.Lock()
+		err := w.enc.Encode(&ev)
+		w.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// StreamRecorder writes the events of one stream. The times of the events are relative to when the
+// StreamRecorder was created. It is safe for concurrent use.
+type StreamRecorder struct {
+	sync.Mutex
+	enc    *json.Encoder
+	id     string
+	start  time.Time
+	closed bool
+}
+
+// NewStreamRecorder writes the open event of the stream with the given ID to w, and returns a
+// StreamRecorder that writes the remaining events of that stream.
+func NewStreamRecorder(w io.Writer, id tunnel.ConnID) (*StreamRecorder, error) {
+	r := &StreamRecorder{enc: json.NewEncoder(w), id: id.String(), start: time.Now()}
+	if err := r.enc.Encode(&Event{ConnID: r.id, Protocol: ipproto.String(id.Protocol()), Type: EventOpen}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record writes an event of the given type. Events that are recorded after the close event are ignored.
+func (r *StreamRecorder) Record(tp EventType, payload []byte) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = tp == EventClose
+	return r.enc.Encode(&Event{Time: time.Since(r.start), ConnID: r.id, Type: tp, Payload: payload})
+}
+
+// Read reads a recording from r and returns its header and events.
+func Read(r io.Reader) (*Header, []*Event, error) {
+	dec := json.NewDecoder(r)
+	var hdr Header
+	if err := dec.Decode(&hdr); err != nil {
+		return nil, nil, fmt.Errorf("unable to read recording header: %w", err)
+	}
+	if hdr.Version != Version {
+		return nil, nil, fmt.Errorf("unsupported recording version %d", hdr.Version)
+	}
+	var events []*Event
+	for {
+		var ev Event
+		if err := dec.Decode(&ev); err != nil {
+			if errors.Is(err, io.EOF) {
+				return &hdr, events, nil
+			}
+			return nil, nil, fmt.Errorf("unable to read recording event %d: %w", len(events)+1, err)
+		}
+		events = append(events, &ev)
+	}
+}
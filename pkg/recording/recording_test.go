@@ -0,0 +1,168 @@
+package recording
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/tunnel"
+)
+
+func TestWriteRead(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "hello")
+	require.NoError(t, err)
+	id := tunnel.NewConnID(ipproto.TCP, net.IP{10, 42, 0, 17}, net.IP{127, 0, 0, 1}, 51234, 8080)
+	require.NoError(t, w.Write(id, EventOpen, nil))
+	require.NoError(t, w.Write(id, EventIn, []byte("ping")))
+	require.NoError(t, w.Write(id, EventOut, []byte("pong")))
+	require.NoError(t, w.Write(id, EventClose, nil))
+
+	hdr, events, err := Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, Version, hdr.Version)
+	assert.Equal(t, "hello", hdr.Intercept)
+	require.Len(t, events, 4)
+	assert.Equal(t, "tcp 10.42.0.17:51234 -> 127.0.0.1:8080", events[0].ConnID)
+	assert.Equal(t, "tcp", events[0].Protocol)
+	assert.Equal(t, EventIn, events[1].Type)
+	assert.Equal(t, []byte("ping"), events[1].Payload)
+	assert.Equal(t, []byte("pong"), events[2].Payload)
+	assert.Equal(t, EventClose, events[3].Type)
+	for i := 1; i < len(events); i++ {
+		assert.LessOrEqual(t, events[i-1].Time, events[i].Time)
+	}
+
+	_, _, err = Read(bytes.NewBufferString(`{"version":99}`))
+	assert.Error(t, err)
+}
+
+func TestStreamRecorderCopy(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "hello")
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+
+	// The events of a stream are transferred using a stream of their own.
+	sb := &bytes.Buffer{}
+	id := tunnel.NewConnID(ipproto.UDP, net.IP{10, 42, 0, 17}, net.IP{127, 0, 0, 1}, 51234, 5353)
+	r, err := NewStreamRecorder(sb, id)
+	require.NoError(t, err)
+	require.NoError(t, r.Record(EventIn, []byte("ping")))
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, r.Record(EventOut, []byte("pong")))
+	require.NoError(t, r.Record(EventClose, nil))
+	require.NoError(t, r.Record(EventIn, []byte("ignored")))
+	require.NoError(t, w.Copy(sb))
+
+	_, events, err := Read(buf)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	assert.Equal(t, EventOpen, events[0].Type)
+	assert.Equal(t, "udp", events[0].Protocol)
+	for _, ev := range events {
+		assert.Equal(t, "udp 10.42.0.17:51234 -> 127.0.0.1:5353", ev.ConnID)
+	}
+	assert.GreaterOrEqual(t, events[0].Time, 50*time.Millisecond)
+	assert.GreaterOrEqual(t, events[2].Time-events[1].Time, 20*time.Millisecond)
+	assert.Equal(t, []byte("pong"), events[2].Payload)
+	assert.Equal(t, EventClose, events[3].Type)
+
+	assert.Error(t, w.Copy(bytes.NewBufferString(`{"connId":"tcp 10.42.0.17:51234 -> 127.0.0.1:8080","type":"in"}`)))
+}
+
+func TestReplay(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+
+	// Record two concurrent streams, the second one opened 200ms after the first one.
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "hello")
+	require.NoError(t, err)
+	id1 := tunnel.NewConnID(ipproto.TCP, net.IP{10, 42, 0, 17}, net.IP{127, 0, 0, 1}, 51234, 8080)
+	id2 := tunnel.NewConnID(ipproto.TCP, net.IP{10, 42, 0, 17}, net.IP{127, 0, 0, 1}, 51235, 8080)
+	require.NoError(t, w.Write(id1, EventOpen, nil))
+	require.NoError(t, w.Write(id1, EventIn, []byte("first ")))
+	time.Sleep(200 * time.Millisecond)
+	require.NoError(t, w.Write(id2, EventOpen, nil))
+	require.NoError(t, w.Write(id2, EventIn, []byte("second")))
+	require.NoError(t, w.Write(id1, EventIn, []byte("stream")))
+	require.NoError(t, w.Write(id1, EventOut, []byte("ignored")))
+	require.NoError(t, w.Write(id1, EventClose, nil))
+	require.NoError(t, w.Write(id2, EventClose, nil))
+
+	addr, received := replayTarget(t)
+	start := time.Now()
+	n, err := Replay(ctx, buf, addr)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	assert.ElementsMatch(t, []string{"first stream", "second"}, received(2))
+}
+
+func TestReplayReusedConnID(t *testing.T) {
+	ctx := dlog.NewTestContext(t, false)
+
+	// Record two consecutive streams that use the same connection ID.
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "hello")
+	require.NoError(t, err)
+	id := tunnel.NewConnID(ipproto.TCP, net.IP{10, 42, 0, 17}, net.IP{127, 0, 0, 1}, 51234, 8080)
+	require.NoError(t, w.Write(id, EventOpen, nil))
+	require.NoError(t, w.Write(id, EventIn, []byte("first")))
+	require.NoError(t, w.Write(id, EventClose, nil))
+	require.NoError(t, w.Write(id, EventOpen, nil))
+	require.NoError(t, w.Write(id, EventIn, []byte("second")))
+	require.NoError(t, w.Write(id, EventClose, nil))
+
+	addr, received := replayTarget(t)
+	n, err := Replay(ctx, buf, addr)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.ElementsMatch(t, []string{"first", "second"}, received(2))
+}
+
+// replayTarget starts a TCP server that reads each connection until it's closed and then responds.
+// It returns the address of the server and a function that waits for the given number of connections
+// and returns what was received on them.
+func replayTarget(t *testing.T) (string, func(int) []string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	var mu sync.Mutex
+	var received []string
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				data, _ := io.ReadAll(conn)
+				_, _ = conn.Write([]byte("response"))
+				mu.Lock()
+				received = append(received, string(data))
+				mu.Unlock()
+			}()
+		}
+	}()
+	return l.Addr().String(), func(count int) []string {
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received) == count
+		}, 5*time.Second, 10*time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		return received
+	}
+}
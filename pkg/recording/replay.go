@@ -0,0 +1,130 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/datawire/dlib/dlog"
+)
+
+// closeTimeout is the maximum time that a replayed TCP stream waits for the peer to close its side
+// of the stream once the recorded stream has been closed.
+const closeTimeout = 5 * time.Second
+
+// Replay reads a recording from r and sends the data that was sent to the intercept target to the
+// given address. Each recorded stream is replayed using a connection of its own, and the events are
+// dispatched with the same timing as when they were recorded, so streams that were concurrent during
+// the recording are concurrent during the replay too. Data received from the address is discarded.
+// Replay returns the number of replayed streams.
+func Replay(ctx context.Context, r io.Reader, to string) (int, error) {
+	_, events, err := Read(r)
+	if err != nil {
+		return 0, err
+	}
+
+	// The streams that are open, keyed by connection ID. A connection ID can be reused once its
+	// stream has been closed, so a closed stream is removed rather than retained.
+	streams := make(map[string]chan *Event)
+	replayed := 0
+	var errs []error
+	var errsLock sync.Mutex
+	wg := sync.WaitGroup{}
+	defer func() {
+		for _, evCh := range streams {
+			close(evCh)
+		}
+		wg.Wait()
+	}()
+
+	start := time.Now()
+	for _, ev := range events {
+		if ev.Type == EventOut {
+			continue
+		}
+		if d := time.Until(start.Add(ev.Time)); d > 0 {
+			select {
+			case <-ctx.Done():
+				return replayed, ctx.Err()
+			case <-time.After(d):
+			}
+		}
+		evCh, ok := streams[ev.ConnID]
+		if !ok {
+			if ev.Type != EventOpen {
+				dlog.Debugf(ctx, "ignoring %s event for %s which has not been opened", ev.Type, ev.ConnID)
+				continue
+			}
+			evCh = make(chan *Event, 32)
+			streams[ev.ConnID] = evCh
+			replayed++
+			wg.Add(1)
+			go func(ev *Event) {
+				defer wg.Done()
+				if err := replayStream(ctx, ev, to, evCh); err != nil {
+					errsLock.Lock()
+					errs = append(errs, fmt.Errorf("%s: %w", ev.ConnID, err))
+					errsLock.Unlock()
+				}
+			}(ev)
+			continue
+		}
+		evCh <- ev
+		if ev.Type == EventClose {
+			close(evCh)
+			delete(streams, ev.ConnID)
+		}
+	}
+	for id, evCh := range streams {
+		close(evCh)
+		delete(streams, id)
+	}
+	wg.Wait()
+	return replayed, errors.Join(errs...)
+}
+
+// replayStream dials the given address and writes the payload of the events that arrive on the
+// given channel until the channel is closed.
+func replayStream(ctx context.Context, open *Event, to string, evCh <-chan *Event) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, open.Protocol, to)
+	if err != nil {
+		// Drain the events so that the dispatcher isn't blocked.
+		for range evCh {
+		}
+		return err
+	}
+	defer conn.Close()
+
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	for ev := range evCh {
+		if ev.Type != EventIn {
+			continue
+		}
+		if _, err = conn.Write(ev.Payload); err != nil {
+			for range evCh {
+			}
+			return err
+		}
+	}
+
+	if tc, ok := conn.(*net.TCPConn); ok {
+		// Let the peer know that nothing more will be sent, and give it a chance to respond.
+		_ = tc.CloseWrite()
+		select {
+		case <-drained:
+		case <-ctx.Done():
+		case <-time.After(closeTimeout):
+		}
+	}
+	return nil
+}
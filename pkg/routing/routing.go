@@ -49,6 +49,31 @@ func DefaultRoute(ctx context.Context) (*Route, error) {
 	return nil, errors.New("unable to find a default route")
 }
 
+// DefaultRouteFor returns the default route of the IP family of the given IP.
+func DefaultRouteFor(ctx context.Context, ip net.IP) (*Route, error) {
+	rt, err := GetRoutingTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ipv4 := ip.To4() != nil
+	for _, r := range rt {
+		if r.Default && r.isIPv4() == ipv4 {
+			return r, nil
+		}
+	}
+	if ipv4 {
+		return nil, errors.New("unable to find a default route for IPv4")
+	}
+	return nil, errors.New("unable to find a default route for IPv6")
+}
+
+func (r *Route) isIPv4() bool {
+	if r.RoutedNet != nil {
+		return r.RoutedNet.IP.To4() != nil
+	}
+	return r.LocalIP.To4() != nil
+}
+
 type rtError string
 
 func (r rtError) Error() string {
@@ -2,6 +2,7 @@ package routing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"regexp"
@@ -27,7 +28,7 @@ var (
 
 type table struct {
 	index int
-	rule  *netlink.Rule
+	rules []*netlink.Rule
 }
 
 type rtmsg struct {
@@ -214,17 +215,23 @@ func openTable(ctx context.Context) (Table, error) {
 		}
 	}
 	dlog.Infof(ctx, "Creating routing table with index %d and priority %d", index, priority)
-	rule := netlink.NewRule()
-	rule.Table = index
-	rule.Priority = priority
-	rule.Family = netlink.FAMILY_V4
-	if err := netlink.RuleAdd(rule); err != nil {
-		return nil, fmt.Errorf("netlink.RuleAdd: %w", err)
+	t := &table{index: index}
+	for _, family := range []int{netlink.FAMILY_V4, netlink.FAMILY_V6} {
+		rule := netlink.NewRule()
+		rule.Table = index
+		rule.Priority = priority
+		rule.Family = family
+		if err := netlink.RuleAdd(rule); err != nil {
+			if family == netlink.FAMILY_V6 {
+				// IPv6 might be disabled on this host, in which case there will be no IPv6 routes.
+				dlog.Warnf(ctx, "unable to add IPv6 rule for routing table %d: %v", index, err)
+				continue
+			}
+			return nil, fmt.Errorf("netlink.RuleAdd: %w", err)
+		}
+		t.rules = append(t.rules, rule)
 	}
-	return &table{
-		index: index,
-		rule:  rule,
-	}, nil
+	return t, nil
 }
 
 func (t *table) routeToNetlink(route *Route) *netlink.Route {
@@ -238,7 +245,13 @@ func (t *table) routeToNetlink(route *Route) *netlink.Route {
 }
 
 func (t *table) Close(ctx context.Context) error {
-	return netlink.RuleDel(t.rule)
+	var errs []error
+	for _, rule := range t.rules {
+		if err := netlink.RuleDel(rule); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 func (t *table) Add(ctx context.Context, r *Route) error {
@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/workload"
 )
 
 func RecordWorkloadInfo(span trace.Span, wl k8sapi.Workload) {
@@ -41,6 +42,8 @@ func GetWorkloadFromCache(ctx context.Context, workloadCache map[string]k8sapi.W
 //  1. Deployments
 //  2. ReplicaSets
 //  3. StatefulSets
+//  4. DaemonSets
+//  5. Custom workload kinds, such as Argo Rollouts
 //
 // The first match is returned.
 func GetWorkload(c context.Context, name, namespace, workloadKind string) (obj k8sapi.Workload, err error) {
@@ -53,5 +56,5 @@ func GetWorkload(c context.Context, name, namespace, workloadKind string) (obj k
 	)
 	defer EndAndRecord(span, err)
 
-	return k8sapi.GetWorkload(c, name, namespace, workloadKind)
+	return workload.GetWorkload(c, name, namespace, workloadKind)
 }
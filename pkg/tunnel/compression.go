@@ -0,0 +1,192 @@
+package tunnel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is the algorithm that a Stream uses to compress the payload of Normal messages. The client
+// proposes an algorithm when the stream is created, and the server confirms it in its StreamOK response.
+// Peers that don't know about compression won't confirm, and the stream is then left uncompressed.
+type Compression byte
+
+const (
+	CompressionNone = Compression(iota)
+	CompressionSnappy
+	CompressionZstd
+)
+
+var compressionNames = [...]string{"none", "snappy", "zstd"} //nolint:gochecknoglobals // constant
+
+const (
+	// compressMinSize is the smallest payload that is worth compressing.
+	compressMinSize = 256
+
+	// compressMinEstimate is the smallest compress.Estimate of a payload that is worth compressing.
+	compressMinEstimate = 0.1
+
+	// compressMaxMisses is the number of consecutive payloads that didn't shrink enough to be worth compressing
+	// before compression is skipped for the rest of the stream.
+	compressMaxMisses = 8
+
+	// maxDecompressedSize protects against payloads that decompress into something huge.
+	maxDecompressedSize = 16 << 20
+)
+
+func (c Compression) String() string {
+	if int(c) < len(compressionNames) {
+		return compressionNames[c]
+	}
+	return fmt.Sprintf("compression(%d)", byte(c))
+}
+
+// ParseCompression returns the Compression with the given name. An empty name means CompressionNone.
+func ParseCompression(s string) (Compression, error) {
+	if s == "" {
+		return CompressionNone, nil
+	}
+	for i, n := range compressionNames {
+		if strings.EqualFold(s, n) {
+			return Compression(i), nil
+		}
+	}
+	return CompressionNone, fmt.Errorf("invalid compression %q, must be one of %s", s, strings.Join(compressionNames[:], ", "))
+}
+
+func (c Compression) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *Compression) UnmarshalText(text []byte) (err error) {
+	*c, err = ParseCompression(string(text))
+	return err
+}
+
+// supported returns the given compression if it's known to this peer, or CompressionNone if it isn't.
+func (c Compression) supported() Compression {
+	if int(c) < len(compressionNames) {
+		return c
+	}
+	return CompressionNone
+}
+
+var (
+	zstdOnce    sync.Once     //nolint:gochecknoglobals // lazy initialization
+	zstdEncoder *zstd.Encoder //nolint:gochecknoglobals // safe for concurrent use by EncodeAll
+	zstdDecoder *zstd.Decoder //nolint:gochecknoglobals // safe for concurrent use by DecodeAll
+)
+
+func initZstd() {
+	zstdOnce.Do(func() {
+		zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+		zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(maxDecompressedSize))
+	})
+}
+
+// compressor compresses and decompresses the messages of a stream, and counts the payload bytes that
+// are sent and received over the wire.
+type compressor struct {
+	compression Compression
+	skip        int32 // set when the payloads of the stream aren't worth compressing
+	misses      int32
+	wireIn      uint64
+	wireOut     uint64
+}
+
+func (c *compressor) wireBytes() (received, sent uint64) {
+	return atomic.LoadUint64(&c.wireIn), atomic.LoadUint64(&c.wireOut)
+}
+
+// compress returns a compressed message with the payload of the given message if compression was negotiated,
+// the message is Normal, and compression seems worthwhile. The given message is returned otherwise.
+func (c *compressor) compress(m Message) Message {
+	pl := m.Payload()
+	if c.compression != CompressionNone && m.Code() == Normal && len(pl) >= compressMinSize && atomic.LoadInt32(&c.skip) == 0 {
+		if looksLikeTLS(pl) {
+			// Encrypted traffic doesn't compress, and neither will the rest of this stream.
+			atomic.StoreInt32(&c.skip, 1)
+		} else if compress.Estimate(pl) >= compressMinEstimate {
+			cm := c.encode(pl)
+			if len(cm) < len(pl)-len(pl)/8 {
+				atomic.StoreInt32(&c.misses, 0)
+				m = cm
+			} else if atomic.AddInt32(&c.misses, 1) >= compressMaxMisses {
+				atomic.StoreInt32(&c.skip, 1)
+			}
+		}
+	}
+	atomic.AddUint64(&c.wireOut, uint64(len(m.Payload())))
+	return m
+}
+
+func (c *compressor) encode(pl []byte) msg {
+	switch c.compression {
+	case CompressionSnappy:
+		b := make([]byte, 1+snappy.MaxEncodedLen(len(pl)))
+		b[0] = byte(compressed)
+		return msg(b[:1+len(snappy.Encode(b[1:], pl))])
+	case CompressionZstd:
+		initZstd()
+		return zstdEncoder.EncodeAll(pl, append(make([]byte, 0, 1+len(pl)/2), byte(compressed)))
+	default:
+		panic(fmt.Sprintf("unsupported %s", c.compression))
+	}
+}
+
+// decompress returns a Normal message with the decompressed payload of the given message if it is compressed,
+// or the given message if it isn't.
+func (c *compressor) decompress(m Message) (Message, error) {
+	pl := m.Payload()
+	atomic.AddUint64(&c.wireIn, uint64(len(pl)))
+	if m.Code() != compressed {
+		return m, nil
+	}
+	var dm []byte
+	var err error
+	switch c.compression {
+	case CompressionSnappy:
+		var n int
+		if n, err = snappy.DecodedLen(pl); err == nil {
+			if n > maxDecompressedSize {
+				return nil, errors.New("decompressed message is too large")
+			}
+			dm = make([]byte, 1+n)
+			_, err = snappy.Decode(dm[1:], pl)
+		}
+	case CompressionZstd:
+		initZstd()
+		dm, err = zstdDecoder.DecodeAll(pl, append(make([]byte, 0, 1+2*len(pl)), byte(Normal)))
+	default:
+		err = errors.New("compression was not negotiated")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress %s message: %w", c.compression, err)
+	}
+	dm[0] = byte(Normal)
+	return msg(dm), nil
+}
+
+// looksLikeTLS returns true if the given payload starts with a TLS record header.
+func looksLikeTLS(pl []byte) bool {
+	return len(pl) >= 5 && pl[0] >= 20 && pl[0] <= 23 && pl[1] == 3 && pl[2] <= 4
+}
+
+// WireBytes returns the number of payload bytes that the given Stream has received and sent over the wire,
+// i.e. after compression. The ok result is false if the Stream doesn't keep track of that.
+func WireBytes(s Stream) (received, sent uint64, ok bool) {
+	if ts, is := s.(*trackedStream); is {
+		s = ts.Stream
+	}
+	if wc, is := s.(interface{ wireBytes() (uint64, uint64) }); is {
+		received, sent = wc.wireBytes()
+		return received, sent, true
+	}
+	return 0, 0, false
+}
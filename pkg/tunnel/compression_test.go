@@ -0,0 +1,150 @@
+package tunnel
+
+import (
+	"bytes"
+	"crypto/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseCompression(t *testing.T) {
+	for _, c := range []Compression{CompressionNone, CompressionSnappy, CompressionZstd} {
+		pc, err := ParseCompression(c.String())
+		require.NoError(t, err)
+		assert.Equal(t, c, pc)
+	}
+	pc, err := ParseCompression("")
+	require.NoError(t, err)
+	assert.Equal(t, CompressionNone, pc)
+
+	_, err = ParseCompression("gzip")
+	assert.Error(t, err)
+
+	var v struct {
+		Compression Compression `yaml:"compression"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte("compression: zstd"), &v))
+	assert.Equal(t, CompressionZstd, v.Compression)
+	assert.Error(t, yaml.Unmarshal([]byte("compression: lz4"), &v))
+}
+
+func compressiblePayload() []byte {
+	return bytes.Repeat([]byte("GET /api/v1/namespaces/default/pods HTTP/1.1\r\nHost: kubernetes\r\n\r\n"), 64)
+}
+
+func TestCompressor(t *testing.T) {
+	random := make([]byte, 0x1000)
+	_, err := rand.Read(random)
+	require.NoError(t, err)
+	small := []byte("hello")
+
+	for _, c := range []Compression{CompressionSnappy, CompressionZstd} {
+		t.Run(c.String(), func(t *testing.T) {
+			enc := compressor{compression: c}
+			dec := compressor{compression: c}
+			for _, pl := range [][]byte{compressiblePayload(), random, small} {
+				m := enc.compress(NewMessage(Normal, pl))
+				if bytes.Equal(pl, random) || bytes.Equal(pl, small) {
+					assert.Equal(t, Normal, m.Code())
+				} else {
+					assert.Equal(t, compressed, m.Code())
+					assert.Less(t, len(m.Payload()), len(pl)/4)
+				}
+				dm, err := dec.decompress(m)
+				require.NoError(t, err)
+				assert.Equal(t, Normal, dm.Code())
+				assert.Equal(t, pl, dm.Payload())
+			}
+			_, sent := enc.wireBytes()
+			received, _ := dec.wireBytes()
+			assert.Equal(t, sent, received)
+
+			// Control messages are never compressed.
+			m := enc.compress(NewMessage(DialOK, compressiblePayload()))
+			assert.Equal(t, DialOK, m.Code())
+		})
+	}
+}
+
+func TestCompressor_SkipTLS(t *testing.T) {
+	enc := compressor{compression: CompressionZstd}
+	record := append([]byte{23, 3, 3, 0x10, 0x00}, compressiblePayload()...)
+	assert.Equal(t, Normal, enc.compress(NewMessage(Normal, record)).Code())
+
+	// Once TLS has been seen, the rest of the stream isn't compressed.
+	assert.Equal(t, Normal, enc.compress(NewMessage(Normal, compressiblePayload())).Code())
+}
+
+func TestStream_Compression(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+
+	payload := compressiblePayload()
+	tunnel := newBidi(10, ctx.Done())
+	id := testConnID(1001)
+	si := uuid.New().String()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		client, err := NewClientStream(WithCompression(ctx, CompressionSnappy), tunnel.clientSide(), id, si, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, client.Send(ctx, NewMessage(Normal, payload)))
+		_, sent, ok := WireBytes(client)
+		require.True(t, ok)
+		assert.Less(t, sent, uint64(len(payload)/4))
+	}()
+
+	go func() {
+		defer wg.Done()
+		server, err := NewServerStream(ctx, tunnel.serverSide())
+		require.NoError(t, err)
+		m, err := server.Receive(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, payload, m.Payload())
+	}()
+	wg.Wait()
+}
+
+func TestMux_Compression(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+	ctx = WithCompression(ctx, CompressionZstd)
+
+	payload := compressiblePayload()
+	t.Run("multiplexed", func(t *testing.T) {
+		mux := NewMux(ctx, &testProvider{handler: echo}, uuid.New().String())
+		for i := 0; i < 2; i++ {
+			s, err := mux.OpenStream(ctx, testConnID(uint16(1000+i)), 0, 0)
+			require.NoError(t, err)
+			require.NoError(t, s.Send(ctx, NewMessage(Normal, payload)))
+			m, err := s.Receive(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, payload, m.Payload())
+			received, sent, ok := WireBytes(s)
+			require.True(t, ok)
+			assert.Less(t, sent, uint64(len(payload)/4))
+			assert.Less(t, received, uint64(len(payload)/4))
+		}
+	})
+
+	t.Run("version 2 peer", func(t *testing.T) {
+		mux := NewMux(ctx, &testProvider{handler: echo, serve: serveV2}, uuid.New().String())
+		s, err := mux.OpenStream(ctx, testConnID(1000), 0, 0)
+		require.NoError(t, err)
+		_, before, _ := WireBytes(s)
+		require.NoError(t, s.Send(ctx, NewMessage(Normal, payload)))
+		m, err := s.Receive(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, payload, m.Payload())
+		_, after, _ := WireBytes(s)
+		assert.Equal(t, uint64(len(payload)), after-before)
+	})
+}
@@ -0,0 +1,159 @@
+package tunnel
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+const (
+	// DefaultStatsInterval is the interval used by ConnStatsRegistry.Watch when the request doesn't specify one.
+	DefaultStatsInterval = time.Second
+
+	// MinStatsInterval is the shortest interval that ConnStatsRegistry.Watch will use. Shorter intervals in a
+	// request are raised to this one, so that a client cannot make the server send snapshots in a tight loop.
+	MinStatsInterval = time.Second
+)
+
+// ConnStats contains the statistics of one tunneled connection.
+type ConnStats struct {
+	id              ConnID
+	clientSessionID string
+	servedBy        string
+	start           time.Time
+	dialLatency     int64 // time.Duration, zero until the dial has been answered
+	ingressBytes    CounterProbe
+	egressBytes     CounterProbe
+}
+
+func (cs *ConnStats) toRPC() *manager.ConnectionStats {
+	rcs := &manager.ConnectionStats{
+		ConnId:          []byte(cs.id),
+		ClientSessionId: cs.clientSessionID,
+		ServedBy:        cs.servedBy,
+		IngressBytes:    cs.ingressBytes.GetValue(),
+		EgressBytes:     cs.egressBytes.GetValue(),
+		StartTime:       timestamppb.New(cs.start),
+	}
+	if dl := atomic.LoadInt64(&cs.dialLatency); dl > 0 {
+		rcs.DialLatency = durationpb.New(time.Duration(dl))
+	}
+	return rcs
+}
+
+// ConnStatsRegistry keeps track of the statistics of active connections.
+type ConnStatsRegistry struct {
+	sync.Mutex
+	conns map[*ConnStats]struct{}
+}
+
+func NewConnStatsRegistry() *ConnStatsRegistry {
+	return &ConnStatsRegistry{conns: make(map[*ConnStats]struct{})}
+}
+
+// Track returns a Stream that records the traffic of the given stream in a new ConnStats. The clientSide
+// argument tells if the stream is the client side of the connection, i.e. if the bytes that it sends are
+// ingress bytes. The ConnStats is removed from the registry when the given context is done.
+func (r *ConnStatsRegistry) Track(ctx context.Context, s Stream, servedBy string, clientSide bool) Stream {
+	cs := &ConnStats{
+		id:              s.ID(),
+		clientSessionID: s.SessionID(),
+		servedBy:        servedBy,
+		start:           time.Now(),
+	}
+	r.Lock()
+	r.conns[cs] = struct{}{}
+	r.Unlock()
+	context.AfterFunc(ctx, func() {
+		r.Lock()
+		delete(r.conns, cs)
+		r.Unlock()
+	})
+	ts := &trackedStream{Stream: s, stats: cs, sent: &cs.egressBytes, received: &cs.ingressBytes}
+	if clientSide {
+		ts.sent, ts.received = ts.received, ts.sent
+	}
+	return ts
+}
+
+// Snapshot returns the statistics of the connections that belong to the given client session, or of all
+// connections if clientSessionID is empty.
+func (r *ConnStatsRegistry) Snapshot(clientSessionID string) *manager.ConnectionStatsSnapshot {
+	r.Lock()
+	defer r.Unlock()
+	conns := make([]*manager.ConnectionStats, 0, len(r.conns))
+	for cs := range r.conns {
+		if clientSessionID == "" || cs.clientSessionID == clientSessionID {
+			conns = append(conns, cs.toRPC())
+		}
+	}
+	return &manager.ConnectionStatsSnapshot{Connections: conns}
+}
+
+// Watch calls the send function with a snapshot of the statistics of the connections that belong to the
+// given client session at the interval given by the request, but never more often than MinStatsInterval,
+// until the context is done or the send function returns an error.
+func (r *ConnStatsRegistry) Watch(
+	ctx context.Context,
+	rq *manager.ConnectionStatsRequest,
+	clientSessionID string,
+	send func(*manager.ConnectionStatsSnapshot) error,
+) error {
+	interval := DefaultStatsInterval
+	if iv := rq.GetInterval(); iv != nil && iv.AsDuration() > 0 {
+		interval = max(iv.AsDuration(), MinStatsInterval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := send(r.Snapshot(clientSessionID)); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// trackedStream is a Stream that counts the bytes of the Normal messages that pass through it, and records
+// the latency of the dial.
+type trackedStream struct {
+	Stream
+	stats    *ConnStats
+	sent     *CounterProbe
+	received *CounterProbe
+}
+
+func (ts *trackedStream) Receive(ctx context.Context) (Message, error) {
+	m, err := ts.Stream.Receive(ctx)
+	if err == nil {
+		ts.count(m, ts.received)
+	}
+	return m, err
+}
+
+func (ts *trackedStream) Send(ctx context.Context, m Message) error {
+	err := ts.Stream.Send(ctx, m)
+	if err == nil {
+		ts.count(m, ts.sent)
+	}
+	return err
+}
+
+func (ts *trackedStream) count(m Message, p *CounterProbe) {
+	switch m.Code() {
+	case Normal:
+		p.Increment(uint64(len(m.Payload())))
+	case DialOK, DialReject:
+		cs := ts.stats
+		atomic.CompareAndSwapInt64(&cs.dialLatency, 0, int64(time.Since(cs.start)))
+	}
+}
@@ -0,0 +1,85 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+func TestConnStatsRegistry(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+
+	r := NewConnStatsRegistry()
+	mux := NewMux(ctx, &testProvider{handler: echo}, uuid.New().String())
+	s, err := mux.OpenStream(ctx, testConnID(1000), 0, 0)
+	require.NoError(t, err)
+
+	sCtx, sCancel := context.WithCancel(ctx)
+	ts := r.Track(sCtx, s, "traffic-manager", true)
+	require.NoError(t, ts.Send(ctx, NewMessage(Normal, make([]byte, 100))))
+	_, err = ts.Receive(ctx)
+	require.NoError(t, err)
+	require.NoError(t, ts.Send(ctx, NewMessage(Normal, make([]byte, 20))))
+	require.NoError(t, ts.Send(ctx, NewMessage(DialOK, nil)))
+
+	snapshot := r.Snapshot("")
+	require.Len(t, snapshot.Connections, 1)
+	cs := snapshot.Connections[0]
+	assert.Equal(t, testConnID(1000), ConnID(cs.ConnId))
+	assert.Equal(t, s.SessionID(), cs.ClientSessionId)
+	assert.Equal(t, "traffic-manager", cs.ServedBy)
+	assert.Equal(t, uint64(120), cs.IngressBytes)
+	assert.Equal(t, uint64(100), cs.EgressBytes)
+	assert.NotNil(t, cs.DialLatency)
+	assert.Empty(t, r.Snapshot("other").Connections)
+
+	// The connection is removed when its context is done.
+	sCancel()
+	assert.Eventually(t, func() bool { return len(r.Snapshot("").Connections) == 0 }, time.Second, time.Millisecond)
+}
+
+func TestConnStatsRegistry_Watch(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+
+	r := NewConnStatsRegistry()
+	var snapshots []*manager.ConnectionStatsSnapshot
+	wCtx, wCancel := context.WithCancel(ctx)
+	err := r.Watch(wCtx, &manager.ConnectionStatsRequest{}, "", func(snapshot *manager.ConnectionStatsSnapshot) error {
+		snapshots = append(snapshots, snapshot)
+		if len(snapshots) == 2 {
+			wCancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+}
+
+func TestConnStatsRegistry_WatchMinInterval(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+
+	r := NewConnStatsRegistry()
+	var times []time.Time
+	wCtx, wCancel := context.WithCancel(ctx)
+	rq := &manager.ConnectionStatsRequest{Interval: durationpb.New(time.Millisecond)}
+	err := r.Watch(wCtx, rq, "", func(*manager.ConnectionStatsSnapshot) error {
+		times = append(times, time.Now())
+		if len(times) == 2 {
+			wCancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), MinStatsInterval-10*time.Millisecond)
+}
@@ -16,3 +16,16 @@ func GetPool(ctx context.Context) *Pool {
 	}
 	return pool
 }
+
+type compressionKey struct{}
+
+// WithCompression returns a context with the Compression that client streams created with it will propose.
+func WithCompression(ctx context.Context, c Compression) context.Context {
+	return context.WithValue(ctx, compressionKey{}, c)
+}
+
+// GetCompression returns the Compression that client streams created with the given context will propose.
+func GetCompression(ctx context.Context) Compression {
+	c, _ := ctx.Value(compressionKey{}).(Compression)
+	return c
+}
@@ -341,6 +341,7 @@ func DialWaitLoop(
 	// create ctx to cleanup leftover dialRespond if waitloop dies
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	mux := NewMux(ctx, tunnelProvider, sessionID)
 	for ctx.Err() == nil {
 		dr, err := dialStream.Recv()
 		if err != nil {
@@ -349,12 +350,12 @@ func DialWaitLoop(
 			}
 			return nil
 		}
-		go dialRespond(ctx, tunnelProvider, dr, sessionID)
+		go dialRespond(ctx, mux, dr)
 	}
 	return nil
 }
 
-func dialRespond(ctx context.Context, tunnelProvider Provider, dr *rpc.DialRequest, sessionID string) {
+func dialRespond(ctx context.Context, opener StreamOpener, dr *rpc.DialRequest) {
 	if tc := dr.GetTraceContext(); tc != nil {
 		carrier := propagation.MapCarrier(tc)
 		propagator := otel.GetTextMapPropagator()
@@ -364,15 +365,10 @@ func dialRespond(ctx context.Context, tunnelProvider Provider, dr *rpc.DialReque
 	defer span.End()
 	id := ConnID(dr.ConnId)
 	id.SpanRecord(span)
-	mt, err := tunnelProvider.Tunnel(ctx)
-	if err != nil {
-		dlog.Errorf(ctx, "!! CONN %s, call to manager Tunnel failed: %v", id, err)
-		return
-	}
 	ctx, cancel := context.WithCancel(ctx)
-	s, err := NewClientStream(ctx, mt, id, sessionID, time.Duration(dr.RoundtripLatency), time.Duration(dr.DialTimeout))
+	s, err := opener.OpenStream(ctx, id, time.Duration(dr.RoundtripLatency), time.Duration(dr.DialTimeout))
 	if err != nil {
-		dlog.Error(ctx, err)
+		dlog.Errorf(ctx, "!! CONN %s, unable to open tunnel: %v", id, err)
 		cancel()
 		return
 	}
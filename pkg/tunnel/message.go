@@ -57,6 +57,13 @@ const (
 
 	KeepAlive
 	Session
+
+	// muxInfo is sent instead of streamInfo by a client that wants to multiplex streams over the gRPC stream.
+	muxInfo
+
+	// compressed carries the compressed payload of a Normal message. It is only sent on streams where
+	// compression was negotiated, and it's never seen by the users of a Stream.
+	compressed
 )
 
 func (c MessageCode) String() string {
@@ -77,6 +84,10 @@ func (c MessageCode) String() string {
 		return "KEEP_ALIVE"
 	case Session:
 		return "SESSION"
+	case muxInfo:
+		return "MUX_INFO"
+	case compressed:
+		return "COMPRESSED"
 	default:
 		return fmt.Sprintf("** unknown control code: %d **", c)
 	}
@@ -151,6 +162,12 @@ func StreamOKMessage() Message {
 	return m[:n+1]
 }
 
+func muxInfoMessage() Message {
+	m := makeMessage(muxInfo, 4)
+	n := binary.PutUvarint(m.Payload(), uint64(Version))
+	return m[:n+1]
+}
+
 func SessionMessage(sessionID string) Message {
 	return NewMessage(Session, []byte(sessionID))
 }
@@ -171,6 +188,24 @@ func getVersion(m Message) uint16 {
 	return uint16(v)
 }
 
+// withCompression appends the given compression to a streamInfo, muxInfo, or streamOK message. Peers
+// that don't know about compression will ignore it.
+func withCompression(m Message, c Compression) Message {
+	if c == CompressionNone {
+		return m
+	}
+	return append(m.(msg), byte(c))
+}
+
+// getCompression returns the compression that was appended to a muxInfo or streamOK message.
+func getCompression(m Message) Compression {
+	pl := m.Payload()
+	if _, n := binary.Uvarint(pl); n > 0 && len(pl) > n {
+		return Compression(pl[n]).supported()
+	}
+	return CompressionNone
+}
+
 var errMalformedConnect = errors.New("malformed Connect message")
 
 // connectInfo returns the connectInfo that this Message represents.
@@ -212,5 +247,10 @@ func setConnectInfo(m Message, s *stream) error {
 	}
 	pl = pl[n:]
 	s.sessionID = string(pl[:v])
+	pl = pl[v:]
+
+	if len(pl) > 0 {
+		s.compression = Compression(pl[0]).supported()
+	}
 	return nil
 }
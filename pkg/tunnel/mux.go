@@ -0,0 +1,746 @@
+package tunnel
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/datawire/dlib/dlog"
+	rpc "github.com/telepresenceio/telepresence/rpc/v2/manager"
+)
+
+// A client that knows that its peer is of version 3 or later may start a gRPC stream with a muxInfo message
+// instead of a streamInfo message. The server responds with a streamOK, and from then on, the payload of each
+// TunnelMessage on the gRPC stream is a frame that belongs to one of many streams. A frame consists of a
+// frameType, the uvarint number of the stream, and a body that depends on the frameType. Stream numbers are
+// assigned by the client.
+type frameType byte
+
+const (
+	// frameOpen is sent by the client to open a stream. The body is the priority of the stream, followed
+	// by a StreamInfo message.
+	frameOpen = frameType(iota)
+
+	// frameData carries a Message.
+	frameData
+
+	// frameWindow permits the receiver to send more data on the stream. The body is a uvarint byte count.
+	frameWindow
+
+	// frameClose is sent when no more data will be sent on the stream.
+	frameClose
+
+	// frameEnd is sent when the stream is gone. The body is an optional uvarint gRPC status code
+	// followed by an error message.
+	frameEnd
+)
+
+// priority determines the order in which frames that are waiting to be sent on a multiplexed gRPC stream
+// are sent. A stream never raises its priority, and it isn't lowered while frames of the stream are waiting,
+// so the frames of one stream are always sent in order.
+type priority byte
+
+const (
+	// priorityControl is used for frames that open streams and for window updates.
+	priorityControl = priority(iota)
+
+	// priorityInteractive is used for streams that haven't sent much data yet.
+	priorityInteractive
+
+	// priorityBulk is used for streams that have sent more than muxBulkThreshold bytes.
+	priorityBulk
+)
+
+const (
+	// muxWindowSize is the number of bytes that can be sent on a stream before the peer grants more.
+	muxWindowSize = 1 << 20
+
+	// muxBulkThreshold is the number of bytes that a stream can send before it is given bulk priority.
+	muxBulkThreshold = 4 << 20
+
+	// muxInteractiveBurst is the number of interactive frames that are sent while bulk frames are waiting
+	// before a bulk frame is sent.
+	muxInteractiveBurst = 4
+
+	// muxStreamsPerConn is the number of streams that a Mux puts on a gRPC stream before it opens another one.
+	muxStreamsPerConn = 64
+
+	// muxMaxConns is the maximum number of gRPC streams that a Mux will open.
+	muxMaxConns = 4
+
+	// muxIdleTimeout is how long a Mux keeps a gRPC stream that has no streams open.
+	muxIdleTimeout = 30 * time.Second
+)
+
+var (
+	errMalformedFrame = errors.New("malformed multiplexing frame")
+	errMuxIdle        = errors.New("multiplexed tunnel is idle")
+)
+
+func makeFrame(ft frameType, no uint32, body []byte) *rpc.TunnelMessage {
+	b := make([]byte, 1+binary.MaxVarintLen32+len(body))
+	b[0] = byte(ft)
+	n := 1 + binary.PutUvarint(b[1:], uint64(no))
+	n += copy(b[n:], body)
+	return &rpc.TunnelMessage{Payload: b[:n]}
+}
+
+func windowFrame(no uint32, credit int) *rpc.TunnelMessage {
+	b := make([]byte, binary.MaxVarintLen64)
+	return makeFrame(frameWindow, no, b[:binary.PutUvarint(b, uint64(credit))])
+}
+
+func endFrame(no uint32, err error) *rpc.TunnelMessage {
+	if err == nil {
+		return makeFrame(frameEnd, no, nil)
+	}
+	st := status.Convert(err)
+	b := binary.AppendUvarint(nil, uint64(st.Code()))
+	return makeFrame(frameEnd, no, append(b, st.Message()...))
+}
+
+func parseFrame(pl []byte) (frameType, uint32, []byte, error) {
+	if len(pl) < 2 {
+		return 0, 0, nil, errMalformedFrame
+	}
+	v, n := binary.Uvarint(pl[1:])
+	if n <= 0 || v > math.MaxUint32 {
+		return 0, 0, nil, errMalformedFrame
+	}
+	return frameType(pl[0]), uint32(v), pl[1+n:], nil
+}
+
+// endError returns the error that the body of a frameEnd represents. An ended stream without
+// an error is reported as io.EOF, just like a gRPC stream that ends normally.
+func endError(body []byte) error {
+	v, n := binary.Uvarint(body)
+	if n <= 0 || codes.Code(v) == codes.OK {
+		return io.EOF
+	}
+	return status.Error(codes.Code(v), string(body[n:]))
+}
+
+// frameQueue holds frames that are waiting to be sent, one FIFO per priority. Control frames are always sent
+// first. Interactive frames are sent before bulk frames, but once muxInteractiveBurst interactive frames have
+// been sent while bulk frames were waiting, a bulk frame is sent, so that sustained interactive traffic
+// cannot starve the bulk streams.
+type frameQueue struct {
+	sync.Mutex
+	levels    [priorityBulk + 1][]queuedFrame
+	bulkSkips int
+	ready     chan struct{}
+}
+
+type queuedFrame struct {
+	tm *rpc.TunnelMessage
+	s  *muxStream // set when the frame was queued with the priority of a stream
+}
+
+func (q *frameQueue) push(p priority, tm *rpc.TunnelMessage) {
+	q.Lock()
+	q.levels[p] = append(q.levels[p], queuedFrame{tm: tm})
+	q.Unlock()
+	signal(q.ready)
+}
+
+// pushStream queues a frame with the priority of the given stream, after adding size to the number of
+// bytes that the stream has sent. The stream is given bulk priority when it has sent more than
+// muxBulkThreshold bytes, but not while it has interactive frames waiting, because a bulk frame can be
+// sent before them.
+func (q *frameQueue) pushStream(s *muxStream, size int, tm *rpc.TunnelMessage) {
+	q.Lock()
+	s.sent += uint64(size)
+	if s.level == priorityInteractive && s.sent > muxBulkThreshold && s.waiting == 0 {
+		s.level = priorityBulk
+	}
+	s.waiting++
+	q.levels[s.level] = append(q.levels[s.level], queuedFrame{tm: tm, s: s})
+	q.Unlock()
+	signal(q.ready)
+}
+
+// pop returns the next frame to send, or nil if the queue is empty.
+func (q *frameQueue) pop() *rpc.TunnelMessage {
+	q.Lock()
+	defer q.Unlock()
+	bulkWaiting := len(q.levels[priorityBulk]) > 0
+	var p priority
+	switch {
+	case len(q.levels[priorityControl]) > 0:
+		p = priorityControl
+	case len(q.levels[priorityInteractive]) > 0 && (!bulkWaiting || q.bulkSkips < muxInteractiveBurst):
+		p = priorityInteractive
+		if bulkWaiting {
+			q.bulkSkips++
+		}
+	case bulkWaiting:
+		p = priorityBulk
+		q.bulkSkips = 0
+	default:
+		return nil
+	}
+	l := q.levels[p]
+	f := l[0]
+	l[0] = queuedFrame{}
+	q.levels[p] = l[1:]
+	if f.s != nil {
+		f.s.waiting--
+	}
+	return f.tm
+}
+
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// muxConn is one gRPC stream that carries the frames of many streams.
+type muxConn struct {
+	sync.Mutex
+	tag         string
+	grpcStream  GRPCStream
+	peerVersion uint16
+	compression Compression
+	cancel      context.CancelFunc
+	handler     func(context.Context, Stream) error // only set on the server side
+	streams     map[uint32]*muxStream
+	lastNo      uint32
+	idleTimer   *time.Timer
+	err         error
+	frames      frameQueue
+	done        chan struct{}
+	handlers    sync.WaitGroup
+}
+
+func newMuxConn(tag string, grpcStream GRPCStream, peerVersion uint16, compression Compression, cancel context.CancelFunc) *muxConn {
+	return &muxConn{
+		tag:         tag,
+		grpcStream:  grpcStream,
+		peerVersion: peerVersion,
+		compression: compression,
+		cancel:      cancel,
+		streams:     make(map[uint32]*muxStream),
+		frames:      frameQueue{ready: make(chan struct{}, 1)},
+		done:        make(chan struct{}),
+	}
+}
+
+// serveMux accepts the streams that a client opens on a multiplexed gRPC stream and calls the handler
+// for each one of them. All streams use the compression that was negotiated for the gRPC stream.
+func serveMux(ctx context.Context, grpcStream GRPCStream, peerVersion uint16, compression Compression, handler func(context.Context, Stream) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	c := newMuxConn("SRV", grpcStream, peerVersion, compression, cancel)
+	c.handler = handler
+	err := c.run(ctx)
+	c.handlers.Wait()
+	return err
+}
+
+// run sends and receives frames until the gRPC stream fails or the context is cancelled.
+func (c *muxConn) run(ctx context.Context) error {
+	go c.writeLoop(ctx)
+	err := c.readLoop(ctx)
+	c.close(err)
+	switch {
+	case ctx.Err() != nil, errors.Is(err, io.EOF), errors.Is(err, net.ErrClosed), status.Code(err) == codes.Canceled:
+		return nil
+	default:
+		return err
+	}
+}
+
+func (c *muxConn) readLoop(ctx context.Context) error {
+	for {
+		tm, err := c.grpcStream.Recv()
+		if err != nil {
+			return err
+		}
+		ft, no, body, err := parseFrame(tm.Payload)
+		if err != nil {
+			return err
+		}
+		if ft == frameOpen {
+			if err = c.accept(ctx, no, body); err != nil {
+				return err
+			}
+			continue
+		}
+		c.Lock()
+		s := c.streams[no]
+		c.Unlock()
+		if s == nil {
+			// The stream has already ended on this side.
+			continue
+		}
+		switch ft {
+		case frameData:
+			if len(body) == 0 {
+				return errMalformedFrame
+			}
+			s.received(msg(body))
+		case frameWindow:
+			v, n := binary.Uvarint(body)
+			if n <= 0 || v > math.MaxInt32 {
+				return errMalformedFrame
+			}
+			s.grant(int(v))
+		case frameClose:
+			s.peerClosed()
+		case frameEnd:
+			c.remove(no)
+			s.ended(endError(body))
+		default:
+			return fmt.Errorf("unknown multiplexing frame type %d", ft)
+		}
+	}
+}
+
+func (c *muxConn) writeLoop(ctx context.Context) {
+	for {
+		if tm := c.frames.pop(); tm != nil {
+			if err := c.grpcStream.Send(tm); err != nil {
+				c.close(err)
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.frames.ready:
+		}
+	}
+}
+
+func (c *muxConn) send(p priority, tm *rpc.TunnelMessage) {
+	c.frames.push(p, tm)
+}
+
+// close ends all streams with the given error and cancels the gRPC stream.
+func (c *muxConn) close(err error) {
+	c.Lock()
+	if c.err != nil {
+		c.Unlock()
+		return
+	}
+	c.err = err
+	streams := c.streams
+	c.streams = nil
+	if c.idleTimer != nil {
+		c.idleTimer.Stop()
+	}
+	c.Unlock()
+	for _, s := range streams {
+		s.ended(err)
+	}
+	c.cancel()
+	close(c.done)
+}
+
+// closeIfIdle closes a client connection that has no streams.
+func (c *muxConn) closeIfIdle() {
+	c.Lock()
+	if c.err != nil || len(c.streams) > 0 {
+		c.Unlock()
+		return
+	}
+	c.err = errMuxIdle
+	c.Unlock()
+	c.cancel()
+	close(c.done)
+}
+
+// streamCount returns the number of open streams, and false if the connection is closed.
+func (c *muxConn) streamCount() (int, bool) {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.streams), c.err == nil
+}
+
+func (c *muxConn) remove(no uint32) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.streams, no)
+	if c.handler == nil && c.err == nil && len(c.streams) == 0 {
+		c.idleTimer = time.AfterFunc(muxIdleTimeout, c.closeIfIdle)
+	}
+}
+
+func (c *muxConn) newStream(no uint32, p priority, closeErr error) *muxStream {
+	s := &muxStream{
+		stream:    newStream(c.tag, nil),
+		conn:      c,
+		no:        no,
+		closeErr:  closeErr,
+		window:    muxWindowSize,
+		recvReady: make(chan struct{}, 1),
+		sendReady: make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	s.peerVersion = c.peerVersion
+	s.compression = c.compression
+	s.level = min(p, priorityBulk)
+	return s
+}
+
+// open opens a client stream, or returns nil if the connection is closed. The stream ends when
+// the given context is cancelled.
+func (c *muxConn) open(ctx context.Context, id ConnID, sessionID string, roundtripLatency, dialTimeout time.Duration) Stream {
+	s := c.newStream(0, priorityInteractive, net.ErrClosed)
+	s.id = id
+	s.sessionID = sessionID
+	s.roundtripLatency = roundtripLatency
+	s.dialTimeout = dialTimeout
+
+	c.Lock()
+	if c.err != nil {
+		c.Unlock()
+		return nil
+	}
+	c.lastNo++
+	s.no = c.lastNo
+	c.streams[s.no] = s
+	if c.idleTimer != nil {
+		c.idleTimer.Stop()
+		c.idleTimer = nil
+	}
+	c.Unlock()
+
+	info := StreamInfoMessage(id, sessionID, roundtripLatency, dialTimeout).TunnelMessage().Payload
+	c.send(priorityControl, makeFrame(frameOpen, s.no, append([]byte{byte(priorityInteractive)}, info...)))
+	dlog.Tracef(ctx, "-> %s %s, open stream %d", s.tag, s.id, s.no)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.end(status.FromContextError(ctx.Err()).Err())
+		case <-s.done:
+		}
+	}()
+	return s
+}
+
+// accept is called on the server side when a client opens a stream.
+func (c *muxConn) accept(ctx context.Context, no uint32, body []byte) error {
+	if c.handler == nil || len(body) < 2 {
+		return errMalformedFrame
+	}
+	m := msg(body[1:])
+	if m.Code() != streamInfo {
+		return errors.New("open frame doesn't contain a StreamInfo message")
+	}
+	s := c.newStream(no, priority(body[0]), io.EOF)
+	if err := setConnectInfo(m, &s.stream); err != nil {
+		return fmt.Errorf("failed to parse StreamInfo message: %w", err)
+	}
+	s.compression = c.compression
+	dlog.Tracef(ctx, "<- %s, %s", s.tag, m)
+	sctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	c.Lock()
+	if _, ok := c.streams[no]; ok || c.err != nil {
+		c.Unlock()
+		cancel()
+		return fmt.Errorf("stream %d is already open", no)
+	}
+	c.streams[no] = s
+	c.Unlock()
+
+	c.handlers.Add(1)
+	go func() {
+		defer c.handlers.Done()
+		s.end(c.handler(sctx, s))
+	}()
+	return nil
+}
+
+// muxStream is a Stream that is multiplexed with other streams over one gRPC stream.
+type muxStream struct {
+	stream
+	conn     *muxConn
+	no       uint32
+	closeErr error              // returned by Receive when the peer has closed its side
+	cancel   context.CancelFunc // cancels the handler of a server stream
+
+	// guarded by conn.frames
+	level   priority
+	sent    uint64
+	waiting int
+
+	mu        sync.Mutex
+	queue     []Message
+	closed    bool
+	endErr    error
+	consumed  int
+	window    int
+	recvReady chan struct{}
+	sendReady chan struct{}
+	done      chan struct{}
+}
+
+// send queues a frame of the stream, with the stream's priority.
+func (s *muxStream) send(size int, tm *rpc.TunnelMessage) {
+	s.conn.frames.pushStream(s, size, tm)
+}
+
+func (s *muxStream) Receive(ctx context.Context) (Message, error) {
+	for {
+		s.mu.Lock()
+		if len(s.queue) > 0 {
+			m := s.queue[0]
+			s.queue[0] = nil
+			s.queue = s.queue[1:]
+			s.consumed += len(m.Payload()) + 1
+			credit := 0
+			if s.consumed >= muxWindowSize/2 {
+				credit = s.consumed
+				s.consumed = 0
+			}
+			s.mu.Unlock()
+			if credit > 0 {
+				s.conn.send(priorityControl, windowFrame(s.no, credit))
+			}
+			m, err := s.decompress(m)
+			if err != nil {
+				return nil, err
+			}
+			if m.Code() == closeSend {
+				dlog.Tracef(ctx, "<- %s %s, close send", s.tag, s.id)
+				return nil, net.ErrClosed
+			}
+			dlog.Tracef(ctx, "<- %s %s, %s", s.tag, s.id, m)
+			return m, nil
+		}
+		err := s.endErr
+		if err == nil && s.closed {
+			err = s.closeErr
+		}
+		s.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.recvReady:
+		case <-s.done:
+		}
+	}
+}
+
+// Send sends the given message. A Normal message waits until the peer has granted enough window.
+func (s *muxStream) Send(ctx context.Context, m Message) error {
+	m = s.compress(m)
+	size := len(m.Payload()) + 1
+	for {
+		s.mu.Lock()
+		if s.endErr != nil {
+			s.mu.Unlock()
+			return net.ErrClosed
+		}
+		if s.window > 0 || (m.Code() != Normal && m.Code() != compressed) {
+			s.window -= size
+			s.mu.Unlock()
+			break
+		}
+		s.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.sendReady:
+		case <-s.done:
+		}
+	}
+	s.send(size, makeFrame(frameData, s.no, m.TunnelMessage().Payload))
+	dlog.Tracef(ctx, "-> %s %s, %s", s.tag, s.id, m)
+	return nil
+}
+
+func (s *muxStream) CloseSend(ctx context.Context) error {
+	s.mu.Lock()
+	ended := s.endErr != nil
+	s.mu.Unlock()
+	if !ended {
+		s.send(0, makeFrame(frameClose, s.no, nil))
+		dlog.Tracef(ctx, "-> %s %s, close send", s.tag, s.id)
+	}
+	return nil
+}
+
+func (s *muxStream) received(m Message) {
+	s.mu.Lock()
+	s.queue = append(s.queue, m)
+	s.mu.Unlock()
+	signal(s.recvReady)
+}
+
+func (s *muxStream) grant(credit int) {
+	s.mu.Lock()
+	s.window += credit
+	s.mu.Unlock()
+	signal(s.sendReady)
+}
+
+func (s *muxStream) peerClosed() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	signal(s.recvReady)
+}
+
+// ended marks the stream as ended. Messages that have been received already can still be read. It
+// returns false if the stream had ended already.
+func (s *muxStream) ended(err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.endErr != nil {
+		return false
+	}
+	s.endErr = err
+	close(s.done)
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return true
+}
+
+// end ends the stream on this side and tells the peer about it, along with the given error.
+func (s *muxStream) end(err error) {
+	if s.ended(net.ErrClosed) {
+		s.conn.remove(s.no)
+		s.send(0, endFrame(s.no, err))
+	}
+}
+
+// Mux is a StreamOpener that multiplexes streams over a few long-lived gRPC streams when the peer supports
+// it. The peer's version is learned from the first stream, which, just like all streams to peers of version 2
+// or earlier, is given a gRPC stream of its own.
+type Mux struct {
+	sync.Mutex
+	ctx         context.Context
+	provider    Provider
+	sessionID   string
+	peerVersion uint16
+	conns       []*muxConn
+}
+
+// NewMux creates a Mux that opens gRPC streams using the given provider. The gRPC streams are closed
+// when the given context is cancelled.
+func NewMux(ctx context.Context, provider Provider, sessionID string) *Mux {
+	return &Mux{ctx: ctx, provider: provider, sessionID: sessionID}
+}
+
+func (m *Mux) OpenStream(ctx context.Context, id ConnID, roundtripLatency, dialTimeout time.Duration) (Stream, error) {
+	m.Lock()
+	pv := m.peerVersion
+	m.Unlock()
+	if pv < muxVersion {
+		return m.openSingleStream(ctx, id, roundtripLatency, dialTimeout)
+	}
+	for {
+		c, err := m.getConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if s := c.open(ctx, id, m.sessionID, roundtripLatency, dialTimeout); s != nil {
+			return s, nil
+		}
+	}
+}
+
+// openSingleStream opens a stream that has a gRPC stream of its own.
+func (m *Mux) openSingleStream(ctx context.Context, id ConnID, roundtripLatency, dialTimeout time.Duration) (Stream, error) {
+	gs, err := m.provider.Tunnel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewClientStream(ctx, gs, id, m.sessionID, roundtripLatency, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	m.Lock()
+	m.peerVersion = s.PeerVersion()
+	m.Unlock()
+	return s, nil
+}
+
+// getConn returns the gRPC stream with the least number of streams, or opens a new one if all of them
+// have many streams.
+func (m *Mux) getConn(ctx context.Context) (*muxConn, error) {
+	m.Lock()
+	defer m.Unlock()
+	var best *muxConn
+	bestCount := 0
+	for _, c := range m.conns {
+		if n, ok := c.streamCount(); ok && (best == nil || n < bestCount) {
+			best, bestCount = c, n
+		}
+	}
+	if best != nil && (bestCount < muxStreamsPerConn || len(m.conns) >= muxMaxConns) {
+		return best, nil
+	}
+	c, err := m.dial(ctx)
+	if err != nil {
+		if best != nil {
+			return best, nil
+		}
+		return nil, err
+	}
+	m.conns = append(m.conns, c)
+	return c, nil
+}
+
+// dial opens a new multiplexed gRPC stream. It must be called with the Mux locked.
+func (m *Mux) dial(ctx context.Context) (*muxConn, error) {
+	cc, cancel := context.WithCancel(m.ctx)
+	stop := context.AfterFunc(ctx, cancel)
+	gs, err := m.provider.Tunnel(cc)
+	var tm *rpc.TunnelMessage
+	if err == nil {
+		if err = gs.Send(withCompression(muxInfoMessage(), GetCompression(ctx)).TunnelMessage()); err == nil {
+			if tm, err = gs.Recv(); err == nil && (len(tm.Payload) == 0 || msg(tm.Payload).Code() != streamOK) {
+				err = errors.New("initial message was not StreamOK")
+			}
+		}
+	}
+	if !stop() && err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		cancel()
+		// The peer may have been replaced by one that doesn't support multiplexing.
+		m.peerVersion = 0
+		return nil, fmt.Errorf("failed to open multiplexed tunnel: %w", err)
+	}
+	ok := msg(tm.Payload)
+	c := newMuxConn("CLI", gs, getVersion(ok), getCompression(ok), cancel)
+	go func() {
+		if err := c.run(cc); err != nil {
+			dlog.Errorf(m.ctx, "!! multiplexed tunnel failed: %v", err)
+		}
+		m.removeConn(c)
+	}()
+	return c, nil
+}
+
+func (m *Mux) removeConn(c *muxConn) {
+	m.Lock()
+	defer m.Unlock()
+	for i, x := range m.conns {
+		if x == c {
+			m.conns = append(m.conns[:i], m.conns[i+1:]...)
+			break
+		}
+	}
+}
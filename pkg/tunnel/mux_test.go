@@ -0,0 +1,310 @@
+package tunnel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/telepresenceio/telepresence/rpc/v2/manager"
+	"github.com/telepresenceio/telepresence/v2/pkg/ipproto"
+	"github.com/telepresenceio/telepresence/v2/pkg/iputil"
+)
+
+type testClient struct {
+	grpc.ClientStream
+	cs GRPCClientStream
+}
+
+func (c *testClient) Recv() (*manager.TunnelMessage, error) {
+	return c.cs.Recv()
+}
+
+func (c *testClient) Send(msg *manager.TunnelMessage) error {
+	return c.cs.Send(msg)
+}
+
+func (c *testClient) CloseSend() error {
+	return c.cs.CloseSend()
+}
+
+// testProvider serves each tunnel that it provides with ServeTunnel and the given handler.
+type testProvider struct {
+	handler func(context.Context, Stream) error
+	serve   func(context.Context, GRPCStream, func(context.Context, Stream) error) error
+	tunnels atomic.Int32
+}
+
+func (p *testProvider) Tunnel(ctx context.Context, _ ...grpc.CallOption) (Client, error) {
+	p.tunnels.Add(1)
+	b := newBidi(10, ctx.Done())
+	serve := p.serve
+	if serve == nil {
+		serve = ServeTunnel
+	}
+	go func() {
+		if err := serve(ctx, b.serverSide(), p.handler); err != nil && ctx.Err() == nil {
+			dlog.Error(ctx, err)
+		}
+	}()
+	return &testClient{cs: b.clientSide()}, nil
+}
+
+// serveV2 is a server of version 2 that doesn't know about multiplexing.
+func serveV2(ctx context.Context, grpcStream GRPCStream, handler func(context.Context, Stream) error) error {
+	s := &stream{tag: "SRV", grpcStream: grpcStream}
+	m, err := s.Receive(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Code() != streamInfo {
+		return errors.New("initial message was not StreamInfo")
+	}
+	if err = setConnectInfo(m, s); err != nil {
+		return err
+	}
+	s.compression = CompressionNone // version 2 doesn't know about compression
+	ok := makeMessage(streamOK, 1)
+	ok[1] = 2
+	if err = s.Send(ctx, ok); err != nil {
+		return err
+	}
+	return handler(ctx, s)
+}
+
+// echo sends all received messages back to the client until the client closes its side.
+func echo(ctx context.Context, s Stream) error {
+	for {
+		m, err := s.Receive(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		if err = s.Send(ctx, m); err != nil {
+			return err
+		}
+	}
+}
+
+func testConnID(port uint16) ConnID {
+	return NewConnID(ipproto.TCP, iputil.Parse("127.0.0.1"), iputil.Parse("192.168.0.1"), port, 8080)
+}
+
+func roundTrip(ctx context.Context, t *testing.T, mux *Mux, port uint16) Stream {
+	s, err := mux.OpenStream(ctx, testConnID(port), 0, 0)
+	require.NoError(t, err)
+	payload := []byte{byte(port), byte(port >> 8)}
+	require.NoError(t, s.Send(ctx, NewMessage(Normal, payload)))
+	m, err := s.Receive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, payload, m.Payload())
+	require.NoError(t, s.CloseSend(ctx))
+	return s
+}
+
+func TestMux_Multiplexed(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+
+	p := &testProvider{handler: echo}
+	mux := NewMux(ctx, p, uuid.New().String())
+
+	// The first stream has a gRPC stream of its own, because the peer's version isn't known.
+	assert.Equal(t, Version, roundTrip(ctx, t, mux, 1000).PeerVersion())
+
+	wg := sync.WaitGroup{}
+	wg.Add(20)
+	for i := 1; i <= 20; i++ {
+		go func(port uint16) {
+			defer wg.Done()
+			s := roundTrip(ctx, t, mux, port)
+			assert.Equal(t, Version, s.PeerVersion())
+
+			// The stream ends when the handler returns.
+			_, err := s.Receive(ctx)
+			assert.ErrorIs(t, err, io.EOF)
+		}(uint16(1000 + i))
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), p.tunnels.Load())
+}
+
+func TestMux_Version2Peer(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+
+	p := &testProvider{handler: echo, serve: serveV2}
+	mux := NewMux(ctx, p, uuid.New().String())
+	for i := 0; i < 5; i++ {
+		s, err := mux.OpenStream(ctx, testConnID(uint16(1000+i)), 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(2), s.PeerVersion())
+		require.NoError(t, s.Send(ctx, NewMessage(Normal, []byte("hello"))))
+		m, err := s.Receive(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), m.Payload())
+	}
+	assert.Equal(t, int32(5), p.tunnels.Load())
+}
+
+func TestMux_HandlerError(t *testing.T) {
+	ctx, cancel := testContext(t, 10*time.Second)
+	defer cancel()
+
+	p := &testProvider{handler: func(ctx context.Context, s Stream) error {
+		if s.ID().SourcePort() == 1000 {
+			return nil
+		}
+		return errors.New("no way")
+	}}
+	mux := NewMux(ctx, p, uuid.New().String())
+	_, err := mux.OpenStream(ctx, testConnID(1000), 0, 0)
+	require.NoError(t, err)
+
+	s, err := mux.OpenStream(ctx, testConnID(1001), 0, 0)
+	require.NoError(t, err)
+	_, err = s.Receive(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no way")
+}
+
+func TestMux_FlowControl(t *testing.T) {
+	ctx, cancel := testContext(t, 30*time.Second)
+	defer cancel()
+
+	serverCh := make(chan Stream, 1)
+	p := &testProvider{handler: func(ctx context.Context, s Stream) error {
+		if s.ID().SourcePort() == 1000 {
+			return nil
+		}
+		serverCh <- s
+		<-ctx.Done()
+		return nil
+	}}
+	mux := NewMux(ctx, p, uuid.New().String())
+	_, err := mux.OpenStream(ctx, testConnID(1000), 0, 0)
+	require.NoError(t, err)
+
+	s, err := mux.OpenStream(ctx, testConnID(1001), 0, 0)
+	require.NoError(t, err)
+	server := <-serverCh
+
+	// Each message consumes its payload length plus one byte of the window.
+	const msgSize = 0x8000 - 1
+	const perWindow = muxWindowSize / (msgSize + 1)
+	var sent atomic.Int32
+	go func() {
+		data := NewMessage(Normal, make([]byte, msgSize))
+		for ctx.Err() == nil {
+			if s.Send(ctx, data) != nil {
+				return
+			}
+			sent.Add(1)
+		}
+	}()
+
+	// The sender is blocked once the window is exhausted.
+	require.Eventually(t, func() bool { return sent.Load() == perWindow }, 5*time.Second, time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(perWindow), sent.Load())
+
+	// Consuming half the window grants the sender that much more.
+	for i := 0; i < perWindow/2; i++ {
+		_, err := server.Receive(ctx)
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool { return sent.Load() == perWindow+perWindow/2 }, 5*time.Second, time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(perWindow+perWindow/2), sent.Load())
+}
+
+func TestFrameQueue_Priority(t *testing.T) {
+	q := frameQueue{ready: make(chan struct{}, 1)}
+	frame := func(no uint32) *manager.TunnelMessage {
+		return makeFrame(frameData, no, []byte{byte(Normal)})
+	}
+	q.push(priorityBulk, frame(1))
+	q.push(priorityInteractive, frame(2))
+	q.push(priorityBulk, frame(3))
+	q.push(priorityControl, frame(4))
+	q.push(priorityInteractive, frame(5))
+
+	var order []uint32
+	for tm := q.pop(); tm != nil; tm = q.pop() {
+		_, no, _, err := parseFrame(tm.Payload)
+		require.NoError(t, err)
+		order = append(order, no)
+	}
+	assert.Equal(t, []uint32{4, 2, 5, 1, 3}, order)
+}
+
+func TestFrameQueue_BulkNotStarved(t *testing.T) {
+	q := frameQueue{ready: make(chan struct{}, 1)}
+	frame := func(no uint32) *manager.TunnelMessage {
+		return makeFrame(frameData, no, []byte{byte(Normal)})
+	}
+	const bulkFrames = 3
+	for i := 0; i < bulkFrames; i++ {
+		q.push(priorityBulk, frame(1))
+	}
+
+	// Interactive frames keep arriving faster than they are sent, but the bulk frames still get through.
+	var bulkAt []int
+	for i := 0; i < 100; i++ {
+		q.push(priorityInteractive, frame(2))
+		q.push(priorityInteractive, frame(2))
+		_, no, _, err := parseFrame(q.pop().Payload)
+		require.NoError(t, err)
+		if no == 1 {
+			bulkAt = append(bulkAt, i)
+		}
+	}
+	require.Len(t, bulkAt, bulkFrames)
+	for i, at := range bulkAt {
+		assert.Equal(t, (i+1)*(muxInteractiveBurst+1)-1, at)
+	}
+}
+
+func TestFrameQueue_StreamOrder(t *testing.T) {
+	q := frameQueue{ready: make(chan struct{}, 1)}
+	s := &muxStream{no: 1, level: priorityInteractive}
+	frame := func(no uint32) *manager.TunnelMessage {
+		return makeFrame(frameData, no, []byte{byte(Normal)})
+	}
+	q.push(priorityBulk, frame(0))
+	for i := 0; i < muxInteractiveBurst; i++ {
+		q.push(priorityInteractive, frame(2))
+	}
+
+	// The stream passes the bulk threshold while its first frame is waiting, so the next frame must
+	// retain the interactive priority.
+	q.pushStream(s, muxBulkThreshold, frame(1))
+	q.pushStream(s, 1, frame(1))
+	assert.Equal(t, priorityInteractive, s.level)
+	assert.Equal(t, 2, s.waiting)
+
+	var order []uint32
+	for tm := q.pop(); tm != nil; tm = q.pop() {
+		_, no, _, err := parseFrame(tm.Payload)
+		require.NoError(t, err)
+		order = append(order, no)
+	}
+	assert.Equal(t, []uint32{2, 2, 2, 2, 0, 1, 1}, order)
+	assert.Equal(t, 0, s.waiting)
+
+	// With no frames waiting, the stream is given bulk priority.
+	q.pushStream(s, 1, frame(1))
+	assert.Equal(t, priorityBulk, s.level)
+}
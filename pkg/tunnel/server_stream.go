@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func NewServerStream(ctx context.Context, grpcStream GRPCStream) (Stream, error) {
@@ -15,11 +18,44 @@ func NewServerStream(ctx context.Context, grpcStream GRPCStream) (Stream, error)
 	if m.Code() != streamInfo {
 		return nil, errors.New("initial message was not StreamInfo")
 	}
-	if err = setConnectInfo(m, s); err != nil {
-		return nil, fmt.Errorf("failed to parse StreamInfo message: %w", err)
-	}
-	if err = s.Send(ctx, StreamOKMessage()); err != nil {
+	if err = s.accept(ctx, m); err != nil {
 		return nil, err
 	}
 	return s, nil
 }
+
+// ServeTunnel serves a gRPC stream that was opened by a tunnel client, and calls the given handler with
+// each Stream that the client opens. A client of version 2 or earlier opens one Stream per gRPC stream,
+// whereas a later client may multiplex many Streams over the same gRPC stream. The handlers of multiplexed
+// streams run concurrently, and ServeTunnel will not return until all of them have returned.
+func ServeTunnel(ctx context.Context, grpcStream GRPCStream, handler func(context.Context, Stream) error) error {
+	s := &stream{tag: "SRV", grpcStream: grpcStream, syncRatio: 8, ackWindow: 1}
+	m, err := s.Receive(ctx)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to connect stream: failed to read initial message: %v", err)
+	}
+	switch m.Code() {
+	case streamInfo:
+		if err = s.accept(ctx, m); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "failed to connect stream: %v", err)
+		}
+		return handler(ctx, s)
+	case muxInfo:
+		compression := getCompression(m)
+		if err = s.Send(ctx, withCompression(StreamOKMessage(), compression)); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "failed to connect stream: %v", err)
+		}
+		return serveMux(ctx, grpcStream, getVersion(m), compression, handler)
+	default:
+		return status.Error(codes.FailedPrecondition, "failed to connect stream: initial message was neither StreamInfo nor MuxInfo")
+	}
+}
+
+// accept parses the given StreamInfo message and responds with a StreamOK message that confirms
+// the proposed compression.
+func (s *stream) accept(ctx context.Context, m Message) error {
+	if err := setConnectInfo(m, s); err != nil {
+		return fmt.Errorf("failed to parse StreamInfo message: %w", err)
+	}
+	return s.Send(ctx, withCompression(StreamOKMessage(), s.compression))
+}
@@ -21,7 +21,12 @@ import (
 //
 //	0 which didn't report versions and didn't do synchronization
 //	1 used MuxTunnel instead of one tunnel per connection.
-const Version = uint16(2)
+//	2 used one gRPC stream per connection.
+//	3 multiplexes connections over a few gRPC streams, with flow control and priorities.
+const Version = uint16(3)
+
+// muxVersion is the first version that accepts multiplexed gRPC streams.
+const muxVersion = uint16(3)
 
 // Endpoint is an endpoint for a Stream such as a Dialer or a bidirectional pipe.
 type Endpoint interface {
@@ -72,6 +77,11 @@ type Stream interface {
 // StreamCreator is a function that creats a Stream.
 type StreamCreator func(context.Context, ConnID) (Stream, error)
 
+// StreamOpener opens client Streams. A Stream ends when the context that it was opened with is cancelled.
+type StreamOpener interface {
+	OpenStream(ctx context.Context, id ConnID, roundtripLatency, dialTimeout time.Duration) (Stream, error)
+}
+
 // ReadLoop reads from the Stream and dispatches messages and error to the give channels. There
 // will be max one error since the error also terminates the loop.
 func ReadLoop(ctx context.Context, s Stream, p *CounterProbe) (<-chan Message, <-chan error) {
@@ -176,6 +186,7 @@ func WriteLoop(
 }
 
 type stream struct {
+	compressor
 	grpcStream       GRPCStream
 	id               ConnID
 	dialTimeout      time.Duration
@@ -220,7 +231,10 @@ func (s *stream) Receive(ctx context.Context) (Message, error) {
 	if err != nil {
 		return nil, err
 	}
-	m := msg(cm.Payload)
+	m, err := s.decompress(msg(cm.Payload))
+	if err != nil {
+		return nil, err
+	}
 	switch m.Code() {
 	case closeSend:
 		dlog.Tracef(ctx, "<- %s %s, close send", s.tag, s.id)
@@ -234,7 +248,7 @@ func (s *stream) Receive(ctx context.Context) (Message, error) {
 }
 
 func (s *stream) Send(ctx context.Context, m Message) error {
-	if err := s.grpcStream.Send(m.TunnelMessage()); err != nil {
+	if err := s.grpcStream.Send(s.compress(m).TunnelMessage()); err != nil {
 		if ctx.Err() == nil && !errors.Is(err, net.ErrClosed) {
 			dlog.Errorf(ctx, "!! %s %s, Send failed: %v", s.tag, s.id, err)
 		}
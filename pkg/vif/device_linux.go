@@ -105,6 +105,11 @@ func (t *nativeDevice) addSubnet(ctx context.Context, subnet *net.IPNet) error {
 		return fmt.Errorf("failed to find link for interface %s: %w", t.name, err)
 	}
 	addr := &netlink.Addr{IPNet: subnet}
+	if subnet.IP.To4() == nil {
+		// The TUN device doesn't answer neighbor solicitations, so duplicate address detection would
+		// leave the address tentative and unusable.
+		addr.Flags = unix.IFA_F_NODAD
+	}
 	if err := netlink.AddrAdd(link, addr); err != nil {
 		return fmt.Errorf("failed to add address %s to interface %s: %w", subnet, t.name, err)
 	}
@@ -118,12 +118,14 @@ func (rt *Router) UpdateRoutes(ctx context.Context, pleaseProxy, dontProxy, dont
 		}
 	}
 
+	// Subnets that are too small to be assigned to the TUN-device are routed using static routes that
+	// use the same interface as a subnet of the same IP family that is assigned to it.
 	var staticNets []*net.IPNet
-	var pr *routing.Route
+	primaryRoutes := make(map[int]*routing.Route, 2) // keyed by the number of bits in the mask
 	for _, sn := range added {
 		var err error
 		ones, bits := sn.Mask.Size()
-		if bits == 32 && ones > 30 {
+		if bits-ones < 2 {
 			staticNets = append(staticNets, sn)
 			continue
 		}
@@ -133,27 +135,43 @@ func (rt *Router) UpdateRoutes(ctx context.Context, pleaseProxy, dontProxy, dont
 			continue
 		}
 
-		if pr == nil {
-			if pr, err = routing.GetRoute(ctx, sn); err == nil {
+		if _, ok := primaryRoutes[bits]; !ok {
+			pr, err := routing.GetRoute(ctx, sn)
+			if err != nil {
 				dlog.Errorf(ctx, "failed to retrieve route for subnet %s: %v", sn, err)
+			} else {
+				primaryRoutes[bits] = pr
 			}
 		}
 	}
-	if len(staticNets) > 0 && pr == nil {
-		return fmt.Errorf("unable to route subnets %v, because there's no subnet with a mask smaller than 31 bits", staticNets)
+	for _, sn := range staticNets {
+		_, bits := sn.Mask.Size()
+		if primaryRoutes[bits] == nil {
+			return fmt.Errorf("unable to route subnet %s, because there's no subnet of the same IP family with a mask smaller than %d bits",
+				sn, bits-1)
+		}
 	}
-	return rt.addStaticOverrides(ctx, dontProxy, dontProxyOverrides, staticNets, pr)
+	return rt.addStaticOverrides(ctx, dontProxy, dontProxyOverrides, staticNets, primaryRoutes)
 }
 
-func (rt *Router) addStaticOverrides(ctx context.Context, neverProxy, neverProxyOverrides, staticNets []*net.IPNet, primaryRoute *routing.Route) (err error) {
+func (rt *Router) addStaticOverrides(
+	ctx context.Context,
+	neverProxy, neverProxyOverrides, staticNets []*net.IPNet,
+	primaryRoutes map[int]*routing.Route,
+) (err error) {
 	desired := make([]*routing.Route, 0, len(neverProxy)+len(neverProxyOverrides))
-	dr, err := routing.DefaultRoute(ctx)
-	if err != nil {
-		return err
-	}
+	defaultRoutes := make(map[int]*routing.Route, 2) // keyed by the number of bits in the mask
 	for _, sn := range neverProxy {
 		// All subnets in neverProxy have been verified as being routed by the TUN-device, so we
-		// route them to the default route instead.
+		// route them to the default route of their IP family instead.
+		_, bits := sn.Mask.Size()
+		dr, ok := defaultRoutes[bits]
+		if !ok {
+			if dr, err = routing.DefaultRouteFor(ctx, sn.IP); err != nil {
+				return err
+			}
+			defaultRoutes[bits] = dr
+		}
 		desired = append(desired, &routing.Route{
 			LocalIP:   dr.LocalIP,
 			RoutedNet: sn,
@@ -179,6 +197,8 @@ func (rt *Router) addStaticOverrides(ctx context.Context, neverProxy, neverProxy
 	}
 
 	for _, sn := range staticNets {
+		_, bits := sn.Mask.Size()
+		primaryRoute := primaryRoutes[bits]
 		desired = append(desired, &routing.Route{
 			LocalIP:   primaryRoute.LocalIP,
 			RoutedNet: sn,
@@ -40,6 +40,15 @@ func getCidr(byte3, byte4 byte, mask int) *net.IPNet {
 	}
 }
 
+func getCidr6(word7, word8 uint16, mask int) *net.IPNet {
+	// 2001:db8::/32 is reserved for documentation.
+	ip := net.IP{0x20, 0x01, 0x0d, 0xb8, 12: byte(word7 >> 8), 13: byte(word7), 14: byte(word8 >> 8), 15: byte(word8)}
+	return &net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(mask, 128),
+	}
+}
+
 func (s *RoutingSuite) SetupSuite() {
 	// Compile the router binary
 	if runtime.GOOS == "windows" {
@@ -267,6 +276,52 @@ func (s *RoutingSuite) Test_GetRoute() {
 	s.Require().Equal(cidr.IP, route.LocalIP)
 }
 
+func (s *RoutingSuite) Test_DualStackRoutesAreAdded() {
+	ctx := context.Background()
+	cidr4 := getCidr(2, 0, 24)
+	cidr6 := getCidr6(2, 0, 112)
+
+	device, routerCancel, err := s.runRouter(ctx, cidr4.String(), cidr6.String())
+	s.Require().NoError(err)
+	defer routerCancel()
+
+	route, err := routing.GetRoute(ctx, getCidr(2, 1, 32))
+	s.Require().NoError(err)
+	s.Require().Equal(device, route.Interface.Name)
+
+	route, err = routing.GetRoute(ctx, getCidr6(2, 1, 128))
+	s.Require().NoError(err)
+	s.Require().Equal(device, route.Interface.Name)
+	s.Require().Equal(cidr6.String(), route.RoutedNet.String())
+}
+
+func (s *RoutingSuite) Test_IPv6StaticRoute() {
+	ctx := context.Background()
+	cidr6 := getCidr6(2, 0, 112)
+	single := getCidr6(4, 1, 128)
+
+	device, routerCancel, err := s.runRouter(ctx, cidr6.String(), single.String())
+	s.Require().NoError(err)
+	defer routerCancel()
+
+	// The /128 can't be assigned to the device, so it must be routed using a static route
+	// that uses the same device as the IPv6 subnet.
+	route, err := routing.GetRoute(ctx, single)
+	s.Require().NoError(err)
+	s.Require().Equal(device, route.Interface.Name)
+}
+
+func (s *RoutingSuite) Test_IPv6StaticRouteWithoutIPv6Subnet() {
+	ctx := context.Background()
+
+	// An IPv4 subnet can't be used as the primary route of an IPv6 static route.
+	_, routerCancel, err := s.runRouter(ctx, getCidr(2, 0, 24).String(), getCidr6(4, 1, 128).String())
+	if routerCancel != nil {
+		defer routerCancel()
+	}
+	s.Require().Error(err)
+}
+
 func (s *RoutingSuite) printRoutingTable(ctx context.Context) { //nolint:unused // Useful for debugging
 	var err error
 	// Print out the routing table for debugging
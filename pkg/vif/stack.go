@@ -212,8 +212,8 @@ func forwardUDP(ctx context.Context, streamCreator tunnel.StreamCreator, fr *udp
 	ctx, span := otel.GetTracerProvider().Tracer("").Start(ctx, "UDPHandler",
 		trace.WithNewRoot(),
 		trace.WithAttributes(
-			attribute.String("tel2.remote-ip", id.RemoteAddress.To4().String()),
-			attribute.String("tel2.local-ip", id.LocalAddress.To4().String()),
+			attribute.String("tel2.remote-ip", id.RemoteAddress.String()),
+			attribute.String("tel2.local-ip", id.LocalAddress.String()),
 			attribute.Int("tel2.local-port", int(id.LocalPort)),
 			attribute.Int("tel2.remote-port", int(id.RemotePort)),
 			attribute.Bool("tel2.port-blocked", false),
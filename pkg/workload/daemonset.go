@@ -0,0 +1,100 @@
+package workload
+
+import (
+	"context"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	typedApps "k8s.io/client-go/kubernetes/typed/apps/v1"
+
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+func GetDaemonSet(c context.Context, name, namespace string) (k8sapi.Workload, error) {
+	d, err := daemonSets(c, namespace).Get(c, name, meta.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &daemonSet{d}, nil
+}
+
+func DaemonSet(d *apps.DaemonSet) k8sapi.Workload {
+	return &daemonSet{d}
+}
+
+// DaemonSetImpl casts the given Object as an *apps.DaemonSet and returns
+// it together with a status flag indicating whether the cast was possible.
+func DaemonSetImpl(o k8sapi.Object) (*apps.DaemonSet, bool) {
+	if s, ok := o.(*daemonSet); ok {
+		return s.DaemonSet, true
+	}
+	return nil, false
+}
+
+type daemonSet struct {
+	*apps.DaemonSet
+}
+
+func daemonSets(c context.Context, namespace string) typedApps.DaemonSetInterface {
+	return k8sapi.GetK8sInterface(c).AppsV1().DaemonSets(namespace)
+}
+
+func (o *daemonSet) ki(c context.Context) typedApps.DaemonSetInterface {
+	return daemonSets(c, o.Namespace)
+}
+
+func (o *daemonSet) GetKind() string {
+	return "DaemonSet"
+}
+
+func (o *daemonSet) Delete(c context.Context) error {
+	return o.ki(c).Delete(c, o.Name, meta.DeleteOptions{})
+}
+
+func (o *daemonSet) GetPodTemplate() *core.PodTemplateSpec {
+	return &o.Spec.Template
+}
+
+func (o *daemonSet) Patch(c context.Context, pt types.PatchType, data []byte, subresources ...string) error {
+	d, err := o.ki(c).Patch(c, o.Name, pt, data, meta.PatchOptions{}, subresources...)
+	if err == nil {
+		o.DaemonSet = d
+	}
+	return err
+}
+
+func (o *daemonSet) Refresh(c context.Context) error {
+	d, err := o.ki(c).Get(c, o.Name, meta.GetOptions{})
+	if err == nil {
+		o.DaemonSet = d
+	}
+	return err
+}
+
+// Replicas returns the number of nodes that should run the daemon pod.
+func (o *daemonSet) Replicas() int {
+	return int(o.Status.DesiredNumberScheduled)
+}
+
+func (o *daemonSet) Selector() (labels.Selector, error) {
+	return meta.LabelSelectorAsSelector(o.Spec.Selector)
+}
+
+func (o *daemonSet) Update(c context.Context) error {
+	d, err := o.ki(c).Update(c, o.DaemonSet, meta.UpdateOptions{})
+	if err == nil {
+		o.DaemonSet = d
+	}
+	return err
+}
+
+func (o *daemonSet) Updated(origGeneration int64) bool {
+	applied := o.ObjectMeta.Generation >= origGeneration &&
+		o.Status.ObservedGeneration == o.ObjectMeta.Generation &&
+		o.Status.UpdatedNumberScheduled == o.Status.DesiredNumberScheduled &&
+		o.Status.NumberAvailable == o.Status.DesiredNumberScheduled
+	return applied
+}
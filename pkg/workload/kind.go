@@ -0,0 +1,149 @@
+package workload
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// CustomKind describes a custom resource that controls pods using a pod template, and that can be used as an
+// intercept target in the same way as a Deployment. The pod template must be found in the resource's
+// spec.template, and its label selector in spec.selector.
+type CustomKind struct {
+	Group   string `json:"group" yaml:"group"`
+	Version string `json:"version" yaml:"version"`
+	Kind    string `json:"kind" yaml:"kind"`
+
+	// Resource is the plural name of the resource. It is guessed from the kind when empty.
+	Resource string `json:"resource,omitempty" yaml:"resource,omitempty"`
+}
+
+// ArgoRollout is the Rollout of Argo Rollouts. It is always supported.
+var ArgoRollout = CustomKind{ //nolint:gochecknoglobals // constant
+	Group:    "argoproj.io",
+	Version:  "v1alpha1",
+	Kind:     "Rollout",
+	Resource: "rollouts",
+}
+
+func (ck CustomKind) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: ck.Group, Version: ck.Version, Kind: ck.Kind}
+}
+
+func (ck CustomKind) GroupVersionResource() schema.GroupVersionResource {
+	if ck.Resource != "" {
+		return schema.GroupVersionResource{Group: ck.Group, Version: ck.Version, Resource: ck.Resource}
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(ck.GroupVersionKind())
+	return gvr
+}
+
+func (ck CustomKind) String() string {
+	return ck.GroupVersionResource().GroupResource().String()
+}
+
+// Validate checks that the kind, version, and group are set, and that the kind doesn't collide with a
+// workload kind that is built in.
+func (ck CustomKind) Validate() error {
+	switch {
+	case ck.Kind == "":
+		return fmt.Errorf("custom workload kind %s has no kind", ck)
+	case ck.Group == "" || ck.Version == "":
+		return fmt.Errorf("custom workload kind %s must have both a group and a version", ck.Kind)
+	case isBuiltin(ck.Kind):
+		return fmt.Errorf("%s is not a custom workload kind", ck.Kind)
+	}
+	return nil
+}
+
+func isBuiltin(kind string) bool {
+	switch kind {
+	case "Deployment", "ReplicaSet", "StatefulSet", "DaemonSet", "Pod":
+		return true
+	}
+	return false
+}
+
+// GroupResource returns the API group and resource of the given workload kind, or false if the kind
+// is unknown.
+func GroupResource(ctx context.Context, kind string) (schema.GroupResource, bool) {
+	switch kind {
+	case "Deployment", "ReplicaSet", "StatefulSet", "DaemonSet":
+		return schema.GroupResource{Group: "apps", Resource: strings.ToLower(kind) + "s"}, true
+	case "Pod":
+		return schema.GroupResource{Resource: "pods"}, true
+	}
+	if ck, ok := FindCustomKind(ctx, kind); ok {
+		return ck.GroupVersionResource().GroupResource(), true
+	}
+	return schema.GroupResource{}, false
+}
+
+type customKindsKey struct{}
+
+// WithCustomKinds returns a context that is configured with the given kinds in addition to the kinds that
+// are always supported.
+func WithCustomKinds(ctx context.Context, kinds []CustomKind) context.Context {
+	return context.WithValue(ctx, customKindsKey{}, kinds)
+}
+
+// GetCustomKinds returns the kinds that are always supported, followed by the kinds that were added using
+// WithCustomKinds. A configured kind replaces a kind that is always supported when their kinds are equal.
+func GetCustomKinds(ctx context.Context) []CustomKind {
+	configured, _ := ctx.Value(customKindsKey{}).([]CustomKind)
+	kinds := make([]CustomKind, 0, len(configured)+1)
+	if !hasKind(configured, ArgoRollout.Kind) {
+		kinds = append(kinds, ArgoRollout)
+	}
+	return append(kinds, configured...)
+}
+
+// FindCustomKind returns the custom kind that has the given kind.
+func FindCustomKind(ctx context.Context, kind string) (CustomKind, bool) {
+	for _, ck := range GetCustomKinds(ctx) {
+		if ck.Kind == kind {
+			return ck, true
+		}
+	}
+	return CustomKind{}, false
+}
+
+// FindCustomKindFor returns the custom kind that has the given kind and the group of the given apiVersion.
+func FindCustomKindFor(ctx context.Context, apiVersion, kind string) (CustomKind, bool) {
+	group := ""
+	if i := strings.IndexByte(apiVersion, '/'); i > 0 {
+		group = apiVersion[:i]
+	}
+	for _, ck := range GetCustomKinds(ctx) {
+		if ck.Kind == kind && ck.Group == group {
+			return ck, true
+		}
+	}
+	return CustomKind{}, false
+}
+
+func hasKind(kinds []CustomKind, kind string) bool {
+	for _, ck := range kinds {
+		if ck.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+type dynamicKey struct{}
+
+// WithDynamicInterface returns a context that is configured with the client used for custom workloads.
+func WithDynamicInterface(ctx context.Context, di dynamic.Interface) context.Context {
+	return context.WithValue(ctx, dynamicKey{}, di)
+}
+
+// GetDynamicInterface returns the client used for custom workloads, or nil if no client has been configured.
+func GetDynamicInterface(ctx context.Context) dynamic.Interface {
+	di, _ := ctx.Value(dynamicKey{}).(dynamic.Interface)
+	return di
+}
@@ -0,0 +1,117 @@
+package workload
+
+import (
+	"context"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	typedCore "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+	"github.com/telepresenceio/telepresence/v2/pkg/maps"
+)
+
+func GetPod(c context.Context, name, namespace string) (k8sapi.Workload, error) {
+	p, err := pods(c, namespace).Get(c, name, meta.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &pod{Pod: p}, nil
+}
+
+// Pod returns the given pod as a workload. Only pods that are recreated using the same name by their
+// controller, such as the pods of a StatefulSet, can have a traffic-agent injected as pod workloads.
+func Pod(p *core.Pod) k8sapi.Workload {
+	return &pod{Pod: p}
+}
+
+// PodImpl casts the given Object as a *core.Pod and returns it together with a
+// status flag indicating whether the cast was possible.
+func PodImpl(o k8sapi.Object) (*core.Pod, bool) {
+	if s, ok := o.(*pod); ok {
+		return s.Pod, true
+	}
+	return nil, false
+}
+
+type pod struct {
+	*core.Pod
+	template *core.PodTemplateSpec
+}
+
+func pods(c context.Context, namespace string) typedCore.PodInterface {
+	return k8sapi.GetK8sInterface(c).CoreV1().Pods(namespace)
+}
+
+func (o *pod) ki(c context.Context) typedCore.PodInterface {
+	return pods(c, o.Namespace)
+}
+
+func (o *pod) set(p *core.Pod) {
+	o.Pod = p
+	o.template = nil
+}
+
+func (o *pod) GetKind() string {
+	return "Pod"
+}
+
+func (o *pod) Delete(c context.Context) error {
+	return o.ki(c).Delete(c, o.Name, meta.DeleteOptions{})
+}
+
+// GetPodTemplate returns a template that is created from the pod's labels, annotations, and spec.
+func (o *pod) GetPodTemplate() *core.PodTemplateSpec {
+	if o.template == nil {
+		o.template = &core.PodTemplateSpec{
+			ObjectMeta: meta.ObjectMeta{
+				Name:        o.Name,
+				Namespace:   o.Namespace,
+				Labels:      maps.Copy(o.Labels),
+				Annotations: maps.Copy(o.Annotations),
+			},
+			Spec: *o.Spec.DeepCopy(),
+		}
+	}
+	return o.template
+}
+
+func (o *pod) Patch(c context.Context, pt types.PatchType, data []byte, subresources ...string) error {
+	p, err := o.ki(c).Patch(c, o.Name, pt, data, meta.PatchOptions{}, subresources...)
+	if err == nil {
+		o.set(p)
+	}
+	return err
+}
+
+func (o *pod) Refresh(c context.Context) error {
+	p, err := o.ki(c).Get(c, o.Name, meta.GetOptions{})
+	if err == nil {
+		o.set(p)
+	}
+	return err
+}
+
+// Replicas always returns 1. A pod is its own single replica.
+func (o *pod) Replicas() int {
+	return 1
+}
+
+// Selector returns a selector for the pod's labels. Note that this selector might select other pods too.
+func (o *pod) Selector() (labels.Selector, error) {
+	return labels.SelectorFromSet(o.Labels), nil
+}
+
+func (o *pod) Update(c context.Context) error {
+	p, err := o.ki(c).Update(c, o.Pod, meta.UpdateOptions{})
+	if err == nil {
+		o.set(p)
+	}
+	return err
+}
+
+func (o *pod) Updated(int64) bool {
+	return o.DeletionTimestamp == nil && o.Status.Phase == core.PodRunning
+}
@@ -0,0 +1,259 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+var errNoDynamicInterface = errors.New("no client for custom workloads has been configured")
+
+// GetWorkload returns a workload for the given name, namespace, and workloadKind. The workloadKind
+// is optional. A search is performed in the following order if it is empty:
+//
+//  1. Deployments
+//  2. ReplicaSets
+//  3. StatefulSets
+//  4. DaemonSets
+//  5. The custom kinds, in the order returned by GetCustomKinds
+//
+// The first match is returned. Pods are never searched for, but they are returned when the workloadKind
+// is "Pod". A k8sapi.UnsupportedWorkloadKindError is returned when the kind is neither built in nor custom.
+func GetWorkload(ctx context.Context, name, namespace, workloadKind string) (k8sapi.Workload, error) {
+	switch workloadKind {
+	case "DaemonSet":
+		return GetDaemonSet(ctx, name, namespace)
+	case "Pod":
+		return GetPod(ctx, name, namespace)
+	}
+	wl, err := k8sapi.GetWorkload(ctx, name, namespace, workloadKind)
+	if err == nil {
+		return wl, nil
+	}
+	if workloadKind == "" {
+		if !k8sErrors.IsNotFound(err) {
+			return nil, err
+		}
+		wl, dsErr := GetDaemonSet(ctx, name, namespace)
+		if dsErr == nil {
+			return wl, nil
+		}
+		if !k8sErrors.IsNotFound(dsErr) || GetDynamicInterface(ctx) == nil {
+			return nil, err
+		}
+		for _, ck := range GetCustomKinds(ctx) {
+			cwl, cErr := GetCustomWorkload(ctx, ck, name, namespace)
+			if cErr == nil {
+				return cwl, nil
+			}
+			if !k8sErrors.IsNotFound(cErr) {
+				return nil, cErr
+			}
+		}
+		return nil, err
+	}
+	var uwkErr k8sapi.UnsupportedWorkloadKindError
+	if errors.As(err, &uwkErr) {
+		if ck, ok := FindCustomKind(ctx, workloadKind); ok {
+			return GetCustomWorkload(ctx, ck, name, namespace)
+		}
+	}
+	return nil, err
+}
+
+// GetCustomWorkload returns the workload of the given custom kind with the given name and namespace.
+func GetCustomWorkload(ctx context.Context, ck CustomKind, name, namespace string) (k8sapi.Workload, error) {
+	di := GetDynamicInterface(ctx)
+	if di == nil {
+		return nil, errNoDynamicInterface
+	}
+	u, err := di.Resource(ck.GroupVersionResource()).Namespace(namespace).Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return Custom(ck, u), nil
+}
+
+// WrapWorkload is like k8sapi.WrapWorkload, but it also accepts an *apps.DaemonSet, a *core.Pod, and an
+// *unstructured.Unstructured, which is then treated as a workload of a custom kind.
+func WrapWorkload(obj runtime.Object) (k8sapi.Workload, error) {
+	switch o := obj.(type) {
+	case *apps.DaemonSet:
+		return DaemonSet(o), nil
+	case *core.Pod:
+		return Pod(o), nil
+	case *unstructured.Unstructured:
+		gvk := o.GroupVersionKind()
+		return Custom(CustomKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind}, o), nil
+	}
+	return k8sapi.WrapWorkload(obj)
+}
+
+// Custom returns the given object as a workload of the given custom kind.
+func Custom(ck CustomKind, u *unstructured.Unstructured) k8sapi.Workload {
+	return &customWorkload{Unstructured: u, kind: ck}
+}
+
+// CustomImpl casts the given Object as an *unstructured.Unstructured and returns it together
+// with its custom kind and a status flag indicating whether the cast was possible.
+func CustomImpl(o k8sapi.Object) (*unstructured.Unstructured, CustomKind, bool) {
+	if c, ok := o.(*customWorkload); ok {
+		return c.Unstructured, c.kind, true
+	}
+	return nil, CustomKind{}, false
+}
+
+type customWorkload struct {
+	*unstructured.Unstructured
+	kind     CustomKind
+	template *core.PodTemplateSpec
+}
+
+func (o *customWorkload) ri(c context.Context) (dynamic.ResourceInterface, error) {
+	di := GetDynamicInterface(c)
+	if di == nil {
+		return nil, errNoDynamicInterface
+	}
+	return di.Resource(o.kind.GroupVersionResource()).Namespace(o.GetNamespace()), nil
+}
+
+func (o *customWorkload) set(u *unstructured.Unstructured) {
+	o.Unstructured = u
+	o.template = nil
+}
+
+func (o *customWorkload) GetKind() string {
+	return o.kind.Kind
+}
+
+func (o *customWorkload) Delete(c context.Context) error {
+	ri, err := o.ri(c)
+	if err != nil {
+		return err
+	}
+	return ri.Delete(c, o.GetName(), meta.DeleteOptions{})
+}
+
+// GetPodTemplate returns the spec.template of the workload. An empty template is returned if
+// the workload has no template, or if the template cannot be converted into a PodTemplateSpec.
+func (o *customWorkload) GetPodTemplate() *core.PodTemplateSpec {
+	if o.template == nil {
+		o.template = &core.PodTemplateSpec{}
+		if tm, ok, _ := unstructured.NestedMap(o.Object, "spec", "template"); ok {
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(tm, o.template); err != nil {
+				o.template = &core.PodTemplateSpec{}
+			}
+		}
+	}
+	return o.template
+}
+
+func (o *customWorkload) Patch(c context.Context, pt types.PatchType, data []byte, subresources ...string) error {
+	ri, err := o.ri(c)
+	if err != nil {
+		return err
+	}
+	u, err := ri.Patch(c, o.GetName(), pt, data, meta.PatchOptions{}, subresources...)
+	if err == nil {
+		o.set(u)
+	}
+	return err
+}
+
+func (o *customWorkload) Refresh(c context.Context) error {
+	ri, err := o.ri(c)
+	if err != nil {
+		return err
+	}
+	u, err := ri.Get(c, o.GetName(), meta.GetOptions{})
+	if err == nil {
+		o.set(u)
+	}
+	return err
+}
+
+func (o *customWorkload) Replicas() int {
+	return int(o.int64Field(0, "status", "replicas"))
+}
+
+func (o *customWorkload) Selector() (labels.Selector, error) {
+	sm, ok, err := unstructured.NestedMap(o.Object, "spec", "selector")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return labels.Nothing(), nil
+	}
+	var ls meta.LabelSelector
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(sm, &ls); err != nil {
+		return nil, err
+	}
+	return meta.LabelSelectorAsSelector(&ls)
+}
+
+func (o *customWorkload) Update(c context.Context) error {
+	ri, err := o.ri(c)
+	if err != nil {
+		return err
+	}
+	u, err := ri.Update(c, o.Unstructured, meta.UpdateOptions{})
+	if err == nil {
+		o.set(u)
+	}
+	return err
+}
+
+// Updated uses the status fields that most controllers use to report progress. The
+// observedGeneration is only considered when it is present. Some controllers, like
+// Argo Rollouts, report it as a string.
+func (o *customWorkload) Updated(origGeneration int64) bool {
+	generation := o.GetGeneration()
+	if generation < origGeneration {
+		return false
+	}
+	if og, ok := o.observedGeneration(); ok && og != generation {
+		return false
+	}
+	specReplicas := o.int64Field(1, "spec", "replicas")
+	replicas := o.int64Field(0, "status", "replicas")
+	updated := o.int64Field(0, "status", "updatedReplicas")
+	available := o.int64Field(0, "status", "availableReplicas")
+	return updated >= specReplicas && updated == replicas && available == replicas
+}
+
+func (o *customWorkload) observedGeneration() (int64, bool) {
+	v, ok, _ := unstructured.NestedFieldNoCopy(o.Object, "status", "observedGeneration")
+	if !ok {
+		return 0, false
+	}
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	case string:
+		if og, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return og, true
+		}
+	}
+	return 0, false
+}
+
+func (o *customWorkload) int64Field(defaultValue int64, fields ...string) int64 {
+	if v, ok, err := unstructured.NestedInt64(o.Object, fields...); ok && err == nil {
+		return v
+	}
+	return defaultValue
+}
@@ -0,0 +1,257 @@
+package workload
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/datawire/dlib/dlog"
+	"github.com/datawire/k8sapi/pkg/k8sapi"
+)
+
+var webApp = CustomKind{ //nolint:gochecknoglobals // constant
+	Group:   "apps.example.com",
+	Version: "v1",
+	Kind:    "WebApp",
+}
+
+func customObject(ck CustomKind, name string, status map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": ck.Group + "/" + ck.Version,
+		"kind":       ck.Kind,
+		"metadata": map[string]any{
+			"name":       name,
+			"namespace":  "default",
+			"generation": int64(2),
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"selector": map[string]any{
+				"matchLabels": map[string]any{"app": name},
+			},
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"labels": map[string]any{"app": name},
+				},
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name":  "echo",
+							"image": "jmalloc/echo-server",
+							"ports": []any{map[string]any{"name": "http", "containerPort": int64(8080)}},
+						},
+					},
+				},
+			},
+		},
+		"status": status,
+	}}
+}
+
+func testContext(t *testing.T, objs ...runtime.Object) context.Context {
+	ctx := dlog.NewTestContext(t, false)
+	ctx = k8sapi.WithK8sInterface(ctx, fake.NewSimpleClientset(
+		&apps.Deployment{
+			ObjectMeta: meta.ObjectMeta{Name: "dep", Namespace: "default"},
+		},
+		&apps.DaemonSet{
+			ObjectMeta: meta.ObjectMeta{Name: "ds", Namespace: "default"},
+		},
+		&core.Pod{
+			ObjectMeta: meta.ObjectMeta{Name: "pod", Namespace: "default", Labels: map[string]string{"app": "pod"}},
+		},
+	))
+	ctx = WithCustomKinds(ctx, []CustomKind{webApp})
+	di := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		ArgoRollout.GroupVersionResource(): "RolloutList",
+		webApp.GroupVersionResource():      "WebAppList",
+	}, objs...)
+	return WithDynamicInterface(ctx, di)
+}
+
+func TestGetWorkload(t *testing.T) {
+	status := map[string]any{"replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)}
+	ctx := testContext(t,
+		customObject(ArgoRollout, "ro", status),
+		customObject(webApp, "web", status),
+	)
+
+	wl, err := GetWorkload(ctx, "ro", "default", "Rollout")
+	require.NoError(t, err)
+	assert.Equal(t, "Rollout", wl.GetKind())
+	assert.Equal(t, 2, wl.Replicas())
+	pt := wl.GetPodTemplate()
+	assert.Equal(t, map[string]string{"app": "ro"}, pt.Labels)
+	require.Len(t, pt.Spec.Containers, 1)
+	assert.Equal(t, int32(8080), pt.Spec.Containers[0].Ports[0].ContainerPort)
+	sel, err := wl.Selector()
+	require.NoError(t, err)
+	assert.True(t, sel.Matches(labels.Set{"app": "ro"}))
+	assert.False(t, sel.Matches(labels.Set{"app": "web"}))
+
+	wl, err = GetWorkload(ctx, "web", "default", "")
+	require.NoError(t, err)
+	assert.Equal(t, "WebApp", wl.GetKind())
+
+	wl, err = GetWorkload(ctx, "dep", "default", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Deployment", wl.GetKind())
+
+	wl, err = GetWorkload(ctx, "ds", "default", "")
+	require.NoError(t, err)
+	assert.Equal(t, "DaemonSet", wl.GetKind())
+	_, ok := DaemonSetImpl(wl)
+	assert.True(t, ok)
+
+	wl, err = GetWorkload(ctx, "pod", "default", "Pod")
+	require.NoError(t, err)
+	assert.Equal(t, "Pod", wl.GetKind())
+	assert.Equal(t, 1, wl.Replicas())
+
+	// Pods are never the result of a search
+	_, err = GetWorkload(ctx, "pod", "default", "")
+	assert.True(t, k8sErrors.IsNotFound(err))
+
+	_, err = GetWorkload(ctx, "nope", "default", "")
+	assert.True(t, k8sErrors.IsNotFound(err))
+
+	_, err = GetWorkload(ctx, "ro", "default", "Job")
+	var uwkErr k8sapi.UnsupportedWorkloadKindError
+	assert.ErrorAs(t, err, &uwkErr)
+}
+
+func TestCustomWorkload_Patch(t *testing.T) {
+	ctx := testContext(t, customObject(ArgoRollout, "ro", nil))
+	wl, err := GetWorkload(ctx, "ro", "default", "Rollout")
+	require.NoError(t, err)
+	_, ck, ok := CustomImpl(wl)
+	require.True(t, ok)
+	assert.Equal(t, ArgoRollout, ck)
+
+	patch := `{"spec": {"template": {"metadata": {"annotations": {"telepresence.getambassador.io/restartedAt": "now"}}}}}`
+	require.NoError(t, wl.Patch(ctx, types.MergePatchType, []byte(patch)))
+	assert.Equal(t, "now", wl.GetPodTemplate().Annotations["telepresence.getambassador.io/restartedAt"])
+	assert.Equal(t, map[string]string{"app": "ro"}, wl.GetPodTemplate().Labels)
+}
+
+func TestCustomWorkload_Updated(t *testing.T) {
+	tests := []struct {
+		name   string
+		status map[string]any
+		want   bool
+	}{
+		{
+			name:   "no status",
+			status: nil,
+			want:   false,
+		},
+		{
+			name:   "all replicas updated",
+			status: map[string]any{"replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)},
+			want:   true,
+		},
+		{
+			name:   "replica not available",
+			status: map[string]any{"replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(1)},
+			want:   false,
+		},
+		{
+			name:   "old generation observed",
+			status: map[string]any{"observedGeneration": int64(1), "replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)},
+			want:   false,
+		},
+		{
+			name:   "non-numeric observed generation",
+			status: map[string]any{"observedGeneration": "7d9f8c", "replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wl := Custom(ArgoRollout, customObject(ArgoRollout, "ro", tt.status))
+			assert.Equal(t, tt.want, wl.Updated(2))
+		})
+	}
+}
+
+func TestPod_GetPodTemplate(t *testing.T) {
+	pod := &core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:        "pod",
+			Namespace:   "default",
+			Labels:      map[string]string{"app": "pod", "telepresence.io/workloadEnabled": "true"},
+			Annotations: map[string]string{"telepresence.getambassador.io/inject-traffic-agent": "enabled"},
+		},
+		Spec: core.PodSpec{
+			Containers: []core.Container{{Name: "echo"}, {Name: "traffic-agent"}},
+		},
+	}
+	pt := Pod(pod).GetPodTemplate()
+	assert.Equal(t, pod.Labels, pt.Labels)
+	assert.Len(t, pt.Spec.Containers, 2)
+
+	assert.Equal(t, "pod", pt.Name)
+	assert.Equal(t, "default", pt.Namespace)
+	assert.Equal(t, pod.Annotations, pt.Annotations)
+}
+
+func TestGetCustomKinds(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, []CustomKind{ArgoRollout}, GetCustomKinds(ctx))
+
+	rollout := CustomKind{Group: "argoproj.io", Version: "v1beta1", Kind: "Rollout"}
+	ctx = WithCustomKinds(ctx, []CustomKind{webApp, rollout})
+	assert.Equal(t, []CustomKind{webApp, rollout}, GetCustomKinds(ctx))
+
+	ck, ok := FindCustomKindFor(ctx, "apps.example.com/v1", "WebApp")
+	assert.True(t, ok)
+	assert.Equal(t, webApp, ck)
+	_, ok = FindCustomKindFor(ctx, "apps/v1", "WebApp")
+	assert.False(t, ok)
+	assert.Equal(t, "webapps", webApp.GroupVersionResource().Resource)
+}
+
+func TestGroupResource(t *testing.T) {
+	ctx := WithCustomKinds(context.Background(), []CustomKind{webApp})
+	tests := []struct {
+		kind     string
+		expected schema.GroupResource
+		ok       bool
+	}{
+		{kind: "Deployment", expected: schema.GroupResource{Group: "apps", Resource: "deployments"}, ok: true},
+		{kind: "ReplicaSet", expected: schema.GroupResource{Group: "apps", Resource: "replicasets"}, ok: true},
+		{kind: "StatefulSet", expected: schema.GroupResource{Group: "apps", Resource: "statefulsets"}, ok: true},
+		{kind: "DaemonSet", expected: schema.GroupResource{Group: "apps", Resource: "daemonsets"}, ok: true},
+		{kind: "Pod", expected: schema.GroupResource{Resource: "pods"}, ok: true},
+		{kind: "Rollout", expected: schema.GroupResource{Group: "argoproj.io", Resource: "rollouts"}, ok: true},
+		{kind: "WebApp", expected: schema.GroupResource{Group: "apps.example.com", Resource: "webapps"}, ok: true},
+		{kind: "Job"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			gr, ok := GroupResource(ctx, tt.kind)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, gr)
+		})
+	}
+}
+
+func TestCustomKind_Validate(t *testing.T) {
+	assert.NoError(t, webApp.Validate())
+	assert.Error(t, CustomKind{Group: "apps", Version: "v1", Kind: "Deployment"}.Validate())
+	assert.Error(t, CustomKind{Version: "v1", Kind: "WebApp"}.Validate())
+	assert.Error(t, CustomKind{Group: "apps.example.com", Version: "v1"}.Validate())
+}
@@ -45,11 +45,14 @@ const (
 	Connector_GatherTraces_FullMethodName            = "/telepresence.connector.Connector/GatherTraces"
 	Connector_AddInterceptor_FullMethodName          = "/telepresence.connector.Connector/AddInterceptor"
 	Connector_RemoveInterceptor_FullMethodName       = "/telepresence.connector.Connector/RemoveInterceptor"
+	Connector_ListAllIntercepts_FullMethodName       = "/telepresence.connector.Connector/ListAllIntercepts"
+	Connector_EvictIntercept_FullMethodName          = "/telepresence.connector.Connector/EvictIntercept"
 	Connector_GetNamespaces_FullMethodName           = "/telepresence.connector.Connector/GetNamespaces"
 	Connector_RemoteMountAvailability_FullMethodName = "/telepresence.connector.Connector/RemoteMountAvailability"
 	Connector_GetConfig_FullMethodName               = "/telepresence.connector.Connector/GetConfig"
 	Connector_SetDNSExcludes_FullMethodName          = "/telepresence.connector.Connector/SetDNSExcludes"
 	Connector_SetDNSMappings_FullMethodName          = "/telepresence.connector.Connector/SetDNSMappings"
+	Connector_WatchConnectionStats_FullMethodName    = "/telepresence.connector.Connector/WatchConnectionStats"
 )
 
 // ConnectorClient is the client API for Connector service.
@@ -112,6 +115,11 @@ type ConnectorClient interface {
 	AddInterceptor(ctx context.Context, in *Interceptor, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// RemoveInterceptor removes a previously added interceptor
 	RemoveInterceptor(ctx context.Context, in *Interceptor, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListAllIntercepts returns the intercepts of all clients in the managed namespaces.
+	ListAllIntercepts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*manager.AllInterceptsResponse, error)
+	// EvictIntercept removes an intercept that is owned by another client. The user must be
+	// allowed to update the intercepted workload.
+	EvictIntercept(ctx context.Context, in *manager.EvictInterceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetNamespaces gets the mapped namespaces with an optional prefix
 	GetNamespaces(ctx context.Context, in *GetNamespacesRequest, opts ...grpc.CallOption) (*GetNamespacesResponse, error)
 	// RemoteMountAvailability checks if remote mounts are possible using the given
@@ -123,6 +131,10 @@ type ConnectorClient interface {
 	SetDNSExcludes(ctx context.Context, in *daemon.SetDNSExcludesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SetDNSMappings sets the Mappings field of DNSConfig.
 	SetDNSMappings(ctx context.Context, in *daemon.SetDNSMappingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchConnectionStats sends periodic snapshots of the statistics of the
+	// active tunneled connections, as seen by the root daemon or, when
+	// requested, by the traffic-manager.
+	WatchConnectionStats(ctx context.Context, in *WatchConnectionStatsRequest, opts ...grpc.CallOption) (Connector_WatchConnectionStatsClient, error)
 }
 
 type connectorClient struct {
@@ -354,6 +366,24 @@ func (c *connectorClient) RemoveInterceptor(ctx context.Context, in *Interceptor
 	return out, nil
 }
 
+func (c *connectorClient) ListAllIntercepts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*manager.AllInterceptsResponse, error) {
+	out := new(manager.AllInterceptsResponse)
+	err := c.cc.Invoke(ctx, Connector_ListAllIntercepts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectorClient) EvictIntercept(ctx context.Context, in *manager.EvictInterceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Connector_EvictIntercept_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *connectorClient) GetNamespaces(ctx context.Context, in *GetNamespacesRequest, opts ...grpc.CallOption) (*GetNamespacesResponse, error) {
 	out := new(GetNamespacesResponse)
 	err := c.cc.Invoke(ctx, Connector_GetNamespaces_FullMethodName, in, out, opts...)
@@ -399,6 +429,38 @@ func (c *connectorClient) SetDNSMappings(ctx context.Context, in *daemon.SetDNSM
 	return out, nil
 }
 
+func (c *connectorClient) WatchConnectionStats(ctx context.Context, in *WatchConnectionStatsRequest, opts ...grpc.CallOption) (Connector_WatchConnectionStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Connector_ServiceDesc.Streams[1], Connector_WatchConnectionStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectorWatchConnectionStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Connector_WatchConnectionStatsClient interface {
+	Recv() (*manager.ConnectionStatsSnapshot, error)
+	grpc.ClientStream
+}
+
+type connectorWatchConnectionStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectorWatchConnectionStatsClient) Recv() (*manager.ConnectionStatsSnapshot, error) {
+	m := new(manager.ConnectionStatsSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ConnectorServer is the server API for Connector service.
 // All implementations must embed UnimplementedConnectorServer
 // for forward compatibility
@@ -459,6 +521,11 @@ type ConnectorServer interface {
 	AddInterceptor(context.Context, *Interceptor) (*emptypb.Empty, error)
 	// RemoveInterceptor removes a previously added interceptor
 	RemoveInterceptor(context.Context, *Interceptor) (*emptypb.Empty, error)
+	// ListAllIntercepts returns the intercepts of all clients in the managed namespaces.
+	ListAllIntercepts(context.Context, *emptypb.Empty) (*manager.AllInterceptsResponse, error)
+	// EvictIntercept removes an intercept that is owned by another client. The user must be
+	// allowed to update the intercepted workload.
+	EvictIntercept(context.Context, *manager.EvictInterceptRequest) (*emptypb.Empty, error)
 	// GetNamespaces gets the mapped namespaces with an optional prefix
 	GetNamespaces(context.Context, *GetNamespacesRequest) (*GetNamespacesResponse, error)
 	// RemoteMountAvailability checks if remote mounts are possible using the given
@@ -470,6 +537,10 @@ type ConnectorServer interface {
 	SetDNSExcludes(context.Context, *daemon.SetDNSExcludesRequest) (*emptypb.Empty, error)
 	// SetDNSMappings sets the Mappings field of DNSConfig.
 	SetDNSMappings(context.Context, *daemon.SetDNSMappingsRequest) (*emptypb.Empty, error)
+	// WatchConnectionStats sends periodic snapshots of the statistics of the
+	// active tunneled connections, as seen by the root daemon or, when
+	// requested, by the traffic-manager.
+	WatchConnectionStats(*WatchConnectionStatsRequest, Connector_WatchConnectionStatsServer) error
 	mustEmbedUnimplementedConnectorServer()
 }
 
@@ -543,6 +614,12 @@ func (UnimplementedConnectorServer) AddInterceptor(context.Context, *Interceptor
 func (UnimplementedConnectorServer) RemoveInterceptor(context.Context, *Interceptor) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveInterceptor not implemented")
 }
+func (UnimplementedConnectorServer) ListAllIntercepts(context.Context, *emptypb.Empty) (*manager.AllInterceptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllIntercepts not implemented")
+}
+func (UnimplementedConnectorServer) EvictIntercept(context.Context, *manager.EvictInterceptRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictIntercept not implemented")
+}
 func (UnimplementedConnectorServer) GetNamespaces(context.Context, *GetNamespacesRequest) (*GetNamespacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaces not implemented")
 }
@@ -558,6 +635,9 @@ func (UnimplementedConnectorServer) SetDNSExcludes(context.Context, *daemon.SetD
 func (UnimplementedConnectorServer) SetDNSMappings(context.Context, *daemon.SetDNSMappingsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDNSMappings not implemented")
 }
+func (UnimplementedConnectorServer) WatchConnectionStats(*WatchConnectionStatsRequest, Connector_WatchConnectionStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConnectionStats not implemented")
+}
 func (UnimplementedConnectorServer) mustEmbedUnimplementedConnectorServer() {}
 
 // UnsafeConnectorServer may be embedded to opt out of forward compatibility for this service.
@@ -970,6 +1050,42 @@ func _Connector_RemoveInterceptor_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Connector_ListAllIntercepts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorServer).ListAllIntercepts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Connector_ListAllIntercepts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorServer).ListAllIntercepts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Connector_EvictIntercept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(manager.EvictInterceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectorServer).EvictIntercept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Connector_EvictIntercept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectorServer).EvictIntercept(ctx, req.(*manager.EvictInterceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Connector_GetNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNamespacesRequest)
 	if err := dec(in); err != nil {
@@ -1060,6 +1176,27 @@ func _Connector_SetDNSMappings_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Connector_WatchConnectionStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConnectionStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectorServer).WatchConnectionStats(m, &connectorWatchConnectionStatsServer{stream})
+}
+
+type Connector_WatchConnectionStatsServer interface {
+	Send(*manager.ConnectionStatsSnapshot) error
+	grpc.ServerStream
+}
+
+type connectorWatchConnectionStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *connectorWatchConnectionStatsServer) Send(m *manager.ConnectionStatsSnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Connector_ServiceDesc is the grpc.ServiceDesc for Connector service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1151,6 +1288,14 @@ var Connector_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveInterceptor",
 			Handler:    _Connector_RemoveInterceptor_Handler,
 		},
+		{
+			MethodName: "ListAllIntercepts",
+			Handler:    _Connector_ListAllIntercepts_Handler,
+		},
+		{
+			MethodName: "EvictIntercept",
+			Handler:    _Connector_EvictIntercept_Handler,
+		},
 		{
 			MethodName: "GetNamespaces",
 			Handler:    _Connector_GetNamespaces_Handler,
@@ -1178,6 +1323,11 @@ var Connector_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Connector_WatchWorkloads_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchConnectionStats",
+			Handler:       _Connector_WatchConnectionStats_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "connector/connector.proto",
 }
@@ -22,18 +22,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Daemon_Version_FullMethodName          = "/telepresence.daemon.Daemon/Version"
-	Daemon_Status_FullMethodName           = "/telepresence.daemon.Daemon/Status"
-	Daemon_Quit_FullMethodName             = "/telepresence.daemon.Daemon/Quit"
-	Daemon_Connect_FullMethodName          = "/telepresence.daemon.Daemon/Connect"
-	Daemon_Disconnect_FullMethodName       = "/telepresence.daemon.Daemon/Disconnect"
-	Daemon_GetNetworkConfig_FullMethodName = "/telepresence.daemon.Daemon/GetNetworkConfig"
-	Daemon_SetDnsSearchPath_FullMethodName = "/telepresence.daemon.Daemon/SetDnsSearchPath"
-	Daemon_SetDNSExcludes_FullMethodName   = "/telepresence.daemon.Daemon/SetDNSExcludes"
-	Daemon_SetDNSMappings_FullMethodName   = "/telepresence.daemon.Daemon/SetDNSMappings"
-	Daemon_SetLogLevel_FullMethodName      = "/telepresence.daemon.Daemon/SetLogLevel"
-	Daemon_WaitForNetwork_FullMethodName   = "/telepresence.daemon.Daemon/WaitForNetwork"
-	Daemon_WaitForAgentIP_FullMethodName   = "/telepresence.daemon.Daemon/WaitForAgentIP"
+	Daemon_Version_FullMethodName              = "/telepresence.daemon.Daemon/Version"
+	Daemon_Status_FullMethodName               = "/telepresence.daemon.Daemon/Status"
+	Daemon_Quit_FullMethodName                 = "/telepresence.daemon.Daemon/Quit"
+	Daemon_Connect_FullMethodName              = "/telepresence.daemon.Daemon/Connect"
+	Daemon_Disconnect_FullMethodName           = "/telepresence.daemon.Daemon/Disconnect"
+	Daemon_GetNetworkConfig_FullMethodName     = "/telepresence.daemon.Daemon/GetNetworkConfig"
+	Daemon_SetDnsSearchPath_FullMethodName     = "/telepresence.daemon.Daemon/SetDnsSearchPath"
+	Daemon_SetDNSExcludes_FullMethodName       = "/telepresence.daemon.Daemon/SetDNSExcludes"
+	Daemon_SetDNSMappings_FullMethodName       = "/telepresence.daemon.Daemon/SetDNSMappings"
+	Daemon_SetLogLevel_FullMethodName          = "/telepresence.daemon.Daemon/SetLogLevel"
+	Daemon_WaitForNetwork_FullMethodName       = "/telepresence.daemon.Daemon/WaitForNetwork"
+	Daemon_WaitForAgentIP_FullMethodName       = "/telepresence.daemon.Daemon/WaitForAgentIP"
+	Daemon_WatchConnectionStats_FullMethodName = "/telepresence.daemon.Daemon/WatchConnectionStats"
 )
 
 // DaemonClient is the client API for Daemon service.
@@ -64,6 +65,10 @@ type DaemonClient interface {
 	WaitForNetwork(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// WaitForAgentIP waits for the network of an intercepted agent to become ready.
 	WaitForAgentIP(ctx context.Context, in *WaitForAgentIPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchConnectionStats sends periodic snapshots of the statistics of the
+	// active connections that the daemon has tunneled to the cluster. The
+	// session and all_sessions fields of the request are ignored.
+	WatchConnectionStats(ctx context.Context, in *manager.ConnectionStatsRequest, opts ...grpc.CallOption) (Daemon_WatchConnectionStatsClient, error)
 }
 
 type daemonClient struct {
@@ -182,6 +187,38 @@ func (c *daemonClient) WaitForAgentIP(ctx context.Context, in *WaitForAgentIPReq
 	return out, nil
 }
 
+func (c *daemonClient) WatchConnectionStats(ctx context.Context, in *manager.ConnectionStatsRequest, opts ...grpc.CallOption) (Daemon_WatchConnectionStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[0], Daemon_WatchConnectionStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonWatchConnectionStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_WatchConnectionStatsClient interface {
+	Recv() (*manager.ConnectionStatsSnapshot, error)
+	grpc.ClientStream
+}
+
+type daemonWatchConnectionStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonWatchConnectionStatsClient) Recv() (*manager.ConnectionStatsSnapshot, error) {
+	m := new(manager.ConnectionStatsSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DaemonServer is the server API for Daemon service.
 // All implementations must embed UnimplementedDaemonServer
 // for forward compatibility
@@ -210,6 +247,10 @@ type DaemonServer interface {
 	WaitForNetwork(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// WaitForAgentIP waits for the network of an intercepted agent to become ready.
 	WaitForAgentIP(context.Context, *WaitForAgentIPRequest) (*emptypb.Empty, error)
+	// WatchConnectionStats sends periodic snapshots of the statistics of the
+	// active connections that the daemon has tunneled to the cluster. The
+	// session and all_sessions fields of the request are ignored.
+	WatchConnectionStats(*manager.ConnectionStatsRequest, Daemon_WatchConnectionStatsServer) error
 	mustEmbedUnimplementedDaemonServer()
 }
 
@@ -253,6 +294,9 @@ func (UnimplementedDaemonServer) WaitForNetwork(context.Context, *emptypb.Empty)
 func (UnimplementedDaemonServer) WaitForAgentIP(context.Context, *WaitForAgentIPRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitForAgentIP not implemented")
 }
+func (UnimplementedDaemonServer) WatchConnectionStats(*manager.ConnectionStatsRequest, Daemon_WatchConnectionStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConnectionStats not implemented")
+}
 func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
 
 // UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
@@ -482,6 +526,27 @@ func _Daemon_WaitForAgentIP_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_WatchConnectionStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(manager.ConnectionStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).WatchConnectionStats(m, &daemonWatchConnectionStatsServer{stream})
+}
+
+type Daemon_WatchConnectionStatsServer interface {
+	Send(*manager.ConnectionStatsSnapshot) error
+	grpc.ServerStream
+}
+
+type daemonWatchConnectionStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonWatchConnectionStatsServer) Send(m *manager.ConnectionStatsSnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Daemon_ServiceDesc is the grpc.ServiceDesc for Daemon service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -538,6 +603,12 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Daemon_WaitForAgentIP_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConnectionStats",
+			Handler:       _Daemon_WatchConnectionStats_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "daemon/daemon.proto",
 }
@@ -48,13 +48,17 @@ const (
 	Manager_RemoveIntercept_FullMethodName           = "/telepresence.manager.Manager/RemoveIntercept"
 	Manager_UpdateIntercept_FullMethodName           = "/telepresence.manager.Manager/UpdateIntercept"
 	Manager_GetIntercept_FullMethodName              = "/telepresence.manager.Manager/GetIntercept"
+	Manager_ListAllIntercepts_FullMethodName         = "/telepresence.manager.Manager/ListAllIntercepts"
+	Manager_EvictIntercept_FullMethodName            = "/telepresence.manager.Manager/EvictIntercept"
 	Manager_ReviewIntercept_FullMethodName           = "/telepresence.manager.Manager/ReviewIntercept"
 	Manager_LookupDNS_FullMethodName                 = "/telepresence.manager.Manager/LookupDNS"
+	Manager_LookupSource_FullMethodName              = "/telepresence.manager.Manager/LookupSource"
 	Manager_AgentLookupDNSResponse_FullMethodName    = "/telepresence.manager.Manager/AgentLookupDNSResponse"
 	Manager_WatchLookupDNS_FullMethodName            = "/telepresence.manager.Manager/WatchLookupDNS"
 	Manager_WatchLogLevel_FullMethodName             = "/telepresence.manager.Manager/WatchLogLevel"
 	Manager_Tunnel_FullMethodName                    = "/telepresence.manager.Manager/Tunnel"
 	Manager_ReportMetrics_FullMethodName             = "/telepresence.manager.Manager/ReportMetrics"
+	Manager_WatchConnectionStats_FullMethodName      = "/telepresence.manager.Manager/WatchConnectionStats"
 	Manager_WatchDial_FullMethodName                 = "/telepresence.manager.Manager/WatchDial"
 )
 
@@ -132,6 +136,10 @@ type ManagerClient interface {
 	UpdateIntercept(ctx context.Context, in *UpdateInterceptRequest, opts ...grpc.CallOption) (*InterceptInfo, error)
 	// GetIntercept gets info from intercept name
 	GetIntercept(ctx context.Context, in *GetInterceptRequest, opts ...grpc.CallOption) (*InterceptInfo, error)
+	// ListAllIntercepts returns the intercepts of all clients, together with their owners.
+	ListAllIntercepts(ctx context.Context, in *SessionInfo, opts ...grpc.CallOption) (*AllInterceptsResponse, error)
+	// EvictIntercept lets a client remove an intercept that is owned by another client.
+	EvictIntercept(ctx context.Context, in *EvictInterceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ReviewIntercept lets an agent approve or reject an intercept by
 	// changing the disposition from "WATING" to "ACTIVE" or to an
 	// error, and setting a human-readable status message.
@@ -139,6 +147,10 @@ type ManagerClient interface {
 	// LookupDNS performs a DNS lookup in the cluster. If the caller has intercepts
 	// active, the lookup will be performed from the intercepted pods.
 	LookupDNS(ctx context.Context, in *DNSRequest, opts ...grpc.CallOption) (*DNSResponse, error)
+	// LookupSource returns information about the pod that has a given IP.
+	// Agents use it to route connections to intercepts that select their
+	// sources.
+	LookupSource(ctx context.Context, in *LookupSourceRequest, opts ...grpc.CallOption) (*SourceInfo, error)
 	// AgentLookupHostResponse lets an agent respond for lookup requests
 	AgentLookupDNSResponse(ctx context.Context, in *DNSAgentResponse, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// WatchLookupHost lets an agent receive lookup requests
@@ -155,6 +167,10 @@ type ManagerClient interface {
 	// ReportMetrics is used by a traffic-agent to report metrics for streams
 	// established when clients connect directly to traffic-agents using port-forward.
 	ReportMetrics(ctx context.Context, in *TunnelMetrics, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchConnectionStats sends periodic snapshots of the statistics of
+	// the active connections that clients have tunneled through the
+	// traffic-manager.
+	WatchConnectionStats(ctx context.Context, in *ConnectionStatsRequest, opts ...grpc.CallOption) (Manager_WatchConnectionStatsClient, error)
 	// WatchDial makes it possible for the client side to receive
 	// DialRequests from the traffic-manager. Requests are sent when an
 	// intercepted traffic-agent creates a Tunnel that needs to be extended
@@ -505,6 +521,24 @@ func (c *managerClient) GetIntercept(ctx context.Context, in *GetInterceptReques
 	return out, nil
 }
 
+func (c *managerClient) ListAllIntercepts(ctx context.Context, in *SessionInfo, opts ...grpc.CallOption) (*AllInterceptsResponse, error) {
+	out := new(AllInterceptsResponse)
+	err := c.cc.Invoke(ctx, Manager_ListAllIntercepts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) EvictIntercept(ctx context.Context, in *EvictInterceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Manager_EvictIntercept_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) ReviewIntercept(ctx context.Context, in *ReviewInterceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Manager_ReviewIntercept_FullMethodName, in, out, opts...)
@@ -523,6 +557,15 @@ func (c *managerClient) LookupDNS(ctx context.Context, in *DNSRequest, opts ...g
 	return out, nil
 }
 
+func (c *managerClient) LookupSource(ctx context.Context, in *LookupSourceRequest, opts ...grpc.CallOption) (*SourceInfo, error) {
+	out := new(SourceInfo)
+	err := c.cc.Invoke(ctx, Manager_LookupSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) AgentLookupDNSResponse(ctx context.Context, in *DNSAgentResponse, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Manager_AgentLookupDNSResponse_FullMethodName, in, out, opts...)
@@ -636,8 +679,40 @@ func (c *managerClient) ReportMetrics(ctx context.Context, in *TunnelMetrics, op
 	return out, nil
 }
 
+func (c *managerClient) WatchConnectionStats(ctx context.Context, in *ConnectionStatsRequest, opts ...grpc.CallOption) (Manager_WatchConnectionStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[8], Manager_WatchConnectionStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerWatchConnectionStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Manager_WatchConnectionStatsClient interface {
+	Recv() (*ConnectionStatsSnapshot, error)
+	grpc.ClientStream
+}
+
+type managerWatchConnectionStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerWatchConnectionStatsClient) Recv() (*ConnectionStatsSnapshot, error) {
+	m := new(ConnectionStatsSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *managerClient) WatchDial(ctx context.Context, in *SessionInfo, opts ...grpc.CallOption) (Manager_WatchDialClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[8], Manager_WatchDial_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[9], Manager_WatchDial_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -742,6 +817,10 @@ type ManagerServer interface {
 	UpdateIntercept(context.Context, *UpdateInterceptRequest) (*InterceptInfo, error)
 	// GetIntercept gets info from intercept name
 	GetIntercept(context.Context, *GetInterceptRequest) (*InterceptInfo, error)
+	// ListAllIntercepts returns the intercepts of all clients, together with their owners.
+	ListAllIntercepts(context.Context, *SessionInfo) (*AllInterceptsResponse, error)
+	// EvictIntercept lets a client remove an intercept that is owned by another client.
+	EvictIntercept(context.Context, *EvictInterceptRequest) (*emptypb.Empty, error)
 	// ReviewIntercept lets an agent approve or reject an intercept by
 	// changing the disposition from "WATING" to "ACTIVE" or to an
 	// error, and setting a human-readable status message.
@@ -749,6 +828,10 @@ type ManagerServer interface {
 	// LookupDNS performs a DNS lookup in the cluster. If the caller has intercepts
 	// active, the lookup will be performed from the intercepted pods.
 	LookupDNS(context.Context, *DNSRequest) (*DNSResponse, error)
+	// LookupSource returns information about the pod that has a given IP.
+	// Agents use it to route connections to intercepts that select their
+	// sources.
+	LookupSource(context.Context, *LookupSourceRequest) (*SourceInfo, error)
 	// AgentLookupHostResponse lets an agent respond for lookup requests
 	AgentLookupDNSResponse(context.Context, *DNSAgentResponse) (*emptypb.Empty, error)
 	// WatchLookupHost lets an agent receive lookup requests
@@ -765,6 +848,10 @@ type ManagerServer interface {
 	// ReportMetrics is used by a traffic-agent to report metrics for streams
 	// established when clients connect directly to traffic-agents using port-forward.
 	ReportMetrics(context.Context, *TunnelMetrics) (*emptypb.Empty, error)
+	// WatchConnectionStats sends periodic snapshots of the statistics of
+	// the active connections that clients have tunneled through the
+	// traffic-manager.
+	WatchConnectionStats(*ConnectionStatsRequest, Manager_WatchConnectionStatsServer) error
 	// WatchDial makes it possible for the client side to receive
 	// DialRequests from the traffic-manager. Requests are sent when an
 	// intercepted traffic-agent creates a Tunnel that needs to be extended
@@ -853,12 +940,21 @@ func (UnimplementedManagerServer) UpdateIntercept(context.Context, *UpdateInterc
 func (UnimplementedManagerServer) GetIntercept(context.Context, *GetInterceptRequest) (*InterceptInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIntercept not implemented")
 }
+func (UnimplementedManagerServer) ListAllIntercepts(context.Context, *SessionInfo) (*AllInterceptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllIntercepts not implemented")
+}
+func (UnimplementedManagerServer) EvictIntercept(context.Context, *EvictInterceptRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictIntercept not implemented")
+}
 func (UnimplementedManagerServer) ReviewIntercept(context.Context, *ReviewInterceptRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReviewIntercept not implemented")
 }
 func (UnimplementedManagerServer) LookupDNS(context.Context, *DNSRequest) (*DNSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupDNS not implemented")
 }
+func (UnimplementedManagerServer) LookupSource(context.Context, *LookupSourceRequest) (*SourceInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupSource not implemented")
+}
 func (UnimplementedManagerServer) AgentLookupDNSResponse(context.Context, *DNSAgentResponse) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AgentLookupDNSResponse not implemented")
 }
@@ -874,6 +970,9 @@ func (UnimplementedManagerServer) Tunnel(Manager_TunnelServer) error {
 func (UnimplementedManagerServer) ReportMetrics(context.Context, *TunnelMetrics) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportMetrics not implemented")
 }
+func (UnimplementedManagerServer) WatchConnectionStats(*ConnectionStatsRequest, Manager_WatchConnectionStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConnectionStats not implemented")
+}
 func (UnimplementedManagerServer) WatchDial(*SessionInfo, Manager_WatchDialServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchDial not implemented")
 }
@@ -1337,6 +1436,42 @@ func _Manager_GetIntercept_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_ListAllIntercepts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).ListAllIntercepts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manager_ListAllIntercepts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).ListAllIntercepts(ctx, req.(*SessionInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_EvictIntercept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictInterceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).EvictIntercept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manager_EvictIntercept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).EvictIntercept(ctx, req.(*EvictInterceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_ReviewIntercept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReviewInterceptRequest)
 	if err := dec(in); err != nil {
@@ -1373,6 +1508,24 @@ func _Manager_LookupDNS_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_LookupSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).LookupSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manager_LookupSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).LookupSource(ctx, req.(*LookupSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_AgentLookupDNSResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DNSAgentResponse)
 	if err := dec(in); err != nil {
@@ -1477,6 +1630,27 @@ func _Manager_ReportMetrics_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_WatchConnectionStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConnectionStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServer).WatchConnectionStats(m, &managerWatchConnectionStatsServer{stream})
+}
+
+type Manager_WatchConnectionStatsServer interface {
+	Send(*ConnectionStatsSnapshot) error
+	grpc.ServerStream
+}
+
+type managerWatchConnectionStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerWatchConnectionStatsServer) Send(m *ConnectionStatsSnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Manager_WatchDial_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SessionInfo)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1581,6 +1755,14 @@ var Manager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIntercept",
 			Handler:    _Manager_GetIntercept_Handler,
 		},
+		{
+			MethodName: "ListAllIntercepts",
+			Handler:    _Manager_ListAllIntercepts_Handler,
+		},
+		{
+			MethodName: "EvictIntercept",
+			Handler:    _Manager_EvictIntercept_Handler,
+		},
 		{
 			MethodName: "ReviewIntercept",
 			Handler:    _Manager_ReviewIntercept_Handler,
@@ -1589,6 +1771,10 @@ var Manager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupDNS",
 			Handler:    _Manager_LookupDNS_Handler,
 		},
+		{
+			MethodName: "LookupSource",
+			Handler:    _Manager_LookupSource_Handler,
+		},
 		{
 			MethodName: "AgentLookupDNSResponse",
 			Handler:    _Manager_AgentLookupDNSResponse_Handler,
@@ -1640,6 +1826,11 @@ var Manager_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchConnectionStats",
+			Handler:       _Manager_WatchConnectionStats_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "WatchDial",
 			Handler:       _Manager_WatchDial_Handler,